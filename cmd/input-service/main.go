@@ -1,43 +1,56 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
-
-	"encoding/json"
-	"io/ioutil"
-	"net/http"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
 	// Adapters - In
+	configIn "jiaa-server-core/internal/input/adapter/in/config"
+	"jiaa-server-core/internal/input/adapter/in/datasync"
 	grpcIn "jiaa-server-core/internal/input/adapter/in/grpc"
 	httpAdapter "jiaa-server-core/internal/input/adapter/in/http"
 	kafkaIn "jiaa-server-core/internal/input/adapter/in/kafka"
 
 	// Adapters - Out
+	boltOut "jiaa-server-core/internal/input/adapter/out/bolt"
 	grpcOut "jiaa-server-core/internal/input/adapter/out/grpc"
 	kafkaOut "jiaa-server-core/internal/input/adapter/out/kafka"
 	"jiaa-server-core/internal/input/adapter/out/memory"
 
-	// Services
+	// Domain, Services
+	"jiaa-server-core/internal/input/domain"
 	"jiaa-server-core/internal/input/service"
 )
 
 // Config 서버 설정
 type Config struct {
-	HTTPPort            string
-	KafkaBrokers        string
-	ActivityTopic       string // client-activity topic (→ Dev 6)
-	StateTopic          string // command-state topic (← Dev 6)
-	PhysicalControlAddr string // Dev 1 gRPC 주소
-	ScreenControlAddr   string // Dev 3 gRPC 주소
-	SabotageCommandAddr string // SabotageCommand gRPC 주소
-	IntelligenceAddr    string // Dev 5 (AI) gRPC 주소
+	HTTPPort             string
+	KafkaBrokers         string
+	ActivityTopic        string                       // client-activity topic (→ Dev 6)
+	StateTopic           string                       // command-state topic (← Dev 6)
+	PhysicalControlAddr  string                       // Dev 1 gRPC 주소
+	ScreenControlAddr    string                       // Dev 3 gRPC 주소
+	SabotageCommandAddr  string                       // SabotageCommand gRPC 주소
+	IntelligenceAddr     string                       // Dev 5 (AI) gRPC 주소
+	ScoreModelPath       string                       // 점수 모델 설정 파일 (비어 있으면 기본 모델)
+	ScoreGaugeInterval   time.Duration                // 점수 게이지 푸시 주기
+	AudioEmergency       service.AudioEmergencyConfig // 오디오 EMERGENCY 감지 파라미터
+	DataDBPath           string                       // 임베디드 DB 파일 (게이미피케이션 진행도 등)
+	AchievementRulesPath string                       // 업적 규칙 파일 (비어 있으면 업적 없음)
+	Streak               service.StreakConfig         // 하루 목표/연속 달성 파라미터
+	WeeklyReport         service.WeeklyReportConfig   // 주간 리포트 전달 일정
+	BlacklistSync        datasync.BlacklistSyncConfig // Data Service 블랙리스트 동기화 (URL이 비어 있으면 끔)
+	UnlockPass           service.UnlockPassConfig     // 차단 해제 이용권 시간/하루 한도
+	ReflexThrottle       service.ReflexThrottleConfig // 같은 대상 반복 차단 억제 (쿨다운/중복 제거 창)
+	AdminAPIToken        string                       // 관리자 전용 HTTP API Bearer 토큰 (비어 있으면 관리자 API 거부)
 }
 
 func main() {
@@ -51,46 +64,6 @@ func main() {
 	blacklistAdapter := memory.NewBlacklistAdapterWithDefaults()
 	log.Printf("[MAIN] Blacklist adapter initialized with defaults")
 
-	// [LOCAL HOTFIX] Fetch Blacklist from Data Service (localhost:8083)
-	// We do this in a goroutine to not block startup, but with a small delay to ensure adapter is ready
-	go func() {
-		time.Sleep(2 * time.Second) // Wait for server to start
-		url := "http://localhost:8083/api/v1/blacklist"
-		log.Printf("[MAIN] Fetching external blacklist from %s...", url)
-
-		resp, err := http.Get(url)
-		if err != nil {
-			log.Printf("[MAIN] Failed to fetch blacklist: %v", err)
-			return
-		}
-		defer resp.Body.Close()
-
-		body, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			log.Printf("[MAIN] Failed to read blacklist body: %v", err)
-			return
-		}
-
-		var result struct {
-			Success bool `json:"success"`
-			Data    []struct {
-				AppName string `json:"appName"`
-			} `json:"data"`
-		}
-
-		if err := json.Unmarshal(body, &result); err != nil {
-			log.Printf("[MAIN] Failed to parse blacklist JSON: %v", err)
-			return
-		}
-
-		count := 0
-		for _, item := range result.Data {
-			blacklistAdapter.AddAppToBlacklist(item.AppName)
-			count++
-		}
-		log.Printf("[MAIN] Successfully synced %d apps from Data Service.", count)
-	}()
-
 	// Kafka Producer (→ Dev 6)
 	dataRelayAdapter, err := kafkaOut.NewDataRelayAdapter(config.KafkaBrokers, config.ActivityTopic)
 	if err != nil {
@@ -105,22 +78,203 @@ func main() {
 	intelligenceAdapter := grpcOut.NewIntelligenceAdapterLazy(config.IntelligenceAddr)
 	log.Printf("[MAIN] gRPC adapters initialized (lazy connection)")
 
+	// Embedded DB (bbolt) - 영속 저장소
+	dataDB, err := boltOut.OpenDB(config.DataDBPath)
+	if err != nil {
+		log.Fatalf("[MAIN] Failed to open data DB %s: %v", config.DataDBPath, err)
+	}
+	gamificationStore, err := boltOut.NewGamificationStore(dataDB)
+	if err != nil {
+		log.Fatalf("[MAIN] Failed to initialize gamification store: %v", err)
+	}
+	achievementStore, err := boltOut.NewAchievementStore(dataDB)
+	if err != nil {
+		log.Fatalf("[MAIN] Failed to initialize achievement store: %v", err)
+	}
+	streakStore, err := boltOut.NewStreakStore(dataDB)
+	if err != nil {
+		log.Fatalf("[MAIN] Failed to initialize streak store: %v", err)
+	}
+	leaderboardStore, err := boltOut.NewLeaderboardStore(dataDB)
+	if err != nil {
+		log.Fatalf("[MAIN] Failed to initialize leaderboard store: %v", err)
+	}
+	eventLogStore, err := boltOut.NewEventLogStore(dataDB)
+	if err != nil {
+		log.Fatalf("[MAIN] Failed to initialize event log store: %v", err)
+	}
+	studySessionStore, err := boltOut.NewStudySessionStore(dataDB)
+	if err != nil {
+		log.Fatalf("[MAIN] Failed to initialize study session store: %v", err)
+	}
+	unlockPassStore, err := boltOut.NewUnlockPassStore(dataDB)
+	if err != nil {
+		log.Fatalf("[MAIN] Failed to initialize unlock pass store: %v", err)
+	}
+	activityUsageStore, err := boltOut.NewActivityUsageStore(dataDB, boltOut.DefaultUsageRetentionDays)
+	if err != nil {
+		log.Fatalf("[MAIN] Failed to initialize activity usage store: %v", err)
+	}
+	// 그룹 블랙리스트 정책은 스터디 그룹 멤버십 기준
+	blacklistAdapter.SetMemberStore(leaderboardStore)
+	// 차단 규칙 저장소 - 저장된 정책으로 기본 블랙리스트 교체 (첫 실행이면 기본값 저장)
+	blacklistStore, err := boltOut.NewBlacklistStore(dataDB)
+	if err != nil {
+		log.Fatalf("[MAIN] Failed to initialize blacklist store: %v", err)
+	}
+	blacklistService := service.NewBlacklistService(blacklistAdapter, blacklistStore)
+	if err := blacklistService.Load(); err != nil {
+		log.Fatalf("[MAIN] Failed to load blacklist policies: %v", err)
+	}
+
+	// Data Service 블랙리스트 동기화 (전체 목록 diff 적용, 저장된 정책 위에서 시작)
+	var blacklistSyncer *datasync.BlacklistSyncer
+	if config.BlacklistSync.URL != "" {
+		blacklistSyncer = datasync.NewBlacklistSyncer(config.BlacklistSync, blacklistService)
+		blacklistSyncer.Start()
+	}
+	log.Printf("[MAIN] Data DB opened: %s", config.DataDBPath)
+
 	// 3. Initialize Services
-	// ReflexService - 즉각 반응 처리
+	// ReflexService - 즉각 반응 처리 (같은 대상 반복 차단은 억제, 억제 건수는 /health)
 	reflexService := service.NewReflexService(blacklistAdapter, sabotageAdapter, dataRelayAdapter)
+	reflexService.SetThrottle(config.ReflexThrottle)
 	log.Printf("[MAIN] ReflexService initialized")
 
+	// ActivityUsageService - 도메인/앱 체류 시간 집계 (팩트 폭격)
+	activityUsageService := service.NewActivityUsageService(service.DefaultActivityUsageConfig(), activityUsageStore)
+	reflexService.SetActivityUsage(activityUsageService)
+	reflexService.SetFactBomb(activityUsageService)
+	log.Printf("[MAIN] ActivityUsageService initialized")
+
 	// CommandRouterService - Dev 6 → Dev 1/3 라우팅
 	commandRouterService := service.NewCommandRouterService(physicalAdapter, screenAdapter)
 	log.Printf("[MAIN] CommandRouterService initialized")
 
+	// EmergencyService - EMERGENCY → Dev 5 분석 → Dev 3
+	emergencyService := service.NewEmergencyService(intelligenceAdapter, screenAdapter)
+	commandRouterService.SetEmergencyHandler(emergencyService)
+	log.Printf("[MAIN] EmergencyService initialized")
+
+	// AudioMonitorService - TranscribeAudio 음량 기반 EMERGENCY 감지
+	audioMonitorService := service.NewAudioMonitorService(config.AudioEmergency, commandRouterService)
+	log.Printf("[MAIN] AudioMonitorService initialized (threshold: %.1f dBFS, sustain: %s)",
+		config.AudioEmergency.ThresholdDBFS, config.AudioEmergency.Sustain)
+
 	// SolutionRouterService - Dev 5 → Dev 3 라우팅
 	solutionRouterService := service.NewSolutionRouterService(screenAdapter)
 	log.Printf("[MAIN] SolutionRouterService initialized")
 
+	// ScoreService - 점수 산정 (Algorithmic Logic)
+	scoreService := service.NewScoreService()
+	log.Printf("[MAIN] ScoreService initialized")
+
+	// Score Model 설정 파일 (Hot Reload)
+	var scoreModelWatcher *configIn.ScoreModelWatcher
+	if config.ScoreModelPath != "" {
+		scoreModelWatcher = configIn.NewScoreModelWatcher(config.ScoreModelPath, 5*time.Second, scoreService)
+		if err := scoreModelWatcher.Load(); err != nil {
+			log.Printf("[MAIN] Warning: Failed to load score model, using defaults: %v", err)
+		}
+		scoreModelWatcher.Start()
+	}
+
+	// ScoreBoardService - 하트비트 기반 실시간 점수 (StreamScore)
+	scoreBoardService := service.NewScoreBoardService(scoreService)
+	log.Printf("[MAIN] ScoreBoardService initialized")
+
+	// ScoreHistoryService - 점수 시계열 (1s → 1m → 1h 다운샘플)
+	scoreHistoryAdapter := memory.NewScoreHistoryAdapter(memory.DefaultScoreRetention())
+	scoreBoardService.SetScoreHistory(scoreHistoryAdapter)
+	scoreHistoryService := service.NewScoreHistoryService(scoreHistoryAdapter)
+	log.Printf("[MAIN] ScoreHistoryService initialized")
+
+	// ScoreGaugeService - 점수/상태 변경 시 클라이언트 게이지 푸시
+	scoreGaugeService := service.NewScoreGaugeService(screenAdapter, config.ScoreGaugeInterval)
+	scoreBoardService.AddScoreListener(scoreGaugeService)
+	scoreGaugeService.Start()
+
+	// GamificationService - 집중/생각 시간 → 경험치/레벨 (GetGamificationInfo)
+	gamificationService := service.NewGamificationService(service.DefaultGamificationConfig(), gamificationStore, screenAdapter)
+	scoreBoardService.AddScoreListener(gamificationService)
+	gamificationService.Start()
+	log.Printf("[MAIN] GamificationService initialized")
+
+	// AchievementService - 규칙 파일 기반 업적 (점수 스트림 + 차단/응급 이벤트)
+	achievementService := service.NewAchievementService(service.DefaultAchievementConfig(), achievementStore, screenAdapter)
+	scoreBoardService.AddScoreListener(achievementService)
+	reflexService.AddEventRecorder(achievementService)
+	emergencyService.AddEventRecorder(achievementService)
+	gamificationService.SetAchievements(achievementService)
+	achievementService.Start()
+
+	// 업적 규칙 파일 (Hot Reload)
+	var achievementRulesWatcher *configIn.AchievementRulesWatcher
+	if config.AchievementRulesPath != "" {
+		achievementRulesWatcher = configIn.NewAchievementRulesWatcher(config.AchievementRulesPath, 5*time.Second, achievementService)
+		if err := achievementRulesWatcher.Load(); err != nil {
+			log.Printf("[MAIN] Warning: Failed to load achievement rules: %v", err)
+		}
+		achievementRulesWatcher.Start()
+	}
+	log.Printf("[MAIN] AchievementService initialized")
+
+	// StreakService - 하루 집중 목표/연속 달성 (자정 전 목표 미달 경고), 업적 daily_streak도 클라이언트 시간대 기준
+	streakService := service.NewStreakService(config.Streak, streakStore, screenAdapter)
+	scoreBoardService.AddScoreListener(streakService)
+	gamificationService.SetStreaks(streakService)
+	achievementService.SetTimezones(streakService)
+	streakService.Start()
+	log.Printf("[MAIN] StreakService initialized")
+
+	// StudySessionService - 학습 세션 선언 (세션 한정 차단 규칙), 차단 일정은 클라이언트 시간대 기준
+	studySessionService := service.NewStudySessionService(studySessionStore)
+	reflexService.SetStudySessions(studySessionService)
+	reflexService.SetTimezones(streakService)
+	log.Printf("[MAIN] StudySessionService initialized")
+
+	// UnlockPassService - 차단 해제 이용권 (하루 한도는 클라이언트 시간대 기준, 발급/사용은 감사 기록)
+	unlockPassService := service.NewUnlockPassService(config.UnlockPass, unlockPassStore, unlockPassStore, blacklistAdapter)
+	unlockPassService.SetTimezones(streakService)
+	reflexService.SetUnlockPasses(unlockPassService)
+	log.Printf("[MAIN] UnlockPassService initialized")
+
+	// LeaderboardService - 스터디 그룹 리더보드 (일간/주간: 하루 기록, 누적: 게이미피케이션)
+	leaderboardService := service.NewLeaderboardService(service.DefaultLeaderboardConfig(), leaderboardStore, leaderboardStore)
+	leaderboardService.SetGamification(gamificationService)
+	// 멤버십 변경 시 그룹 블랙리스트 정책 캐시 갱신
+	leaderboardService.OnMembershipChange(blacklistAdapter.UpdateMembership)
+	scoreBoardService.AddScoreListener(leaderboardService)
+	leaderboardService.Start()
+	log.Printf("[MAIN] LeaderboardService initialized")
+
+	// WeeklyReportService - 주간 학습 리포트 (정해진 시각에 SolutionRouter로 전달)
+	weeklyReportService := service.NewWeeklyReportService(config.WeeklyReport, leaderboardService, eventLogStore, solutionRouterService)
+	weeklyReportService.SetActivityUsage(activityUsageService)
+	scoreBoardService.AddScoreListener(weeklyReportService)
+	reflexService.AddEventRecorder(weeklyReportService)
+	emergencyService.AddEventRecorder(weeklyReportService)
+	weeklyReportService.Start()
+	log.Printf("[MAIN] WeeklyReportService initialized")
+
 	// 4. Initialize Adapters (Driving - In)
 	// HTTP Handler
 	activityHandler := httpAdapter.NewActivityHandler(reflexService)
+	scoreHistoryHandler := httpAdapter.NewScoreHistoryHandler(scoreHistoryService)
+	streakHandler := httpAdapter.NewStreakHandler(streakService)
+	leaderboardHandler := httpAdapter.NewLeaderboardHandler(leaderboardService, leaderboardService)
+	reportHandler := httpAdapter.NewReportHandler(weeklyReportService)
+	studySessionHandler := httpAdapter.NewStudySessionHandler(studySessionService)
+	blacklistHandler := httpAdapter.NewBlacklistHandler(reflexService, blacklistService)
+	unlockPassHandler := httpAdapter.NewUnlockPassHandler(unlockPassService)
+
+	adminAuth := httpAdapter.NewAdminAuth(config.AdminAPIToken)
+	if !adminAuth.Enabled() {
+		log.Printf("[MAIN] Warning: ADMIN_API_TOKEN not set, admin-only HTTP APIs are disabled")
+	}
+	leaderboardHandler.SetAdminAuth(adminAuth)
+	unlockPassHandler.SetAdminAuth(adminAuth)
+	blacklistHandler.SetAdminAuth(adminAuth)
 
 	// Kafka Consumer (← Dev 6)
 	var stateConsumer *kafkaIn.StateConsumer
@@ -148,10 +302,24 @@ func main() {
 
 	// Register routes
 	activityHandler.RegisterRoutes(e)
+	scoreHistoryHandler.RegisterRoutes(e)
+	streakHandler.RegisterRoutes(e)
+	leaderboardHandler.RegisterRoutes(e)
+	reportHandler.RegisterRoutes(e)
+	studySessionHandler.RegisterRoutes(e)
+	blacklistHandler.RegisterRoutes(e)
+	unlockPassHandler.RegisterRoutes(e)
 
 	// Health check endpoint
 	e.GET("/health", func(c echo.Context) error {
-		return c.JSON(200, map[string]string{"status": "ok"})
+		health := map[string]interface{}{
+			"status": "ok",
+			"reflex": reflexService.Metrics(),
+		}
+		if blacklistSyncer != nil {
+			health["blacklist_sync"] = blacklistSyncer.Status()
+		}
+		return c.JSON(200, health)
 	})
 
 	// Solution Router endpoint (Dev 5 → Dev 3)
@@ -178,13 +346,13 @@ func main() {
 		}
 	}()
 
-	// ScoreService - 점수 산정 (Algorithmic Logic)
-	scoreService := service.NewScoreService()
-	log.Printf("[MAIN] ScoreService initialized")
-
 	// gRPC Server (Vision Service Input) on Port 50052
 	// gRPC Server (Vision Service Input) on Port 50052
-	inputGrpcServer := grpcIn.NewInputGrpcServer("50052", reflexService, scoreService, intelligenceAdapter)
+	inputGrpcServer := grpcIn.NewInputGrpcServer("50052", reflexService, scoreBoardService, intelligenceAdapter)
+	inputGrpcServer.SetAudioMonitor(audioMonitorService)
+	inputGrpcServer.SetGamification(gamificationService)
+	inputGrpcServer.SetFactBomb(activityUsageService)
+	inputGrpcServer.SetLeaderboard(leaderboardService)
 	if err := inputGrpcServer.Start(); err != nil {
 		log.Printf("[MAIN] Failed to start Input gRPC server: %v", err)
 	}
@@ -197,10 +365,40 @@ func main() {
 	log.Printf("[MAIN] Shutting down...")
 
 	// Cleanup
+	// 요청 수신을 먼저 멈추고, 저장을 마친 서비스들을 정리한 뒤 마지막에 DB 종료
+	// 1) HTTP
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := e.Shutdown(shutdownCtx); err != nil {
+		log.Printf("[MAIN] HTTP server shutdown failed: %v", err)
+	}
+
+	// 2) gRPC, Kafka
 	inputGrpcServer.Stop()
 	if stateConsumer != nil {
 		stateConsumer.Stop()
 	}
+
+	// 3) Watchers, syncer, services (Stop 시 저장)
+	if scoreModelWatcher != nil {
+		scoreModelWatcher.Stop()
+	}
+	if achievementRulesWatcher != nil {
+		achievementRulesWatcher.Stop()
+	}
+	if blacklistSyncer != nil {
+		blacklistSyncer.Stop()
+	}
+	scoreGaugeService.Stop()
+	gamificationService.Stop()
+	achievementService.Stop()
+	streakService.Stop()
+	leaderboardService.Stop()
+	weeklyReportService.Stop()
+
+	// 4) DB
+	dataDB.Close()
+
 	if dataRelayAdapter != nil {
 		dataRelayAdapter.Close()
 	}
@@ -214,15 +412,97 @@ func main() {
 // loadConfig 환경 변수에서 설정 로드
 func loadConfig() Config {
 	return Config{
-		HTTPPort:            getEnv("HTTP_PORT", "8080"),
-		KafkaBrokers:        getEnv("KAFKA_BOOTSTRAP_SERVERS", "localhost:9092"),
-		ActivityTopic:       getEnv("ACTIVITY_TOPIC", "client-activity"),
-		StateTopic:          getEnv("STATE_TOPIC", "command-state"),
-		PhysicalControlAddr: getEnv("PHYSICAL_CONTROL_ADDR", "localhost:50051"),
-		ScreenControlAddr:   getEnv("SCREEN_CONTROL_ADDR", "localhost:50052"),
-		SabotageCommandAddr: getEnv("SABOTAGE_CMD_ADDR", "localhost:50053"),
-		IntelligenceAddr:    getEnv("INTELLIGENCE_ADDR", "localhost:50051"),
+		HTTPPort:             getEnv("HTTP_PORT", "8080"),
+		KafkaBrokers:         getEnv("KAFKA_BOOTSTRAP_SERVERS", "localhost:9092"),
+		ActivityTopic:        getEnv("ACTIVITY_TOPIC", "client-activity"),
+		StateTopic:           getEnv("STATE_TOPIC", "command-state"),
+		PhysicalControlAddr:  getEnv("PHYSICAL_CONTROL_ADDR", "localhost:50051"),
+		ScreenControlAddr:    getEnv("SCREEN_CONTROL_ADDR", "localhost:50052"),
+		SabotageCommandAddr:  getEnv("SABOTAGE_CMD_ADDR", "localhost:50053"),
+		IntelligenceAddr:     getEnv("INTELLIGENCE_ADDR", "localhost:50051"),
+		ScoreModelPath:       getEnv("SCORE_MODEL_PATH", ""),
+		ScoreGaugeInterval:   getEnvDuration("SCORE_GAUGE_INTERVAL", service.DefaultScoreGaugeInterval),
+		AudioEmergency:       loadAudioEmergencyConfig(),
+		DataDBPath:           getEnv("DATA_DB_PATH", "data/jiaa-core.db"),
+		AchievementRulesPath: getEnv("ACHIEVEMENT_RULES_PATH", ""),
+		Streak:               loadStreakConfig(),
+		WeeklyReport:         loadWeeklyReportConfig(),
+		BlacklistSync:        loadBlacklistSyncConfig(),
+		UnlockPass:           loadUnlockPassConfig(),
+		ReflexThrottle:       loadReflexThrottleConfig(),
+		AdminAPIToken:        getEnv("ADMIN_API_TOKEN", ""),
+	}
+}
+
+// loadBlacklistSyncConfig Data Service 블랙리스트 동기화 설정 로드
+func loadBlacklistSyncConfig() datasync.BlacklistSyncConfig {
+	config := datasync.DefaultBlacklistSyncConfig()
+	if value, ok := os.LookupEnv("BLACKLIST_SYNC_URL"); ok {
+		config.URL = value
+	}
+	config.Interval = getEnvDuration("BLACKLIST_SYNC_INTERVAL", config.Interval)
+	config.MaxBackoff = getEnvDuration("BLACKLIST_SYNC_MAX_BACKOFF", config.MaxBackoff)
+	return config
+}
+
+// loadReflexThrottleConfig 반복 차단 억제 설정 로드 (0이면 억제하지 않음)
+func loadReflexThrottleConfig() service.ReflexThrottleConfig {
+	config := service.DefaultReflexThrottleConfig()
+	config.Cooldown = getEnvDuration("REFLEX_COOLDOWN", config.Cooldown)
+	config.DedupWindow = getEnvDuration("REFLEX_DEDUP_WINDOW", config.DedupWindow)
+	return config
+}
+
+// loadUnlockPassConfig 차단 해제 이용권 설정 로드 (형식 오류 시 기본값)
+func loadUnlockPassConfig() service.UnlockPassConfig {
+	config := service.DefaultUnlockPassConfig()
+	config.DefaultDuration = getEnvDuration("UNLOCK_PASS_DEFAULT_DURATION", config.DefaultDuration)
+	config.MaxDuration = getEnvDuration("UNLOCK_PASS_MAX_DURATION", config.MaxDuration)
+	config.MaxTimePerDay = getEnvDuration("UNLOCK_PASS_MAX_TIME_PER_DAY", config.MaxTimePerDay)
+	if value := os.Getenv("UNLOCK_PASS_MAX_PER_DAY"); value != "" {
+		if count, err := strconv.Atoi(value); err == nil {
+			config.MaxPassesPerDay = count
+		} else {
+			log.Printf("[MAIN] Warning: invalid UNLOCK_PASS_MAX_PER_DAY=%q, using %d", value, config.MaxPassesPerDay)
+		}
+	}
+	return config
+}
+
+// loadStreakConfig 하루 목표/연속 달성 설정 로드
+func loadStreakConfig() service.StreakConfig {
+	config := service.DefaultStreakConfig()
+	config.DefaultGoal = getEnvDuration("DAILY_GOAL_DEFAULT", config.DefaultGoal)
+	config.WarnBefore = getEnvDuration("STREAK_WARN_BEFORE", config.WarnBefore)
+	return config
+}
+
+// loadWeeklyReportConfig 주간 리포트 전달 일정 로드 (형식 오류 시 기본값)
+func loadWeeklyReportConfig() service.WeeklyReportConfig {
+	config := service.DefaultWeeklyReportConfig()
+	if value := os.Getenv("WEEKLY_REPORT_DAY"); value != "" {
+		if weekday, err := domain.ParseWeekday(value); err == nil {
+			config.DeliveryWeekday = weekday
+		} else {
+			log.Printf("[MAIN] Warning: %v, using %s", err, config.DeliveryWeekday)
+		}
+	}
+	if value := os.Getenv("WEEKLY_REPORT_TIME"); value != "" {
+		if clock, err := domain.ParseClock(value); err == nil {
+			config.DeliveryTime = clock
+		} else {
+			log.Printf("[MAIN] Warning: %v, using %s", err, config.DeliveryTime)
+		}
 	}
+	return config
+}
+
+// loadAudioEmergencyConfig 오디오 EMERGENCY 감지 설정 로드
+func loadAudioEmergencyConfig() service.AudioEmergencyConfig {
+	config := service.DefaultAudioEmergencyConfig()
+	config.ThresholdDBFS = getEnvFloat("AUDIO_EMERGENCY_DBFS", config.ThresholdDBFS)
+	config.Sustain = getEnvDuration("AUDIO_EMERGENCY_SUSTAIN", config.Sustain)
+	return config
 }
 
 // getEnv 환경 변수 조회 (기본값 지원)
@@ -232,3 +512,31 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// getEnvDuration 환경 변수를 Duration으로 조회 (형식 오류 시 기본값)
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("[MAIN] Warning: invalid %s=%q, using %s", key, value, defaultValue)
+		return defaultValue
+	}
+	return d
+}
+
+// getEnvFloat 환경 변수를 float64로 조회 (형식 오류 시 기본값)
+func getEnvFloat(key string, defaultValue float64) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Printf("[MAIN] Warning: invalid %s=%q, using %v", key, value, defaultValue)
+		return defaultValue
+	}
+	return f
+}
@@ -1,24 +1,31 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
 	// Input Service - Adapters In
+	configIn "jiaa-server-core/internal/input/adapter/in/config"
+	inputGrpcIn "jiaa-server-core/internal/input/adapter/in/grpc"
 	httpAdapter "jiaa-server-core/internal/input/adapter/in/http"
 	kafkaIn "jiaa-server-core/internal/input/adapter/in/kafka"
 
 	// Input Service - Adapters Out
+	boltOut "jiaa-server-core/internal/input/adapter/out/bolt"
 	inputGrpcOut "jiaa-server-core/internal/input/adapter/out/grpc"
 	kafkaOut "jiaa-server-core/internal/input/adapter/out/kafka"
 	"jiaa-server-core/internal/input/adapter/out/memory"
 
-	// Input Service - Services
+	// Input Service - Domain, Services
+	inputDomain "jiaa-server-core/internal/input/domain"
 	inputService "jiaa-server-core/internal/input/service"
 
 	// Output Service - Adapters In
@@ -34,10 +41,20 @@ import (
 // Config 통합 서버 설정
 type Config struct {
 	// Input Service
-	HTTPPort      string
-	KafkaBrokers  string
-	ActivityTopic string
-	StateTopic    string
+	HTTPPort             string
+	InputGRPCPort        string // SyncClient/StreamScore gRPC 포트
+	KafkaBrokers         string
+	ActivityTopic        string
+	StateTopic           string
+	LocalDecider         bool                              // Dev 6 없이 점수로 상태 판정 (Local Decider)
+	ScoreModelPath       string                            // 점수 모델 설정 파일 (비어 있으면 기본 모델)
+	ScoreGaugeInterval   time.Duration                     // 점수 게이지 푸시 주기
+	AudioEmergency       inputService.AudioEmergencyConfig // 오디오 EMERGENCY 감지 파라미터
+	DataDBPath           string                            // 임베디드 DB 파일 (게이미피케이션 진행도 등)
+	AchievementRulesPath string                            // 업적 규칙 파일 (비어 있으면 업적 없음)
+	Streak               inputService.StreakConfig         // 하루 목표/연속 달성 파라미터
+	WeeklyReport         inputService.WeeklyReportConfig   // 주간 리포트 전달 일정
+	AdminAPIToken        string                            // 관리자 전용 HTTP API Bearer 토큰 (비어 있으면 관리자 API 거부)
 
 	// Output Service
 	OutputGRPCPort string
@@ -97,17 +114,182 @@ func main() {
 	screenAdapter := inputGrpcOut.NewScreenControlAdapterLazy(config.ScreenControlAddr)
 	intelligenceAdapter := inputGrpcOut.NewIntelligenceAdapterLazy(config.IntelligenceAddr)
 
+	// Embedded DB (bbolt) - 영속 저장소
+	dataDB, err := boltOut.OpenDB(config.DataDBPath)
+	if err != nil {
+		log.Fatalf("[LOCAL] Failed to open data DB %s: %v", config.DataDBPath, err)
+	}
+	gamificationStore, err := boltOut.NewGamificationStore(dataDB)
+	if err != nil {
+		log.Fatalf("[LOCAL] Failed to initialize gamification store: %v", err)
+	}
+	achievementStore, err := boltOut.NewAchievementStore(dataDB)
+	if err != nil {
+		log.Fatalf("[LOCAL] Failed to initialize achievement store: %v", err)
+	}
+	streakStore, err := boltOut.NewStreakStore(dataDB)
+	if err != nil {
+		log.Fatalf("[LOCAL] Failed to initialize streak store: %v", err)
+	}
+	leaderboardStore, err := boltOut.NewLeaderboardStore(dataDB)
+	if err != nil {
+		log.Fatalf("[LOCAL] Failed to initialize leaderboard store: %v", err)
+	}
+	eventLogStore, err := boltOut.NewEventLogStore(dataDB)
+	if err != nil {
+		log.Fatalf("[LOCAL] Failed to initialize event log store: %v", err)
+	}
+	studySessionStore, err := boltOut.NewStudySessionStore(dataDB)
+	if err != nil {
+		log.Fatalf("[LOCAL] Failed to initialize study session store: %v", err)
+	}
+	unlockPassStore, err := boltOut.NewUnlockPassStore(dataDB)
+	if err != nil {
+		log.Fatalf("[LOCAL] Failed to initialize unlock pass store: %v", err)
+	}
+	activityUsageStore, err := boltOut.NewActivityUsageStore(dataDB, boltOut.DefaultUsageRetentionDays)
+	if err != nil {
+		log.Fatalf("[LOCAL] Failed to initialize activity usage store: %v", err)
+	}
+	// 그룹 블랙리스트 정책은 스터디 그룹 멤버십 기준
+	blacklistAdapter.SetMemberStore(leaderboardStore)
+	// 차단 규칙 저장소 - 저장된 정책으로 기본 블랙리스트 교체 (첫 실행이면 기본값 저장)
+	blacklistStore, err := boltOut.NewBlacklistStore(dataDB)
+	if err != nil {
+		log.Fatalf("[LOCAL] Failed to initialize blacklist store: %v", err)
+	}
+	blacklistService := inputService.NewBlacklistService(blacklistAdapter, blacklistStore)
+	if err := blacklistService.Load(); err != nil {
+		log.Fatalf("[LOCAL] Failed to load blacklist policies: %v", err)
+	}
+
 	// Input - Services
 	reflexService := inputService.NewReflexService(blacklistAdapter, sabotageAdapter, dataRelayAdapter)
+	reflexService.SetThrottle(inputService.DefaultReflexThrottleConfig())
+	activityUsageService := inputService.NewActivityUsageService(inputService.DefaultActivityUsageConfig(), activityUsageStore)
+	reflexService.SetActivityUsage(activityUsageService)
+	reflexService.SetFactBomb(activityUsageService)
 	commandRouterService := inputService.NewCommandRouterService(physicalAdapter, screenAdapter)
+	emergencyService := inputService.NewEmergencyService(intelligenceAdapter, screenAdapter)
+	commandRouterService.SetEmergencyHandler(emergencyService)
+	audioMonitorService := inputService.NewAudioMonitorService(config.AudioEmergency, commandRouterService)
 	solutionRouterService := inputService.NewSolutionRouterService(screenAdapter)
 
 	// Intelligence Adapter 로깅 (Dev 5 연결 확인)
 	log.Printf("[LOCAL] Intelligence Worker (Dev 5) address: %s", config.IntelligenceAddr)
-	_ = intelligenceAdapter // TODO: Emergency 프로토콜에서 사용 예정
+
+	// Score - 하트비트 기반 실시간 점수
+	scoreService := inputService.NewScoreService()
+	scoreBoardService := inputService.NewScoreBoardService(scoreService)
+
+	// Score History - 점수 시계열 (1s → 1m → 1h 다운샘플)
+	scoreHistoryAdapter := memory.NewScoreHistoryAdapter(memory.DefaultScoreRetention())
+	scoreBoardService.SetScoreHistory(scoreHistoryAdapter)
+	scoreHistoryService := inputService.NewScoreHistoryService(scoreHistoryAdapter)
+
+	// Score Gauge - 점수/상태 변경 시 클라이언트 게이지 푸시
+	scoreGaugeService := inputService.NewScoreGaugeService(screenAdapter, config.ScoreGaugeInterval)
+	scoreBoardService.AddScoreListener(scoreGaugeService)
+	scoreGaugeService.Start()
+
+	// Gamification - 집중/생각 시간 → 경험치/레벨 (GetGamificationInfo)
+	gamificationService := inputService.NewGamificationService(inputService.DefaultGamificationConfig(), gamificationStore, screenAdapter)
+	scoreBoardService.AddScoreListener(gamificationService)
+	gamificationService.Start()
+
+	// Achievements - 규칙 파일 기반 업적 (점수 스트림 + 차단/응급 이벤트)
+	achievementService := inputService.NewAchievementService(inputService.DefaultAchievementConfig(), achievementStore, screenAdapter)
+	scoreBoardService.AddScoreListener(achievementService)
+	reflexService.AddEventRecorder(achievementService)
+	emergencyService.AddEventRecorder(achievementService)
+	gamificationService.SetAchievements(achievementService)
+	achievementService.Start()
+
+	var achievementRulesWatcher *configIn.AchievementRulesWatcher
+	if config.AchievementRulesPath != "" {
+		achievementRulesWatcher = configIn.NewAchievementRulesWatcher(config.AchievementRulesPath, 5*time.Second, achievementService)
+		if err := achievementRulesWatcher.Load(); err != nil {
+			log.Printf("[LOCAL] Warning: Failed to load achievement rules: %v", err)
+		}
+		achievementRulesWatcher.Start()
+	}
+
+	// StreakService - 하루 집중 목표/연속 달성 (자정 전 목표 미달 경고), 업적 daily_streak도 클라이언트 시간대 기준
+	streakService := inputService.NewStreakService(config.Streak, streakStore, screenAdapter)
+	scoreBoardService.AddScoreListener(streakService)
+	gamificationService.SetStreaks(streakService)
+	achievementService.SetTimezones(streakService)
+	streakService.Start()
+
+	// StudySessionService - 학습 세션 선언 (세션 한정 차단 규칙), 차단 일정은 클라이언트 시간대 기준
+	studySessionService := inputService.NewStudySessionService(studySessionStore)
+	reflexService.SetStudySessions(studySessionService)
+	reflexService.SetTimezones(streakService)
+
+	// UnlockPassService - 차단 해제 이용권 (하루 한도는 클라이언트 시간대 기준, 발급/사용은 감사 기록)
+	unlockPassService := inputService.NewUnlockPassService(inputService.DefaultUnlockPassConfig(), unlockPassStore, unlockPassStore, blacklistAdapter)
+	unlockPassService.SetTimezones(streakService)
+	reflexService.SetUnlockPasses(unlockPassService)
+
+	// LeaderboardService - 스터디 그룹 리더보드 (일간/주간: 하루 기록, 누적: 게이미피케이션)
+	leaderboardService := inputService.NewLeaderboardService(inputService.DefaultLeaderboardConfig(), leaderboardStore, leaderboardStore)
+	leaderboardService.SetGamification(gamificationService)
+	// 멤버십 변경 시 그룹 블랙리스트 정책 캐시 갱신
+	leaderboardService.OnMembershipChange(blacklistAdapter.UpdateMembership)
+	scoreBoardService.AddScoreListener(leaderboardService)
+	leaderboardService.Start()
+
+	// WeeklyReportService - 주간 학습 리포트 (정해진 시각에 SolutionRouter로 전달)
+	weeklyReportService := inputService.NewWeeklyReportService(config.WeeklyReport, leaderboardService, eventLogStore, solutionRouterService)
+	weeklyReportService.SetActivityUsage(activityUsageService)
+	scoreBoardService.AddScoreListener(weeklyReportService)
+	reflexService.AddEventRecorder(weeklyReportService)
+	emergencyService.AddEventRecorder(weeklyReportService)
+	weeklyReportService.Start()
+
+	var scoreModelWatcher *configIn.ScoreModelWatcher
+	if config.ScoreModelPath != "" {
+		scoreModelWatcher = configIn.NewScoreModelWatcher(config.ScoreModelPath, 5*time.Second, scoreService)
+		if err := scoreModelWatcher.Load(); err != nil {
+			log.Printf("[LOCAL] Warning: Failed to load score model, using defaults: %v", err)
+		}
+		scoreModelWatcher.Start()
+	}
+
+	// Local Decider - 점수 → StateCommand → CommandRouter (Kafka/Dev 6 불필요)
+	if config.LocalDecider {
+		stateDecider := inputService.NewStateDeciderService(inputService.DefaultStateDeciderConfig(), commandRouterService)
+		scoreBoardService.SetStateDecider(stateDecider)
+		log.Println("[LOCAL] Local decider enabled (score → state without Dev 6)")
+	}
 
 	// Input - Driving Adapters
 	activityHandler := httpAdapter.NewActivityHandler(reflexService)
+	scoreHistoryHandler := httpAdapter.NewScoreHistoryHandler(scoreHistoryService)
+	streakHandler := httpAdapter.NewStreakHandler(streakService)
+	leaderboardHandler := httpAdapter.NewLeaderboardHandler(leaderboardService, leaderboardService)
+	reportHandler := httpAdapter.NewReportHandler(weeklyReportService)
+	studySessionHandler := httpAdapter.NewStudySessionHandler(studySessionService)
+	blacklistHandler := httpAdapter.NewBlacklistHandler(reflexService, blacklistService)
+	unlockPassHandler := httpAdapter.NewUnlockPassHandler(unlockPassService)
+
+	adminAuth := httpAdapter.NewAdminAuth(config.AdminAPIToken)
+	if !adminAuth.Enabled() {
+		log.Printf("[LOCAL] Warning: ADMIN_API_TOKEN not set, admin-only HTTP APIs are disabled")
+	}
+	leaderboardHandler.SetAdminAuth(adminAuth)
+	unlockPassHandler.SetAdminAuth(adminAuth)
+	blacklistHandler.SetAdminAuth(adminAuth)
+
+	// gRPC Server (SyncClient, StreamScore)
+	inputGrpcServer := inputGrpcIn.NewInputGrpcServer(config.InputGRPCPort, reflexService, scoreBoardService, intelligenceAdapter)
+	inputGrpcServer.SetAudioMonitor(audioMonitorService)
+	inputGrpcServer.SetGamification(gamificationService)
+	inputGrpcServer.SetFactBomb(activityUsageService)
+	inputGrpcServer.SetLeaderboard(leaderboardService)
+	if err := inputGrpcServer.Start(); err != nil {
+		log.Fatalf("[LOCAL] Failed to start Input gRPC server: %v", err)
+	}
 
 	// Kafka Consumer (optional)
 	var stateConsumer *kafkaIn.StateConsumer
@@ -136,6 +318,13 @@ func main() {
 
 	// Routes
 	activityHandler.RegisterRoutes(e)
+	scoreHistoryHandler.RegisterRoutes(e)
+	streakHandler.RegisterRoutes(e)
+	leaderboardHandler.RegisterRoutes(e)
+	reportHandler.RegisterRoutes(e)
+	studySessionHandler.RegisterRoutes(e)
+	blacklistHandler.RegisterRoutes(e)
+	unlockPassHandler.RegisterRoutes(e)
 
 	// Health check
 	e.GET("/health", func(c echo.Context) error {
@@ -144,6 +333,8 @@ func main() {
 			"mode":           "local",
 			"input_service":  "running",
 			"output_service": "running",
+			"local_decider":  config.LocalDecider,
+			"reflex":         reflexService.Metrics(),
 		})
 	})
 
@@ -175,7 +366,7 @@ func main() {
 	// ========================================
 	log.Println("============================================")
 	log.Printf("  HTTP API: http://localhost:%s", config.HTTPPort)
-	log.Printf("  gRPC:     localhost:%s", config.OutputGRPCPort)
+	log.Printf("  gRPC:     localhost:%s (input), localhost:%s (output)", config.InputGRPCPort, config.OutputGRPCPort)
 	log.Println("  Press Ctrl+C to stop")
 	log.Println("============================================")
 
@@ -189,13 +380,41 @@ func main() {
 	log.Println("[LOCAL] Shutting down...")
 
 	// Cleanup
+	// 요청 수신을 먼저 멈추고, 저장을 마친 서비스들을 정리한 뒤 마지막에 DB 종료
+	// 1) HTTP
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := e.Shutdown(shutdownCtx); err != nil {
+		log.Printf("[LOCAL] HTTP server shutdown failed: %v", err)
+	}
+
+	// 2) gRPC, Kafka
+	inputGrpcServer.Stop()
+	outputServer.Stop()
 	if stateConsumer != nil {
 		stateConsumer.Stop()
 	}
+
+	// 3) Watchers, services (Stop 시 저장)
+	if scoreModelWatcher != nil {
+		scoreModelWatcher.Stop()
+	}
+	if achievementRulesWatcher != nil {
+		achievementRulesWatcher.Stop()
+	}
+	scoreGaugeService.Stop()
+	gamificationService.Stop()
+	achievementService.Stop()
+	streakService.Stop()
+	leaderboardService.Stop()
+	weeklyReportService.Stop()
+
+	// 4) DB
+	dataDB.Close()
+
 	if dataRelayAdapter != nil {
 		dataRelayAdapter.Close()
 	}
-	outputServer.Stop()
 	sabotageAdapter.Close()
 	physicalAdapter.Close()
 	screenAdapter.Close()
@@ -208,15 +427,61 @@ func main() {
 // loadConfig 환경 변수에서 설정 로드
 func loadConfig() Config {
 	return Config{
-		HTTPPort:            getEnv("HTTP_PORT", "8080"),
-		KafkaBrokers:        getEnv("KAFKA_BROKERS", ""),
-		ActivityTopic:       getEnv("ACTIVITY_TOPIC", "client-activity"),
-		StateTopic:          getEnv("STATE_TOPIC", "command-state"),
-		OutputGRPCPort:      getEnv("OUTPUT_GRPC_PORT", "50053"),
-		PhysicalControlAddr: getEnv("PHYSICAL_CONTROL_ADDR", "localhost:50051"),
-		ScreenControlAddr:   getEnv("SCREEN_CONTROL_ADDR", "localhost:50052"),
-		IntelligenceAddr:    getEnv("INTELLIGENCE_ADDR", "localhost:50051"), // Dev 5
+		HTTPPort:             getEnv("HTTP_PORT", "8080"),
+		InputGRPCPort:        getEnv("INPUT_GRPC_PORT", "50052"),
+		KafkaBrokers:         getEnv("KAFKA_BROKERS", ""),
+		ActivityTopic:        getEnv("ACTIVITY_TOPIC", "client-activity"),
+		StateTopic:           getEnv("STATE_TOPIC", "command-state"),
+		OutputGRPCPort:       getEnv("OUTPUT_GRPC_PORT", "50053"),
+		PhysicalControlAddr:  getEnv("PHYSICAL_CONTROL_ADDR", "localhost:50051"),
+		ScreenControlAddr:    getEnv("SCREEN_CONTROL_ADDR", "localhost:50052"),
+		IntelligenceAddr:     getEnv("INTELLIGENCE_ADDR", "localhost:50051"), // Dev 5
+		LocalDecider:         getEnv("LOCAL_DECIDER", "true") == "true",
+		ScoreModelPath:       getEnv("SCORE_MODEL_PATH", ""),
+		ScoreGaugeInterval:   getEnvDuration("SCORE_GAUGE_INTERVAL", inputService.DefaultScoreGaugeInterval),
+		AudioEmergency:       loadAudioEmergencyConfig(),
+		DataDBPath:           getEnv("DATA_DB_PATH", "data/jiaa-core.db"),
+		AchievementRulesPath: getEnv("ACHIEVEMENT_RULES_PATH", ""),
+		Streak:               loadStreakConfig(),
+		WeeklyReport:         loadWeeklyReportConfig(),
+		AdminAPIToken:        getEnv("ADMIN_API_TOKEN", ""),
+	}
+}
+
+// loadStreakConfig 하루 목표/연속 달성 설정 로드
+func loadStreakConfig() inputService.StreakConfig {
+	config := inputService.DefaultStreakConfig()
+	config.DefaultGoal = getEnvDuration("DAILY_GOAL_DEFAULT", config.DefaultGoal)
+	config.WarnBefore = getEnvDuration("STREAK_WARN_BEFORE", config.WarnBefore)
+	return config
+}
+
+// loadWeeklyReportConfig 주간 리포트 전달 일정 로드 (형식 오류 시 기본값)
+func loadWeeklyReportConfig() inputService.WeeklyReportConfig {
+	config := inputService.DefaultWeeklyReportConfig()
+	if value := os.Getenv("WEEKLY_REPORT_DAY"); value != "" {
+		if weekday, err := inputDomain.ParseWeekday(value); err == nil {
+			config.DeliveryWeekday = weekday
+		} else {
+			log.Printf("[LOCAL] Warning: %v, using %s", err, config.DeliveryWeekday)
+		}
+	}
+	if value := os.Getenv("WEEKLY_REPORT_TIME"); value != "" {
+		if clock, err := inputDomain.ParseClock(value); err == nil {
+			config.DeliveryTime = clock
+		} else {
+			log.Printf("[LOCAL] Warning: %v, using %s", err, config.DeliveryTime)
+		}
 	}
+	return config
+}
+
+// loadAudioEmergencyConfig 오디오 EMERGENCY 감지 설정 로드
+func loadAudioEmergencyConfig() inputService.AudioEmergencyConfig {
+	config := inputService.DefaultAudioEmergencyConfig()
+	config.ThresholdDBFS = getEnvFloat("AUDIO_EMERGENCY_DBFS", config.ThresholdDBFS)
+	config.Sustain = getEnvDuration("AUDIO_EMERGENCY_SUSTAIN", config.Sustain)
+	return config
 }
 
 // getEnv 환경 변수 조회 (기본값 지원)
@@ -226,3 +491,31 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// getEnvDuration 환경 변수를 Duration으로 조회 (형식 오류 시 기본값)
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("[LOCAL] Warning: invalid %s=%q, using %s", key, value, defaultValue)
+		return defaultValue
+	}
+	return d
+}
+
+// getEnvFloat 환경 변수를 float64로 조회 (형식 오류 시 기본값)
+func getEnvFloat(key string, defaultValue float64) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Printf("[LOCAL] Warning: invalid %s=%q, using %v", key, value, defaultValue)
+		return defaultValue
+	}
+	return f
+}
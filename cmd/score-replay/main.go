@@ -0,0 +1,299 @@
+// score-replay 녹화된 ClientHeartbeat 스트림을 오프라인으로 재생하는 도구
+//
+// 라이브 서버와 같은 세션 트래커(ScoreBoardService)와 ScoreService, Local Decider를
+// 시뮬레이션 시계로 돌려 점수/상태 타임라인과 발생했을 StateCommand를 출력한다.
+// 실제 클라이언트 없이 녹화된 세션으로 임계값을 튜닝하기 위한 용도.
+//
+// 입력 형식:
+//   - jsonl: 한 줄에 ClientHeartbeat 하나 (protojson, 필드명은 client_id 등 proto 이름)
+//   - pb:    length-delimited protobuf (varint 길이 + ClientHeartbeat 바이트 반복)
+//
+// 사용 예:
+//
+//	go run ./cmd/score-replay -input session.jsonl -model config/score_model.example.json
+//	go run ./cmd/score-replay -input session.pb -changes
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	// Adapters - In
+	configIn "jiaa-server-core/internal/input/adapter/in/config"
+	grpcIn "jiaa-server-core/internal/input/adapter/in/grpc"
+
+	"jiaa-server-core/internal/input/domain"
+	"jiaa-server-core/internal/input/service"
+	"jiaa-server-core/pkg/proto"
+)
+
+// Config 재생 설정
+type Config struct {
+	InputPath    string        // 입력 파일 ("-"이면 stdin)
+	Format       string        // auto | jsonl | pb
+	ModelPath    string        // 점수 모델 설정 파일 (비어 있으면 기본 모델)
+	Start        time.Time     // 시뮬레이션 시작 시간
+	Interval     time.Duration // 클라이언트별 하트비트 간격
+	Decider      service.StateDeciderConfig
+	ChangesOnly  bool // 상태가 바뀐 틱만 출력
+	ServiceLogs  bool // 서비스 로그 출력
+	MaxLineBytes int  // jsonl 한 줄 최대 크기
+}
+
+func main() {
+	config, err := parseFlags()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "score-replay: %v\n", err)
+		os.Exit(2)
+	}
+
+	if !config.ServiceLogs {
+		log.SetOutput(io.Discard)
+	}
+
+	if err := run(config, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "score-replay: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// parseFlags 명령행 인자 파싱
+func parseFlags() (Config, error) {
+	defaults := service.DefaultStateDeciderConfig()
+
+	config := Config{MaxLineBytes: 1 << 20}
+	var start string
+	flag.StringVar(&config.InputPath, "input", "-", "recorded heartbeat file (- for stdin)")
+	flag.StringVar(&config.Format, "format", "auto", "input format: auto, jsonl, pb")
+	flag.StringVar(&config.ModelPath, "model", "", "score model file (default: builtin model)")
+	flag.StringVar(&start, "start", "", "simulated start time in RFC3339 (default: 1970-01-01T00:00:00Z)")
+	flag.DurationVar(&config.Interval, "interval", domain.HeartbeatInterval, "simulated heartbeat interval per client")
+	flag.DurationVar(&config.Decider.MinDwell, "min-dwell", defaults.MinDwell, "decider minimum dwell time")
+	flag.DurationVar(&config.Decider.Confirm, "confirm", defaults.Confirm, "decider confirmation time")
+	flag.IntVar(&config.Decider.Hysteresis, "hysteresis", defaults.Hysteresis, "decider score hysteresis")
+	flag.BoolVar(&config.ChangesOnly, "changes", false, "print only ticks where the state changed")
+	flag.BoolVar(&config.ServiceLogs, "v", false, "print service logs to stderr")
+	flag.Parse()
+
+	config.Start = time.Unix(0, 0).UTC()
+	if start != "" {
+		parsed, err := time.Parse(time.RFC3339, start)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid -start: %w", err)
+		}
+		config.Start = parsed
+	}
+	if config.Interval <= 0 {
+		return Config{}, errors.New("-interval must be positive")
+	}
+
+	format, err := resolveFormat(config.Format, config.InputPath)
+	if err != nil {
+		return Config{}, err
+	}
+	config.Format = format
+	return config, nil
+}
+
+// resolveFormat auto 형식을 파일 확장자로 결정 (stdin은 jsonl)
+func resolveFormat(format, path string) (string, error) {
+	switch format {
+	case "jsonl", "pb":
+		return format, nil
+	case "auto":
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".pb", ".bin", ".binpb":
+			return "pb", nil
+		default:
+			return "jsonl", nil
+		}
+	default:
+		return "", fmt.Errorf("unsupported -format %q (use auto, jsonl, pb)", format)
+	}
+}
+
+// run 입력을 끝까지 재생하며 타임라인 출력
+func run(config Config, out io.Writer) error {
+	input := os.Stdin
+	if config.InputPath != "-" {
+		file, err := os.Open(config.InputPath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		input = file
+	}
+
+	reader := newHeartbeatReader(config, input)
+
+	// 라이브 서버와 같은 구성: ScoreService → ScoreBoardService → StateDeciderService
+	scoreService := service.NewScoreService()
+	if config.ModelPath != "" {
+		model, err := configIn.ReadScoreModelFile(config.ModelPath)
+		if err != nil {
+			return err
+		}
+		if err := scoreService.UpdateScoreModel(model); err != nil {
+			return err
+		}
+	}
+
+	recorder := &commandRecorder{}
+	scoreBoardService := service.NewScoreBoardService(scoreService)
+	scoreBoardService.SetStateDecider(service.NewStateDeciderService(config.Decider, recorder))
+
+	fmt.Fprintf(out, "# model=%s min-dwell=%s confirm=%s hysteresis=%d\n",
+		scoreService.CurrentScoreModel().Version, config.Decider.MinDwell, config.Decider.Confirm, config.Decider.Hysteresis)
+	fmt.Fprintf(out, "%-10s %-12s %5s  %-10s %5s %5s %5s %5s %5s %5s\n",
+		"ELAPSED", "CLIENT", "SCORE", "STATE", "HEAD", "EYE", "AUDIO", "ACT", "IDLE", "TYPE")
+
+	ticks := make(map[string]int)         // 클라이언트별 하트비트 수 (시뮬레이션 시계)
+	lastStates := make(map[string]string) // 클라이언트별 직전 ScoreService 상태
+	commandCounts := make(map[string]int) // 상태별 StateCommand 수
+	heartbeats := 0
+
+	for {
+		heartbeat, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("heartbeat #%d: %w", heartbeats+1, err)
+		}
+		heartbeats++
+
+		clientID := heartbeat.ClientId
+		now := config.Start.Add(time.Duration(ticks[clientID]) * config.Interval)
+		ticks[clientID]++
+
+		snapshot := scoreBoardService.ProcessHeartbeat(grpcIn.ToDomainHeartbeat(heartbeat, now))
+		commands := recorder.drain()
+
+		changed := lastStates[clientID] != snapshot.State
+		lastStates[clientID] = snapshot.State
+		if !config.ChangesOnly || changed || len(commands) > 0 {
+			printSnapshot(out, config.Start, snapshot)
+		}
+		for _, cmd := range commands {
+			commandCounts[string(cmd.State)]++
+			fmt.Fprintf(out, "%-10s %-12s >> StateCommand %s (priority %d)\n",
+				elapsed(config.Start, cmd.Timestamp), cmd.ClientID, cmd.State, cmd.Priority)
+		}
+	}
+
+	states := make([]string, 0, len(commandCounts))
+	total := 0
+	for state, count := range commandCounts {
+		states = append(states, state)
+		total += count
+	}
+	sort.Strings(states)
+
+	summary := fmt.Sprintf("# heartbeats=%d clients=%d state_commands=%d", heartbeats, len(ticks), total)
+	for _, state := range states {
+		summary += fmt.Sprintf(" %s=%d", state, commandCounts[state])
+	}
+	fmt.Fprintln(out, summary)
+	return nil
+}
+
+// printSnapshot 산정 결과 한 줄 출력
+func printSnapshot(out io.Writer, start time.Time, snapshot domain.ScoreSnapshot) {
+	b := snapshot.Breakdown
+	fmt.Fprintf(out, "%-10s %-12s %5d  %-10s %5d %5d %5d %5d %5d %5d\n",
+		elapsed(start, snapshot.Timestamp), snapshot.ClientID, snapshot.Score, snapshot.State,
+		b.HeadPose, b.EyeFocus, b.Audio, b.Activity, b.Idle, b.Typing)
+}
+
+// elapsed 시뮬레이션 시작 기준 경과 시간 (+HH:MM:SS)
+func elapsed(start, at time.Time) string {
+	d := at.Sub(start)
+	return fmt.Sprintf("+%02d:%02d:%02d", int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60)
+}
+
+// commandRecorder 발생한 StateCommand를 모아두는 StateReceiverUseCase
+// 실제 CommandRouter 대신 연결하여 Dev 1/3으로 아무것도 보내지 않음
+type commandRecorder struct {
+	pending []domain.StateCommand
+}
+
+// HandleStateChange StateCommand 기록
+func (r *commandRecorder) HandleStateChange(cmd domain.StateCommand) error {
+	r.pending = append(r.pending, cmd)
+	return nil
+}
+
+// drain 기록된 StateCommand를 꺼내고 비움
+func (r *commandRecorder) drain() []domain.StateCommand {
+	commands := r.pending
+	r.pending = nil
+	return commands
+}
+
+// heartbeatReader 녹화된 ClientHeartbeat 순차 읽기 (끝이면 io.EOF)
+type heartbeatReader interface {
+	Next() (*proto.ClientHeartbeat, error)
+}
+
+// newHeartbeatReader 형식에 맞는 reader 생성
+func newHeartbeatReader(config Config, input io.Reader) heartbeatReader {
+	if config.Format == "pb" {
+		return &delimitedReader{reader: bufio.NewReader(input)}
+	}
+	scanner := bufio.NewScanner(input)
+	scanner.Buffer(make([]byte, 0, 64*1024), config.MaxLineBytes)
+	return &jsonlReader{scanner: scanner}
+}
+
+// jsonlReader JSON Lines reader (빈 줄과 #으로 시작하는 줄은 무시)
+type jsonlReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+// Next 다음 하트비트 읽기
+func (r *jsonlReader) Next() (*proto.ClientHeartbeat, error) {
+	for r.scanner.Scan() {
+		r.line++
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		heartbeat := &proto.ClientHeartbeat{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(line, heartbeat); err != nil {
+			return nil, fmt.Errorf("line %d: %w", r.line, err)
+		}
+		return heartbeat, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// delimitedReader length-delimited protobuf reader
+type delimitedReader struct {
+	reader *bufio.Reader
+}
+
+// Next 다음 하트비트 읽기
+func (r *delimitedReader) Next() (*proto.ClientHeartbeat, error) {
+	heartbeat := &proto.ClientHeartbeat{}
+	if err := protodelim.UnmarshalFrom(r.reader, heartbeat); err != nil {
+		return nil, err
+	}
+	return heartbeat, nil
+}
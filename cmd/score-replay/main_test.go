@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+
+	"jiaa-server-core/internal/input/domain"
+	"jiaa-server-core/internal/input/service"
+)
+
+// 점수 모델/Decider를 바꿨다면 출력 차이를 확인한 뒤 -update로 골든 파일 갱신:
+//
+//	go test ./cmd/score-replay -update
+var update = flag.Bool("update", false, "rewrite testdata golden files")
+
+// fixtureConfig 테스트 픽스처 재생 설정 (Decider는 짧은 픽스처에 맞춰 2초로 단축)
+func fixtureConfig(path, format string) Config {
+	return Config{
+		InputPath: path,
+		Format:    format,
+		Start:     time.Unix(0, 0).UTC(),
+		Interval:  domain.HeartbeatInterval,
+		Decider: service.StateDeciderConfig{
+			MinDwell:   2 * time.Second,
+			Confirm:    2 * time.Second,
+			Hysteresis: service.DefaultStateDeciderConfig().Hysteresis,
+		},
+		MaxLineBytes: 1 << 20,
+	}
+}
+
+// replay 픽스처 재생 결과
+func replay(t *testing.T, config Config) string {
+	t.Helper()
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	var out bytes.Buffer
+	if err := run(config, &out); err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	return out.String()
+}
+
+func TestRun_Golden(t *testing.T) {
+	got := replay(t, fixtureConfig(filepath.Join("testdata", "session.jsonl"), "jsonl"))
+
+	golden := filepath.Join("testdata", "session.golden")
+	if *update {
+		if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+			t.Fatalf("failed to update golden file: %v", err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+	if got != string(want) {
+		t.Errorf("replay output differs from %s (run with -update if the change is intended)\n--- got ---\n%s--- want ---\n%s", golden, got, want)
+	}
+}
+
+func TestRun_ScoresAndStates(t *testing.T) {
+	got := replay(t, fixtureConfig(filepath.Join("testdata", "session.jsonl"), "jsonl"))
+
+	// 경과 시간/클라이언트별 점수와 상태
+	tests := []struct {
+		elapsed  string
+		clientID string
+		score    string
+		state    string
+	}{
+		{"+00:00:00", "pc-01", "100", "FOCUSING"},
+		{"+00:00:08", "pc-01", "38", "FOCUSING"},
+		{"+00:00:10", "pc-01", "0", "SLEEPING"},
+		{"+00:00:16", "pc-01", "0", "NEUTRAL"},
+		{"+00:00:22", "pc-01", "85", "FOCUSING"},
+		{"+00:00:00", "pc-02", "90", "FOCUSING"},
+		{"+00:00:01", "pc-02", "80", "FOCUSING"},
+	}
+	for _, tt := range tests {
+		found := false
+		for _, line := range strings.Split(got, "\n") {
+			fields := strings.Fields(line)
+			if len(fields) < 4 || fields[0] != tt.elapsed || fields[1] != tt.clientID || fields[2] == ">>" {
+				continue
+			}
+			found = true
+			if fields[2] != tt.score || fields[3] != tt.state {
+				t.Errorf("%s %s: expected %s %s, got %s %s", tt.elapsed, tt.clientID, tt.score, tt.state, fields[2], fields[3])
+			}
+		}
+		if !found {
+			t.Errorf("%s %s: tick not found in output", tt.elapsed, tt.clientID)
+		}
+	}
+
+	for _, want := range []string{
+		"+00:00:10  pc-01        >> StateCommand SLEEPING (priority 8)",
+		"# heartbeats=30 clients=2 state_commands=3 SLEEPING=1 THINKING=2",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected output to contain %q", want)
+		}
+	}
+}
+
+func TestRun_DelimitedMatchesJSONL(t *testing.T) {
+	jsonlPath := filepath.Join("testdata", "session.jsonl")
+	file, err := os.Open(jsonlPath)
+	if err != nil {
+		t.Fatalf("failed to open fixture: %v", err)
+	}
+	defer file.Close()
+
+	// jsonl 픽스처를 length-delimited protobuf로 변환
+	var encoded bytes.Buffer
+	reader := newHeartbeatReader(fixtureConfig(jsonlPath, "jsonl"), file)
+	for {
+		heartbeat, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("failed to read fixture: %v", err)
+		}
+		if _, err := protodelim.MarshalTo(&encoded, heartbeat); err != nil {
+			t.Fatalf("failed to encode heartbeat: %v", err)
+		}
+	}
+	pbPath := filepath.Join(t.TempDir(), "session.pb")
+	if err := os.WriteFile(pbPath, encoded.Bytes(), 0o644); err != nil {
+		t.Fatalf("failed to write pb fixture: %v", err)
+	}
+
+	want := replay(t, fixtureConfig(jsonlPath, "jsonl"))
+	if got := replay(t, fixtureConfig(pbPath, "pb")); got != want {
+		t.Errorf("pb replay differs from jsonl replay\n--- pb ---\n%s--- jsonl ---\n%s", got, want)
+	}
+}
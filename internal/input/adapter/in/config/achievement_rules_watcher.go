@@ -0,0 +1,182 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+)
+
+// AchievementRulesWatcher 업적 규칙 파일 감시자 (Driving Adapter)
+// 파일 변경(수정 시간)을 주기적으로 확인하여 재시작 없이 업적 규칙을 교체
+type AchievementRulesWatcher struct {
+	path        string
+	interval    time.Duration
+	useCase     portin.AchievementRuleUseCase
+	lastModTime time.Time
+	stopChan    chan struct{}
+}
+
+// AchievementRulesFile 업적 규칙 파일 구조체 (JSON)
+type AchievementRulesFile struct {
+	Version      string                `json:"version"`
+	Achievements []AchievementRuleFile `json:"achievements"`
+}
+
+// AchievementRuleFile 업적 규칙 하나 (시간은 "3h", "30m" 같은 Go duration 문자열)
+type AchievementRuleFile struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	Type         string   `json:"type"`
+	States       []string `json:"states,omitempty"`
+	Duration     string   `json:"duration,omitempty"`
+	ResetOn      []string `json:"reset_on,omitempty"`
+	Days         int      `json:"days,omitempty"`
+	DailyMinimum string   `json:"daily_minimum,omitempty"`
+	Event        string   `json:"event,omitempty"`
+	Count        int      `json:"count,omitempty"`
+}
+
+// NewAchievementRulesWatcher AchievementRulesWatcher 생성자
+func NewAchievementRulesWatcher(path string, interval time.Duration, useCase portin.AchievementRuleUseCase) *AchievementRulesWatcher {
+	return &AchievementRulesWatcher{
+		path:     path,
+		interval: interval,
+		useCase:  useCase,
+		stopChan: make(chan struct{}),
+	}
+}
+
+// Load 규칙 파일을 읽어 즉시 적용
+func (w *AchievementRulesWatcher) Load() error {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return err
+	}
+
+	ruleSet, err := ReadAchievementRulesFile(w.path)
+	if err != nil {
+		return err
+	}
+
+	if err := w.useCase.UpdateAchievementRules(ruleSet); err != nil {
+		return err
+	}
+
+	w.lastModTime = info.ModTime()
+	log.Printf("[ACHIEVEMENT_RULES] Loaded %d achievements (%s) from %s", len(ruleSet.Rules), ruleSet.Version, w.path)
+	return nil
+}
+
+// Start 파일 감시 시작 (백그라운드)
+func (w *AchievementRulesWatcher) Start() {
+	log.Printf("[ACHIEVEMENT_RULES] Watching %s (interval: %v)", w.path, w.interval)
+	go w.watchLoop()
+}
+
+// Stop 파일 감시 중지
+func (w *AchievementRulesWatcher) Stop() {
+	close(w.stopChan)
+	log.Printf("[ACHIEVEMENT_RULES] Stopped")
+}
+
+// watchLoop 수정 시간이 바뀌면 재적용
+// 잘못된 규칙은 로그만 남기고 기존 규칙을 유지
+func (w *AchievementRulesWatcher) watchLoop() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stopChan:
+			return
+		case <-ticker.C:
+			info, err := os.Stat(w.path)
+			if err != nil {
+				log.Printf("[ACHIEVEMENT_RULES] Failed to stat %s: %v", w.path, err)
+				continue
+			}
+			if !info.ModTime().After(w.lastModTime) {
+				continue
+			}
+
+			if err := w.Load(); err != nil {
+				log.Printf("[ACHIEVEMENT_RULES] Rejected rules reload, keeping %s: %v",
+					w.useCase.CurrentAchievementRules().Version, err)
+				// 같은 파일로 재시도하지 않도록 수정 시간은 기록
+				w.lastModTime = info.ModTime()
+			}
+		}
+	}
+}
+
+// ReadAchievementRulesFile 규칙 파일을 읽어 AchievementRuleSet으로 변환
+func ReadAchievementRulesFile(path string) (domain.AchievementRuleSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return domain.AchievementRuleSet{}, err
+	}
+
+	var file AchievementRulesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return domain.AchievementRuleSet{}, fmt.Errorf("invalid achievement rules file %s: %w", path, err)
+	}
+
+	ruleSet := domain.AchievementRuleSet{Version: file.Version}
+	for _, f := range file.Achievements {
+		rule, err := f.toDomain()
+		if err != nil {
+			return domain.AchievementRuleSet{}, err
+		}
+		ruleSet.Rules = append(ruleSet.Rules, rule)
+	}
+
+	if err := ruleSet.Validate(); err != nil {
+		return domain.AchievementRuleSet{}, err
+	}
+	return ruleSet, nil
+}
+
+// toDomain 규칙 파일 항목을 Domain 규칙으로 변환
+func (f AchievementRuleFile) toDomain() (domain.AchievementRule, error) {
+	duration, err := parseOptionalDuration(f.Duration)
+	if err != nil {
+		return domain.AchievementRule{}, fmt.Errorf("achievement %s: invalid duration: %w", f.ID, err)
+	}
+	dailyMinimum, err := parseOptionalDuration(f.DailyMinimum)
+	if err != nil {
+		return domain.AchievementRule{}, fmt.Errorf("achievement %s: invalid daily_minimum: %w", f.ID, err)
+	}
+
+	resetOn := make([]domain.ProgressEventType, 0, len(f.ResetOn))
+	for _, event := range f.ResetOn {
+		resetOn = append(resetOn, domain.ProgressEventType(event))
+	}
+
+	return domain.AchievementRule{
+		ID:           f.ID,
+		Name:         f.Name,
+		Description:  f.Description,
+		Type:         domain.AchievementRuleType(f.Type),
+		States:       f.States,
+		Duration:     duration,
+		ResetOn:      resetOn,
+		Days:         f.Days,
+		DailyMinimum: dailyMinimum,
+		Event:        domain.ProgressEventType(f.Event),
+		Count:        f.Count,
+	}, nil
+}
+
+// parseOptionalDuration 빈 문자열은 0으로 처리
+func parseOptionalDuration(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(value)
+}
@@ -0,0 +1,202 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+)
+
+// ScoreModelWatcher 점수 모델 설정 파일 감시자 (Driving Adapter)
+// 파일 변경(수정 시간)을 주기적으로 확인하여 재시작 없이 ScoreModel을 교체
+type ScoreModelWatcher struct {
+	path        string
+	interval    time.Duration
+	useCase     portin.ScoreModelUseCase
+	lastModTime time.Time
+	stopChan    chan struct{}
+}
+
+// ScoreModelFile 점수 모델 설정 파일 구조체 (JSON)
+type ScoreModelFile struct {
+	Version string `json:"version"`
+	Sleep   struct {
+		EyesClosedSec float64 `json:"eyes_closed_sec"`
+		HeadPitch     float64 `json:"head_pitch"`
+	} `json:"sleep"`
+	Distracted struct {
+		Score int `json:"score"`
+	} `json:"distracted"`
+	Thinking struct {
+		VisionMin int     `json:"vision_min"`
+		HeadPitch float64 `json:"head_pitch"`
+		Score     int     `json:"score"`
+	} `json:"thinking"`
+	Focus struct {
+		VisionWeight   float64 `json:"vision_weight"`
+		OSWeight       float64 `json:"os_weight"`
+		PointsPerInput float64 `json:"points_per_input"`
+	} `json:"focus"`
+	Idle struct {
+		VisionMax      int `json:"vision_max"`
+		DecayPerSecond int `json:"decay_per_second"`
+	} `json:"idle"`
+	Typing struct {
+		MinKeystrokes    int     `json:"min_keystrokes"`
+		MashEntropyMax   float64 `json:"mash_entropy_max"`
+		SteadyEntropyMin float64 `json:"steady_entropy_min"`
+		SteadyDwellMinMs float64 `json:"steady_dwell_min_ms"`
+		SteadyDwellMaxMs float64 `json:"steady_dwell_max_ms"`
+		Bonus            int     `json:"bonus"`
+		MashPenalty      int     `json:"mash_penalty"`
+	} `json:"typing"`
+}
+
+// NewScoreModelWatcher ScoreModelWatcher 생성자
+func NewScoreModelWatcher(path string, interval time.Duration, useCase portin.ScoreModelUseCase) *ScoreModelWatcher {
+	return &ScoreModelWatcher{
+		path:     path,
+		interval: interval,
+		useCase:  useCase,
+		stopChan: make(chan struct{}),
+	}
+}
+
+// Load 설정 파일을 읽어 즉시 적용
+func (w *ScoreModelWatcher) Load() error {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return err
+	}
+
+	model, err := ReadScoreModelFile(w.path)
+	if err != nil {
+		return err
+	}
+
+	if err := w.useCase.UpdateScoreModel(model); err != nil {
+		return err
+	}
+
+	w.lastModTime = info.ModTime()
+	log.Printf("[SCORE_MODEL] Loaded score model %s from %s", model.Version, w.path)
+	return nil
+}
+
+// Start 파일 감시 시작 (백그라운드)
+func (w *ScoreModelWatcher) Start() {
+	log.Printf("[SCORE_MODEL] Watching %s (interval: %v)", w.path, w.interval)
+	go w.watchLoop()
+}
+
+// Stop 파일 감시 중지
+func (w *ScoreModelWatcher) Stop() {
+	close(w.stopChan)
+	log.Printf("[SCORE_MODEL] Stopped")
+}
+
+// watchLoop 수정 시간이 바뀌면 재적용
+// 잘못된 설정은 로그만 남기고 기존 모델을 유지
+func (w *ScoreModelWatcher) watchLoop() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stopChan:
+			return
+		case <-ticker.C:
+			info, err := os.Stat(w.path)
+			if err != nil {
+				log.Printf("[SCORE_MODEL] Failed to stat %s: %v", w.path, err)
+				continue
+			}
+			if !info.ModTime().After(w.lastModTime) {
+				continue
+			}
+
+			if err := w.Load(); err != nil {
+				log.Printf("[SCORE_MODEL] Rejected score model reload, keeping %s: %v",
+					w.useCase.CurrentScoreModel().Version, err)
+				// 같은 파일로 재시도하지 않도록 수정 시간은 기록
+				w.lastModTime = info.ModTime()
+			}
+		}
+	}
+}
+
+// ReadScoreModelFile 설정 파일을 읽어 ScoreModel로 변환
+// 파일에 없는 항목은 기본 모델 값을 사용
+func ReadScoreModelFile(path string) (domain.ScoreModel, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return domain.ScoreModel{}, err
+	}
+
+	file := defaultScoreModelFile()
+	if err := json.Unmarshal(data, &file); err != nil {
+		return domain.ScoreModel{}, fmt.Errorf("invalid score model file %s: %w", path, err)
+	}
+
+	model := file.toDomain()
+	if err := model.Validate(); err != nil {
+		return domain.ScoreModel{}, err
+	}
+	return model, nil
+}
+
+// defaultScoreModelFile 기본 모델 값으로 채운 설정 파일 구조체
+func defaultScoreModelFile() ScoreModelFile {
+	model := domain.DefaultScoreModel()
+
+	var file ScoreModelFile
+	file.Sleep.EyesClosedSec = model.SleepEyesClosedSec
+	file.Sleep.HeadPitch = model.SleepHeadPitch
+	file.Distracted.Score = model.DistractedScore
+	file.Thinking.VisionMin = model.ThinkingVisionMin
+	file.Thinking.HeadPitch = model.ThinkingHeadPitch
+	file.Thinking.Score = model.ThinkingScore
+	file.Focus.VisionWeight = model.VisionWeight
+	file.Focus.OSWeight = model.OSWeight
+	file.Focus.PointsPerInput = model.PointsPerInput
+	file.Idle.VisionMax = model.IdleVisionMax
+	file.Idle.DecayPerSecond = model.DecayPerSecond
+	file.Typing.MinKeystrokes = model.TypingMinKeystrokes
+	file.Typing.MashEntropyMax = model.MashEntropyMax
+	file.Typing.SteadyEntropyMin = model.SteadyEntropyMin
+	file.Typing.SteadyDwellMinMs = model.SteadyDwellMinMs
+	file.Typing.SteadyDwellMaxMs = model.SteadyDwellMaxMs
+	file.Typing.Bonus = model.TypingBonus
+	file.Typing.MashPenalty = model.MashPenalty
+	return file
+}
+
+// toDomain 설정 파일 구조체를 Domain 모델로 변환
+func (f ScoreModelFile) toDomain() domain.ScoreModel {
+	return domain.ScoreModel{
+		Version:            f.Version,
+		SleepEyesClosedSec: f.Sleep.EyesClosedSec,
+		SleepHeadPitch:     f.Sleep.HeadPitch,
+		DistractedScore:    f.Distracted.Score,
+		ThinkingVisionMin:  f.Thinking.VisionMin,
+		ThinkingHeadPitch:  f.Thinking.HeadPitch,
+		ThinkingScore:      f.Thinking.Score,
+		VisionWeight:       f.Focus.VisionWeight,
+		OSWeight:           f.Focus.OSWeight,
+		PointsPerInput:     f.Focus.PointsPerInput,
+		IdleVisionMax:      f.Idle.VisionMax,
+		DecayPerSecond:     f.Idle.DecayPerSecond,
+
+		TypingMinKeystrokes: f.Typing.MinKeystrokes,
+		MashEntropyMax:      f.Typing.MashEntropyMax,
+		SteadyEntropyMin:    f.Typing.SteadyEntropyMin,
+		SteadyDwellMinMs:    f.Typing.SteadyDwellMinMs,
+		SteadyDwellMaxMs:    f.Typing.SteadyDwellMaxMs,
+		TypingBonus:         f.Typing.Bonus,
+		MashPenalty:         f.Typing.MashPenalty,
+	}
+}
@@ -0,0 +1,346 @@
+package datasync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+)
+
+// DefaultBlacklistSource Data Service에서 동기화한 규칙의 출처 표시
+const DefaultBlacklistSource = "data-service"
+
+// maxBlacklistBodyBytes 블랙리스트 응답 본문 상한 (넘으면 실패로 처리하고 기존 규칙 유지)
+const maxBlacklistBodyBytes = 8 << 20
+
+// 동기화 결과 (BlacklistSyncStatus.LastResult)
+const (
+	SyncPending     = "pending"      // 아직 시도 전
+	SyncUpdated     = "updated"      // 새 목록을 받아 적용 (바뀐 규칙이 없을 수도 있음)
+	SyncNotModified = "not_modified" // 304, 기존 목록 유지
+	SyncFailed      = "failed"       // 재시도까지 실패, 기존 규칙 유지
+)
+
+// BlacklistSyncConfig 동기화 파라미터
+type BlacklistSyncConfig struct {
+	URL            string        // Data Service 블랙리스트 API
+	Source         string        // 규칙 출처 표시 (같은 출처의 규칙만 교체/제거)
+	Interval       time.Duration // 폴링 주기
+	Timeout        time.Duration // 요청 하나의 제한 시간
+	InitialBackoff time.Duration // 첫 재시도 대기 (실패마다 두 배)
+	MaxBackoff     time.Duration // 재시도 대기 상한
+	MaxRetries     int           // 한 주기 안의 재시도 횟수 (넘으면 다음 주기까지 대기)
+}
+
+// DefaultBlacklistSyncConfig 기본 동기화 파라미터
+func DefaultBlacklistSyncConfig() BlacklistSyncConfig {
+	return BlacklistSyncConfig{
+		URL:            "http://localhost:8083/api/v1/blacklist",
+		Source:         DefaultBlacklistSource,
+		Interval:       time.Minute,
+		Timeout:        10 * time.Second,
+		InitialBackoff: 2 * time.Second,
+		MaxBackoff:     30 * time.Second,
+		MaxRetries:     4,
+	}
+}
+
+// BlacklistSyncStatus 마지막 동기화 상태 (/health 노출용)
+type BlacklistSyncStatus struct {
+	URL                 string     `json:"url"`
+	LastResult          string     `json:"last_result"`
+	LastError           string     `json:"last_error,omitempty"`
+	LastAttempt         *time.Time `json:"last_attempt,omitempty"`
+	LastSuccess         *time.Time `json:"last_success,omitempty"` // 마지막 200/304 응답
+	LastChange          *time.Time `json:"last_change,omitempty"`  // 규칙이 마지막으로 바뀐 시각
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	ETag                string     `json:"etag,omitempty"`
+	LastModified        string     `json:"last_modified,omitempty"`
+	Rules               int        `json:"rules"`             // 마지막으로 받은 목록의 유효 규칙 수
+	Invalid             int        `json:"invalid,omitempty"` // 해석할 수 없어 건너뛴 항목 수
+	Diff                *SyncDiff  `json:"diff,omitempty"`    // 마지막 적용 결과
+}
+
+// SyncDiff 적용 결과 (domain.RuleSyncDiff JSON 표현)
+type SyncDiff struct {
+	Added     int `json:"added"`
+	Updated   int `json:"updated"`
+	Removed   int `json:"removed"`
+	Unchanged int `json:"unchanged"`
+	Skipped   int `json:"skipped"`
+}
+
+// BlacklistSyncer Data Service 블랙리스트 동기화 (Driving Adapter)
+// 주기적으로 전체 목록을 조건부 요청(ETag/If-Modified-Since)으로 받아
+// 같은 출처의 규칙을 한 번에 교체 (목록에서 빠진 규칙은 제거)
+// 실패하면 지수 백오프로 재시도하고, 끝내 실패하면 기존 규칙을 유지한 채 다음 주기에 다시 시도
+type BlacklistSyncer struct {
+	config   BlacklistSyncConfig
+	useCase  portin.BlacklistSyncUseCase
+	client   *http.Client
+	status   BlacklistSyncStatus
+	mu       sync.RWMutex
+	stopChan chan struct{}
+	stopOnce sync.Once
+	now      func() time.Time
+}
+
+// blacklistResponse Data Service 응답 형식
+type blacklistResponse struct {
+	Success bool            `json:"success"`
+	Data    []blacklistItem `json:"data"`
+}
+
+// blacklistItem 목록 항목 (앱 이름 또는 URL/도메인)
+type blacklistItem struct {
+	AppName string `json:"appName"`
+	URL     string `json:"url"`
+	Domain  string `json:"domain"`
+}
+
+// NewBlacklistSyncer BlacklistSyncer 생성자
+func NewBlacklistSyncer(config BlacklistSyncConfig, useCase portin.BlacklistSyncUseCase) *BlacklistSyncer {
+	defaults := DefaultBlacklistSyncConfig()
+	if config.Source == "" {
+		config.Source = defaults.Source
+	}
+	if config.Interval <= 0 {
+		config.Interval = defaults.Interval
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaults.Timeout
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = defaults.InitialBackoff
+	}
+	if config.MaxBackoff < config.InitialBackoff {
+		config.MaxBackoff = config.InitialBackoff
+	}
+	if config.MaxRetries < 0 {
+		config.MaxRetries = 0
+	}
+	return &BlacklistSyncer{
+		config:   config,
+		useCase:  useCase,
+		client:   &http.Client{Timeout: config.Timeout},
+		status:   BlacklistSyncStatus{URL: config.URL, LastResult: SyncPending},
+		stopChan: make(chan struct{}),
+		now:      time.Now,
+	}
+}
+
+// Start 동기화 시작 (백그라운드, 시작 직후 한 번 동기화)
+func (s *BlacklistSyncer) Start() {
+	log.Printf("[BLACKLIST_SYNC] Syncing from %s (interval: %v)", s.config.URL, s.config.Interval)
+	go s.syncLoop()
+}
+
+// Stop 동기화 중지
+func (s *BlacklistSyncer) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopChan)
+		log.Printf("[BLACKLIST_SYNC] Stopped")
+	})
+}
+
+// Status 마지막 동기화 상태 (복사본)
+func (s *BlacklistSyncer) Status() BlacklistSyncStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	status := s.status
+	if status.Diff != nil {
+		diff := *status.Diff
+		status.Diff = &diff
+	}
+	return status
+}
+
+// syncLoop 주기마다 재시도를 포함한 동기화
+func (s *BlacklistSyncer) syncLoop() {
+	for {
+		s.syncWithRetry()
+
+		select {
+		case <-s.stopChan:
+			return
+		case <-time.After(s.config.Interval):
+		}
+	}
+}
+
+// syncWithRetry 실패하면 InitialBackoff부터 두 배씩 늘려 MaxRetries번까지 재시도
+func (s *BlacklistSyncer) syncWithRetry() {
+	backoff := s.config.InitialBackoff
+	for attempt := 0; ; attempt++ {
+		err := s.SyncOnce()
+		if err == nil {
+			return
+		}
+		if attempt >= s.config.MaxRetries {
+			log.Printf("[BLACKLIST_SYNC] Giving up until next poll after %d attempts: %v", attempt+1, err)
+			return
+		}
+		log.Printf("[BLACKLIST_SYNC] Sync failed, retrying in %v: %v", backoff, err)
+
+		select {
+		case <-s.stopChan:
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > s.config.MaxBackoff {
+			backoff = s.config.MaxBackoff
+		}
+	}
+}
+
+// SyncOnce 조건부 요청 한 번 (304면 그대로, 200이면 전체 목록 적용)
+func (s *BlacklistSyncer) SyncOnce() error {
+	s.mu.RLock()
+	etag, lastModified := s.status.ETag, s.status.LastModified
+	s.mu.RUnlock()
+
+	attemptAt := s.now()
+	err := s.fetchAndApply(etag, lastModified, attemptAt)
+	if err != nil {
+		s.mu.Lock()
+		s.status.LastAttempt = &attemptAt
+		s.status.LastResult = SyncFailed
+		s.status.LastError = err.Error()
+		s.status.ConsecutiveFailures++
+		s.mu.Unlock()
+	}
+	return err
+}
+
+// fetchAndApply 요청/적용 후 성공 상태 기록
+func (s *BlacklistSyncer) fetchAndApply(etag, lastModified string, attemptAt time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.config.URL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if lastModified != "" {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified:
+		s.mu.Lock()
+		s.status.LastAttempt = &attemptAt
+		s.status.LastSuccess = &attemptAt
+		s.status.LastResult = SyncNotModified
+		s.status.LastError = ""
+		s.status.ConsecutiveFailures = 0
+		s.mu.Unlock()
+		return nil
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBlacklistBodyBytes+1))
+	if err != nil {
+		return fmt.Errorf("failed to read blacklist body: %w", err)
+	}
+	if len(body) > maxBlacklistBodyBytes {
+		return fmt.Errorf("blacklist body exceeds %d bytes", maxBlacklistBodyBytes)
+	}
+	var payload blacklistResponse
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return fmt.Errorf("failed to parse blacklist JSON: %w", err)
+	}
+	if !payload.Success {
+		return fmt.Errorf("data service reported failure")
+	}
+
+	urlRules, patternRules, invalid := s.toRules(payload.Data)
+	diff, err := s.useCase.SyncRules(s.config.Source, urlRules, patternRules)
+	if err != nil {
+		return fmt.Errorf("failed to apply blacklist: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status.LastAttempt = &attemptAt
+	s.status.LastSuccess = &attemptAt
+	s.status.LastResult = SyncUpdated
+	s.status.LastError = ""
+	s.status.ConsecutiveFailures = 0
+	s.status.ETag = resp.Header.Get("ETag")
+	s.status.LastModified = resp.Header.Get("Last-Modified")
+	s.status.Rules = len(urlRules) + len(patternRules)
+	s.status.Invalid = invalid
+	s.status.Diff = &SyncDiff{
+		Added:     diff.Added,
+		Updated:   diff.Updated,
+		Removed:   diff.Removed,
+		Unchanged: diff.Unchanged,
+		Skipped:   diff.Skipped,
+	}
+	if diff.Changed() {
+		s.status.LastChange = &attemptAt
+	}
+	return nil
+}
+
+// toRules 목록 항목을 규칙으로 변환 (앱 이름은 대소문자 무시 전체 일치 CLOSE_APP, 해석할 수 없는 항목은 건너뜀)
+// 앱 이름의 *, ?, [ 등이 패턴으로 해석되지 않도록 이름 그대로 일치하는 정규식 규칙으로 만듦
+func (s *BlacklistSyncer) toRules(items []blacklistItem) ([]domain.URLRule, []domain.PatternRule, int) {
+	meta := domain.RuleMetadata{
+		CreatedBy: s.config.Source,
+		Reason:    "synced from " + s.config.URL,
+		Source:    s.config.Source,
+	}
+
+	var urlRules []domain.URLRule
+	var patternRules []domain.PatternRule
+	invalid := 0
+	for _, item := range items {
+		if appName := strings.TrimSpace(item.AppName); appName != "" {
+			rule, err := domain.NewPatternRule(domain.PatternFieldApp, domain.PatternRegex, "^"+regexp.QuoteMeta(appName)+"$", domain.ActionCloseApp, 0)
+			if err != nil {
+				log.Printf("[BLACKLIST_SYNC] Skipping app %q: %v", appName, err)
+				invalid++
+				continue
+			}
+			rule.Meta = meta
+			patternRules = append(patternRules, rule)
+			continue
+		}
+
+		pattern := strings.TrimSpace(item.URL)
+		if pattern == "" {
+			pattern = strings.TrimSpace(item.Domain)
+		}
+		rule, err := domain.ParseURLRule(pattern)
+		if err != nil {
+			log.Printf("[BLACKLIST_SYNC] Skipping url %q: %v", pattern, err)
+			invalid++
+			continue
+		}
+		rule.Meta = meta
+		urlRules = append(urlRules, rule)
+	}
+	return urlRules, patternRules, invalid
+}
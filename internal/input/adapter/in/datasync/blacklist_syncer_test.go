@@ -0,0 +1,179 @@
+package datasync
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	boltOut "jiaa-server-core/internal/input/adapter/out/bolt"
+	"jiaa-server-core/internal/input/adapter/out/memory"
+	"jiaa-server-core/internal/input/domain"
+	"jiaa-server-core/internal/input/service"
+)
+
+// fakeDataService 조건부 요청을 지원하는 Data Service 블랙리스트 API
+type fakeDataService struct {
+	mu       sync.Mutex
+	etag     string
+	body     string
+	failures int // 남은 500 응답 수
+	requests int
+}
+
+func (f *fakeDataService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests++
+	if f.failures > 0 {
+		f.failures--
+		http.Error(w, "unavailable", http.StatusInternalServerError)
+		return
+	}
+	if r.Header.Get("If-None-Match") == f.etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("ETag", f.etag)
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(f.body))
+}
+
+// publish 새 목록 게시
+func (f *fakeDataService) publish(etag, body string) {
+	f.mu.Lock()
+	f.etag, f.body = etag, body
+	f.mu.Unlock()
+}
+
+// fail 다음 n번 요청 실패
+func (f *fakeDataService) fail(n int) {
+	f.mu.Lock()
+	f.failures = n
+	f.mu.Unlock()
+}
+
+func TestBlacklistSyncer_ConditionalSync(t *testing.T) {
+	db, err := boltOut.OpenDB(filepath.Join(t.TempDir(), "data.db"))
+	if err != nil {
+		t.Fatalf("OpenDB failed: %v", err)
+	}
+	defer db.Close()
+	store, err := boltOut.NewBlacklistStore(db)
+	if err != nil {
+		t.Fatalf("NewBlacklistStore failed: %v", err)
+	}
+	blacklist := memory.NewBlacklistAdapter()
+	blacklistService := service.NewBlacklistService(blacklist, store)
+
+	dataService := &fakeDataService{}
+	server := httptest.NewServer(dataService)
+	defer server.Close()
+
+	config := DefaultBlacklistSyncConfig()
+	config.URL = server.URL
+	syncer := NewBlacklistSyncer(config, blacklistService)
+	now := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+	syncer.now = func() time.Time { return now }
+	ctx := domain.RuleContext{At: now}
+
+	// 200: 전체 목록 적용 (앱 이름의 glob 문자는 그대로 비교)
+	dataService.publish(`"v1"`, `{"success": true, "data": [{"appName": "Game[1]*.exe"}, {"domain": "roblox.com"}, {"url": ""}]}`)
+	if err := syncer.SyncOnce(); err != nil {
+		t.Fatalf("SyncOnce failed: %v", err)
+	}
+	status := syncer.Status()
+	if status.LastResult != SyncUpdated || status.ETag != `"v1"` || status.Rules != 2 || status.Invalid != 1 ||
+		status.Diff == nil || status.Diff.Added != 2 || status.LastChange == nil {
+		t.Fatalf("Unexpected status after first sync: %+v", status)
+	}
+	if !blacklist.IsBlacklisted(ctx, "https://www.roblox.com/games") {
+		t.Error("Expected synced domain blocked")
+	}
+	if _, matched := blacklist.MatchApp(ctx, "game[1]*.EXE"); !matched {
+		t.Error("Expected synced app name matched exactly")
+	}
+	if _, matched := blacklist.MatchApp(ctx, "Game[1]-launcher.exe"); matched {
+		t.Error("Expected app name wildcards not to be treated as a glob")
+	}
+
+	// 304: 기존 규칙 유지
+	now = now.Add(time.Minute)
+	if err := syncer.SyncOnce(); err != nil {
+		t.Fatalf("SyncOnce failed: %v", err)
+	}
+	if status := syncer.Status(); status.LastResult != SyncNotModified || !status.LastSuccess.Equal(now) || status.Rules != 2 {
+		t.Errorf("Unexpected status after 304: %+v", status)
+	}
+
+	// 200: 목록에서 빠진 앱 규칙 제거
+	now = now.Add(time.Minute)
+	dataService.publish(`"v2"`, `{"success": true, "data": [{"domain": "roblox.com"}]}`)
+	if err := syncer.SyncOnce(); err != nil {
+		t.Fatalf("SyncOnce failed: %v", err)
+	}
+	status = syncer.Status()
+	if status.LastResult != SyncUpdated || status.ETag != `"v2"` || status.Diff.Removed != 1 || status.Diff.Unchanged != 1 || !status.LastChange.Equal(now) {
+		t.Errorf("Unexpected status after removal: %+v", status)
+	}
+	if _, matched := blacklist.MatchApp(ctx, "game[1]*.exe"); matched {
+		t.Error("Expected removed app rule no longer applied")
+	}
+	if policies, _, _ := store.LoadPolicies(); len(policies) != 1 || len(policies[0].Policy.PatternRules) != 0 {
+		t.Errorf("Expected removal persisted, got %+v", policies)
+	}
+
+	// 실패: 상태만 기록하고 기존 규칙 유지
+	lastSuccess := now
+	now = now.Add(time.Minute)
+	dataService.fail(1)
+	if err := syncer.SyncOnce(); err == nil {
+		t.Fatal("Expected SyncOnce to fail on 500")
+	}
+	status = syncer.Status()
+	if status.LastResult != SyncFailed || status.LastError == "" || status.ConsecutiveFailures != 1 ||
+		!status.LastAttempt.Equal(now) || !status.LastSuccess.Equal(lastSuccess) {
+		t.Errorf("Unexpected status after failure: %+v", status)
+	}
+	if !blacklist.IsBlacklisted(ctx, "https://roblox.com") {
+		t.Error("Expected rules kept after a failed sync")
+	}
+
+	// 복구: 같은 ETag로 304를 받아 정상 상태로
+	now = now.Add(time.Minute)
+	if err := syncer.SyncOnce(); err != nil {
+		t.Fatalf("SyncOnce failed after recovery: %v", err)
+	}
+	status = syncer.Status()
+	if status.LastResult != SyncNotModified || status.LastError != "" || status.ConsecutiveFailures != 0 || !status.LastSuccess.Equal(now) {
+		t.Errorf("Unexpected status after recovery: %+v", status)
+	}
+	if dataService.requests != 5 {
+		t.Errorf("Expected 5 requests, got %d", dataService.requests)
+	}
+}
+
+func TestBlacklistSyncer_RejectsOversizedBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success": true, "data": [`))
+		w.Write(bytes.Repeat([]byte(" "), maxBlacklistBodyBytes))
+		w.Write([]byte(`]}`))
+	}))
+	defer server.Close()
+
+	blacklist := memory.NewBlacklistAdapterWithDefaults()
+	config := DefaultBlacklistSyncConfig()
+	config.URL = server.URL
+	syncer := NewBlacklistSyncer(config, service.NewBlacklistService(blacklist, nil))
+
+	if err := syncer.SyncOnce(); err == nil || !strings.Contains(err.Error(), "exceeds") {
+		t.Fatalf("Expected oversized body rejected, got %v", err)
+	}
+	if status := syncer.Status(); status.LastResult != SyncFailed || status.ConsecutiveFailures != 1 {
+		t.Errorf("Unexpected status: %+v", status)
+	}
+}
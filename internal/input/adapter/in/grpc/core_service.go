@@ -4,12 +4,12 @@ import (
 	"context"
 	"io"
 	"log"
+	"time"
 
 	"fmt"
 	"jiaa-server-core/internal/input/domain"
 	portin "jiaa-server-core/internal/input/port/in"
 	portout "jiaa-server-core/internal/input/port/out"
-	"jiaa-server-core/internal/input/service"
 	proto "jiaa-server-core/pkg/proto"
 )
 
@@ -17,19 +17,25 @@ import (
 type CoreServiceServer struct {
 	proto.UnimplementedCoreServiceServer
 	reflexService       portin.ReflexUseCase
-	scoreService        *service.ScoreService
+	scoreUseCase        portin.ScoreUseCase
 	intelligenceService portout.IntelligencePort
+	audioMonitor        portin.AudioMonitorUseCase // 오디오 EMERGENCY 감지 (선택)
 }
 
 // NewCoreServiceServer creates a new instance of CoreServiceServer
-func NewCoreServiceServer(reflexService portin.ReflexUseCase, scoreService *service.ScoreService, intelligenceService portout.IntelligencePort) *CoreServiceServer {
+func NewCoreServiceServer(reflexService portin.ReflexUseCase, scoreUseCase portin.ScoreUseCase, intelligenceService portout.IntelligencePort) *CoreServiceServer {
 	return &CoreServiceServer{
 		reflexService:       reflexService,
-		scoreService:        scoreService,
+		scoreUseCase:        scoreUseCase,
 		intelligenceService: intelligenceService,
 	}
 }
 
+// SetAudioMonitor 오디오 EMERGENCY 감지 설정
+func (s *CoreServiceServer) SetAudioMonitor(audioMonitor portin.AudioMonitorUseCase) {
+	s.audioMonitor = audioMonitor
+}
+
 // SyncClient handles bidirectional streaming between Client (Dev 2/Vision) and Server
 func (s *CoreServiceServer) SyncClient(stream proto.CoreService_SyncClientServer) error {
 	log.Println("[CoreService] SyncClient connected")
@@ -48,7 +54,7 @@ func (s *CoreServiceServer) SyncClient(stream proto.CoreService_SyncClientServer
 
 	sm := GetStreamManager()
 	sm.Register(clientID, stream)
-	defer sm.Unregister(clientID)
+	defer sm.Unregister(clientID, stream)
 
 	// Process first message
 	s.processHeartbeat(firstMsg)
@@ -67,13 +73,21 @@ func (s *CoreServiceServer) SyncClient(stream proto.CoreService_SyncClientServer
 
 		s.processHeartbeat(heartbeat)
 	}
-	return nil
 }
 
 func (s *CoreServiceServer) processHeartbeat(heartbeat *proto.ClientHeartbeat) {
 	// Debug Log
 	// log.Printf("[DEBUG] Heartbeat recv: Keys=%d...", heartbeat.KeystrokeCount)
 
+	// 1. Score Calculation (StreamScore가 읽어갈 최신 점수 갱신)
+	domainHeartbeat := ToDomainHeartbeat(heartbeat, time.Now())
+	s.scoreUseCase.ProcessHeartbeat(domainHeartbeat)
+
+	// [Reflex Check] 활성 창 제목 규칙 (입력이 없어도 검사 - 동영상 시청 등)
+	if _, err := s.reflexService.ProcessHeartbeat(domainHeartbeat); err != nil {
+		log.Printf("[CoreService] Failed to apply window title rule: %v", err)
+	}
+
 	// 2. Aggregate Data and Route to ReflexService -> Kafka
 	osActivity := int(heartbeat.KeystrokeCount) + int(heartbeat.ClickCount) + int(heartbeat.MouseDistance)
 
@@ -94,6 +108,25 @@ func (s *CoreServiceServer) processHeartbeat(heartbeat *proto.ClientHeartbeat) {
 	}
 }
 
+// ToDomainHeartbeat ClientHeartbeat를 Domain 엔티티로 변환
+// receivedAt은 수신 시간 (score-replay는 시뮬레이션 시계를 넣음)
+func ToDomainHeartbeat(heartbeat *proto.ClientHeartbeat, receivedAt time.Time) domain.Heartbeat {
+	return domain.Heartbeat{
+		ClientID:           heartbeat.ClientId,
+		MouseDistance:      int(heartbeat.MouseDistance),
+		ClickCount:         int(heartbeat.ClickCount),
+		KeystrokeCount:     int(heartbeat.KeystrokeCount),
+		IsOSIdle:           heartbeat.IsOsIdle,
+		IsEyesClosed:       heartbeat.IsEyesClosed,
+		ConcentrationScore: float64(heartbeat.ConcentrationScore),
+		KeyboardEntropy:    float64(heartbeat.KeyboardEntropy),
+		ActiveWindowTitle:  heartbeat.ActiveWindowTitle,
+		IsDragging:         heartbeat.IsDragging,
+		AvgDwellTime:       heartbeat.AvgDwellTime,
+		Timestamp:          receivedAt,
+	}
+}
+
 // ReportAnalysisResult handles reports from AI Service
 func (s *CoreServiceServer) ReportAnalysisResult(ctx context.Context, req *proto.AnalysisReport) (*proto.Ack, error) {
 	log.Printf("[CoreService] Received Analysis Report: %s - %s", req.Type, req.Content)
@@ -120,16 +153,35 @@ func (s *CoreServiceServer) SendAppList(ctx context.Context, req *proto.AppListR
 }
 
 // TranscribeAudio handles audio stream from client
+// 청크마다 음량을 분석하여 큰 소리가 지속되면 EMERGENCY 흐름 시작
 func (s *CoreServiceServer) TranscribeAudio(stream proto.CoreService_TranscribeAudioServer) error {
 	log.Println("[CoreService] Audio stream started")
+	clientID := "unknown"
+	emergency := false
+	// SyncClient 연결이 없는 클라이언트의 음량 상태는 오디오 스트림과 함께 정리 (쿨다운은 유지)
+	// (연결된 클라이언트는 SyncClient 해제 시 정리)
+	defer func() {
+		if s.audioMonitor == nil {
+			return
+		}
+		if _, connected := GetStreamManager().Get(clientID); !connected {
+			s.audioMonitor.Forget(clientID)
+		}
+	}()
 	for {
 		req, err := stream.Recv()
 		if err == io.EOF {
 			// Finished receiving audio
 			log.Println("[CoreService] Audio stream ended")
+			transcript := "(Go Server) Audio received successfully"
+			if s.audioMonitor != nil {
+				if latest := s.audioMonitor.LatestTranscript(clientID); latest != "" {
+					transcript = latest
+				}
+			}
 			return stream.SendAndClose(&proto.AudioResponse{
-				Transcript:  "(Go Server) Audio received successfully",
-				IsEmergency: false,
+				Transcript:  transcript,
+				IsEmergency: emergency,
 			})
 		}
 		if err != nil {
@@ -137,8 +189,26 @@ func (s *CoreServiceServer) TranscribeAudio(stream proto.CoreService_TranscribeA
 			return err
 		}
 		// Process audio chunk (req.AudioData)
+		if req.ClientId != "" {
+			clientID = req.ClientId
+		}
+		if s.audioMonitor != nil && s.audioMonitor.ProcessAudioChunk(toDomainAudioChunk(clientID, req)) {
+			emergency = true
+		}
 		if req.IsFinal {
 			log.Println("[CoreService] Final audio chunk received")
 		}
 	}
 }
+
+// toDomainAudioChunk AudioRequest를 Domain 엔티티로 변환
+func toDomainAudioChunk(clientID string, req *proto.AudioRequest) domain.AudioChunk {
+	return domain.AudioChunk{
+		ClientID:   clientID,
+		AudioData:  req.AudioData,
+		SampleRate: int(req.SampleRate),
+		Transcript: req.Transcript,
+		IsFinal:    req.IsFinal,
+		Timestamp:  time.Now(),
+	}
+}
@@ -0,0 +1,274 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+	"jiaa-server-core/internal/input/service"
+	proto "jiaa-server-core/pkg/proto"
+)
+
+// scoreStreamInterval StreamScore 전송 주기 (Dev 3 오버레이 요구사항: 0.1초)
+const scoreStreamInterval = 100 * time.Millisecond
+
+// ScoringServiceServer implements the ScoringService gRPC server
+type ScoringServiceServer struct {
+	proto.UnimplementedScoringServiceServer
+	scoreUseCase        portin.ScoreUseCase
+	gamificationUseCase portin.GamificationUseCase
+	factBombUseCase     portin.FactBombUseCase
+	leaderboardUseCase  portin.LeaderboardUseCase
+}
+
+// NewScoringServiceServer creates a new instance of ScoringServiceServer
+func NewScoringServiceServer(scoreUseCase portin.ScoreUseCase) *ScoringServiceServer {
+	return &ScoringServiceServer{
+		scoreUseCase: scoreUseCase,
+	}
+}
+
+// SetGamification sets the use case backing GetGamificationInfo
+func (s *ScoringServiceServer) SetGamification(gamificationUseCase portin.GamificationUseCase) {
+	s.gamificationUseCase = gamificationUseCase
+}
+
+// SetFactBomb sets the use case backing GetFactBomb
+func (s *ScoringServiceServer) SetFactBomb(factBombUseCase portin.FactBombUseCase) {
+	s.factBombUseCase = factBombUseCase
+}
+
+// SetLeaderboard sets the use case backing GetLeaderboard
+func (s *ScoringServiceServer) SetLeaderboard(leaderboardUseCase portin.LeaderboardUseCase) {
+	s.leaderboardUseCase = leaderboardUseCase
+}
+
+// StreamScore pushes the latest ScorePacket for a client every 100ms until the stream is closed
+func (s *ScoringServiceServer) StreamScore(req *proto.ScoreStreamRequest, stream proto.ScoringService_StreamScoreServer) error {
+	if req.ClientId == "" {
+		return status.Error(codes.InvalidArgument, "client_id is required")
+	}
+
+	log.Printf("[ScoringService] StreamScore started for client: %s", req.ClientId)
+
+	ticker := time.NewTicker(scoreStreamInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			log.Printf("[ScoringService] StreamScore ended for client: %s", req.ClientId)
+			return nil
+		case <-ticker.C:
+			snapshot, exists := s.scoreUseCase.GetLatestScore(req.ClientId)
+			if !exists {
+				// 아직 하트비트가 없는 클라이언트 - 다음 틱까지 대기
+				continue
+			}
+
+			if err := stream.Send(toScorePacket(snapshot)); err != nil {
+				log.Printf("[ScoringService] Failed to send score packet: %v", err)
+				return err
+			}
+		}
+	}
+}
+
+// GetFactBomb returns a fact bomb built from the client's accumulated domain/app dwell time
+// success is false when there is not enough accumulated time to make a point
+func (s *ScoringServiceServer) GetFactBomb(ctx context.Context, req *proto.FactBombRequest) (*proto.FactBombResponse, error) {
+	if s.factBombUseCase == nil {
+		return s.UnimplementedScoringServiceServer.GetFactBomb(ctx, req)
+	}
+	if req.ClientId == "" {
+		return nil, status.Error(codes.InvalidArgument, "client_id is required")
+	}
+
+	factBomb, exists, err := s.factBombUseCase.GetFactBomb(req.ClientId, req.TriggerEvent, req.TargetUrl)
+	if err != nil {
+		log.Printf("[ScoringService] Failed to get fact bomb: %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !exists {
+		return &proto.FactBombResponse{Success: false}, nil
+	}
+
+	return &proto.FactBombResponse{
+		Success:            true,
+		FactMessage:        factBomb.Message,
+		AccumulatedSeconds: factBomb.AccumulatedSeconds,
+		TimePeriod:         factBomb.Period.Label(),
+	}, nil
+}
+
+// GetGamificationInfo returns the client's level, experience and accumulated study time
+func (s *ScoringServiceServer) GetGamificationInfo(ctx context.Context, req *proto.GamificationRequest) (*proto.GamificationResponse, error) {
+	if s.gamificationUseCase == nil {
+		return s.UnimplementedScoringServiceServer.GetGamificationInfo(ctx, req)
+	}
+	if req.ClientId == "" {
+		return nil, status.Error(codes.InvalidArgument, "client_id is required")
+	}
+
+	info, err := s.gamificationUseCase.GetGamificationInfo(req.ClientId)
+	if err != nil {
+		log.Printf("[ScoringService] Failed to get gamification info: %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	achievements := make([]*proto.Achievement, 0, len(info.Achievements))
+	for _, achievement := range info.Achievements {
+		var unlockedAt int64
+		if achievement.Unlocked {
+			unlockedAt = achievement.UnlockedAt.Unix()
+		}
+		achievements = append(achievements, &proto.Achievement{
+			Id:          achievement.ID,
+			Name:        achievement.Name,
+			Description: achievement.Description,
+			Unlocked:    achievement.Unlocked,
+			UnlockedAt:  unlockedAt,
+		})
+	}
+
+	return &proto.GamificationResponse{
+		Success:           true,
+		Level:             int32(info.Level),
+		Experience:        info.Experience,
+		NextLevelExp:      info.NextLevelExp,
+		TotalStudySeconds: info.TotalStudySeconds,
+		AverageScore:      float32(info.AverageScore),
+		Achievements:      achievements,
+		Streak:            toProtoStreakStatus(info.Streak),
+	}, nil
+}
+
+// GetLeaderboard returns the study group's ranking for the requested period and metric
+// Clients that opted out of leaderboards are never included
+func (s *ScoringServiceServer) GetLeaderboard(ctx context.Context, req *proto.LeaderboardRequest) (*proto.LeaderboardResponse, error) {
+	if s.leaderboardUseCase == nil {
+		return s.UnimplementedScoringServiceServer.GetLeaderboard(ctx, req)
+	}
+	if req.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+	if req.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+
+	period := domain.LeaderboardDaily
+	switch req.Period {
+	case proto.LeaderboardPeriod_LEADERBOARD_WEEKLY:
+		period = domain.LeaderboardWeekly
+	case proto.LeaderboardPeriod_LEADERBOARD_ALL_TIME:
+		period = domain.LeaderboardAllTime
+	}
+	metric := domain.MetricFocusedTime
+	if req.Metric == proto.LeaderboardMetric_LEADERBOARD_AVERAGE_SCORE {
+		metric = domain.MetricAverageScore
+	}
+
+	board, err := s.leaderboardUseCase.GetLeaderboard(req.GroupId, period, metric, int(req.Limit))
+	if errors.Is(err, service.ErrAllTimeUnavailable) {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+	if err != nil {
+		log.Printf("[ScoringService] Failed to get leaderboard: %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &proto.LeaderboardResponse{
+		Success: true,
+		GroupId: board.GroupID,
+		Period:  req.Period,
+		Metric:  req.Metric,
+		Entries: make([]*proto.LeaderboardEntry, 0, len(board.Entries)),
+	}
+	if !board.From.IsZero() {
+		response.From = board.From.UnixMilli()
+		response.To = board.To.UnixMilli()
+	}
+	for _, entry := range board.Entries {
+		response.Entries = append(response.Entries, &proto.LeaderboardEntry{
+			Rank:           int32(entry.Rank),
+			ClientId:       entry.ClientID,
+			DisplayName:    entry.DisplayName,
+			FocusedSeconds: entry.FocusedSeconds,
+			AverageScore:   float32(entry.AverageScore),
+		})
+	}
+	return response, nil
+}
+
+// toProtoStreakStatus StreakStatus를 proto 메시지로 변환 (연속 달성 미사용 시 nil)
+func toProtoStreakStatus(streak *domain.StreakStatus) *proto.StreakStatus {
+	if streak == nil {
+		return nil
+	}
+	return &proto.StreakStatus{
+		DailyGoalSeconds:  int64(streak.DailyGoal / time.Second),
+		TodayFocusSeconds: int64(streak.TodayFocus / time.Second),
+		RemainingSeconds:  int64(streak.Remaining / time.Second),
+		GoalMetToday:      streak.GoalMetToday,
+		CurrentStreak:     int32(streak.CurrentStreak),
+		BestStreak:        int32(streak.BestStreak),
+		AtRisk:            streak.AtRisk,
+		Timezone:          streak.Timezone,
+	}
+}
+
+// toScorePacket ScoreSnapshot을 ScorePacket으로 변환
+func toScorePacket(snapshot domain.ScoreSnapshot) *proto.ScorePacket {
+	return &proto.ScorePacket{
+		ClientId:     snapshot.ClientID,
+		CurrentScore: int32(snapshot.Score),
+		State:        toProtoScoreState(snapshot.State, snapshot.Score),
+		Timestamp:    snapshot.Timestamp.UnixMilli(),
+		Breakdown:    ToProtoScoreBreakdown(snapshot.Breakdown),
+	}
+}
+
+// ToProtoScoreBreakdown ScoreBreakdown을 proto 메시지로 변환 (ScorePacket, ScoreUpdateRequest 공용)
+func ToProtoScoreBreakdown(breakdown domain.ScoreBreakdown) *proto.ScoreBreakdown {
+	return &proto.ScoreBreakdown{
+		HeadPoseScore: int32(breakdown.HeadPose),
+		EyeFocusScore: int32(breakdown.EyeFocus),
+		AudioScore:    int32(breakdown.Audio),
+		ActivityScore: int32(breakdown.Activity),
+		IdleScore:     int32(breakdown.Idle),
+		TypingScore:   int32(breakdown.Typing),
+		CarriedScore:  int32(breakdown.Carried),
+	}
+}
+
+// toProtoScoreState ScoreService 상태를 proto ScoreState로 매핑
+// IDLING/NEUTRAL처럼 proto에 없는 상태는 점수 구간으로 판정
+func toProtoScoreState(state string, score int) proto.ScoreState {
+	switch state {
+	case service.ScoreStateSleeping:
+		return proto.ScoreState_SLEEPING
+	case service.ScoreStateDistracted:
+		return proto.ScoreState_DISTRACTED
+	case service.ScoreStateThinking:
+		return proto.ScoreState_THINKING
+	case service.ScoreStateFocusing:
+		return proto.ScoreState_FOCUSED
+	}
+
+	switch {
+	case score > 80:
+		return proto.ScoreState_THINKING
+	case score > 50:
+		return proto.ScoreState_FOCUSED
+	case score > 30:
+		return proto.ScoreState_DISTRACTED
+	default:
+		return proto.ScoreState_SLEEPING
+	}
+}
@@ -11,27 +11,53 @@ import (
 
 	portin "jiaa-server-core/internal/input/port/in"
 	portout "jiaa-server-core/internal/input/port/out"
-	"jiaa-server-core/internal/input/service"
 	"jiaa-server-core/pkg/proto"
 )
 
 // InputGrpcServer manages the gRPC server for incoming client connections (e.g. Vision Service)
 type InputGrpcServer struct {
-	server        *grpc.Server
-	port          string
-	coreService   *CoreServiceServer
-	reflexService portin.ReflexUseCase
+	server         *grpc.Server
+	port           string
+	coreService    *CoreServiceServer
+	scoringService *ScoringServiceServer
+	reflexService  portin.ReflexUseCase
 }
 
 // NewInputGrpcServer creates a new gRPC server wrapper
-func NewInputGrpcServer(port string, reflexService portin.ReflexUseCase, scoreService *service.ScoreService, intelligencePort portout.IntelligencePort) *InputGrpcServer {
+func NewInputGrpcServer(port string, reflexService portin.ReflexUseCase, scoreUseCase portin.ScoreUseCase, intelligencePort portout.IntelligencePort) *InputGrpcServer {
+	// SyncClient 스트림이 해제되면 점수 세션도 만료
+	GetStreamManager().OnUnregister(scoreUseCase.EndSession)
+
 	return &InputGrpcServer{
-		port:          port,
-		coreService:   NewCoreServiceServer(reflexService, scoreService, intelligencePort),
-		reflexService: reflexService,
+		port:           port,
+		coreService:    NewCoreServiceServer(reflexService, scoreUseCase, intelligencePort),
+		scoringService: NewScoringServiceServer(scoreUseCase),
+		reflexService:  reflexService,
 	}
 }
 
+// SetAudioMonitor 오디오 EMERGENCY 감지 설정 (TranscribeAudio)
+func (s *InputGrpcServer) SetAudioMonitor(audioMonitor portin.AudioMonitorUseCase) {
+	// SyncClient 스트림이 해제되면 음량 상태도 제거
+	GetStreamManager().OnUnregister(audioMonitor.Forget)
+	s.coreService.SetAudioMonitor(audioMonitor)
+}
+
+// SetGamification 게이미피케이션 조회 설정 (GetGamificationInfo)
+func (s *InputGrpcServer) SetGamification(gamificationUseCase portin.GamificationUseCase) {
+	s.scoringService.SetGamification(gamificationUseCase)
+}
+
+// SetFactBomb 팩트 폭격 조회 설정 (GetFactBomb)
+func (s *InputGrpcServer) SetFactBomb(factBombUseCase portin.FactBombUseCase) {
+	s.scoringService.SetFactBomb(factBombUseCase)
+}
+
+// SetLeaderboard 그룹 리더보드 조회 설정 (GetLeaderboard)
+func (s *InputGrpcServer) SetLeaderboard(leaderboardUseCase portin.LeaderboardUseCase) {
+	s.scoringService.SetLeaderboard(leaderboardUseCase)
+}
+
 // Start starts the gRPC server
 func (s *InputGrpcServer) Start() error {
 	lis, err := net.Listen("tcp", ":"+s.port)
@@ -43,12 +69,14 @@ func (s *InputGrpcServer) Start() error {
 
 	// Register Services
 	proto.RegisterCoreServiceServer(s.server, s.coreService)
+	proto.RegisterScoringServiceServer(s.server, s.scoringService)
 
 	// Register Health Server
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(s.server, healthServer)
 	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
 	healthServer.SetServingStatus("jiaa.score.ScoreService", grpc_health_v1.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("jiaa.ScoringService", grpc_health_v1.HealthCheckResponse_SERVING)
 
 	// Enable Reflection
 	reflection.Register(s.server)
@@ -1,16 +1,37 @@
 package grpc
 
 import (
+	"errors"
+	"fmt"
 	"log"
 	"sync"
 
 	proto "jiaa-server-core/pkg/proto"
 )
 
+// ErrClientNotConnected is returned by TrySendCommand when the client has no active stream
+var ErrClientNotConnected = errors.New("client not connected")
+
+// clientStream wraps a client's stream with its own send lock
+// grpc-go streams are not safe for concurrent Send calls, and commands for one client
+// come from several goroutines (reflex, score gauge, achievements, AI results)
+type clientStream struct {
+	stream proto.CoreService_SyncClientServer
+	sendMu sync.Mutex
+}
+
+// send sends a command, serialized with other sends on the same stream
+func (cs *clientStream) send(cmd *proto.ServerCommand) error {
+	cs.sendMu.Lock()
+	defer cs.sendMu.Unlock()
+	return cs.stream.Send(cmd)
+}
+
 // StreamManager manages active gRPC streams for clients
 type StreamManager struct {
-	streams map[string]proto.CoreService_SyncClientServer
-	mu      sync.RWMutex
+	streams             map[string]*clientStream
+	unregisterListeners []func(clientID string)
+	mu                  sync.RWMutex
 }
 
 var instance *StreamManager
@@ -20,7 +41,7 @@ var once sync.Once
 func GetStreamManager() *StreamManager {
 	once.Do(func() {
 		instance = &StreamManager{
-			streams: make(map[string]proto.CoreService_SyncClientServer),
+			streams: make(map[string]*clientStream),
 		}
 	})
 	return instance
@@ -30,34 +51,75 @@ func GetStreamManager() *StreamManager {
 func (sm *StreamManager) Register(clientID string, stream proto.CoreService_SyncClientServer) {
 	sm.mu.Lock()
 	defer sm.mu.Unlock()
-	sm.streams[clientID] = stream
+	sm.streams[clientID] = &clientStream{stream: stream}
 	log.Printf("[StreamManager] Registered stream for client: %s", clientID)
 }
 
-// Unregister removes a stream for a client
-func (sm *StreamManager) Unregister(clientID string) {
+// Unregister removes a client's stream and notifies unregister listeners
+// Only the given stream is removed: when a client reconnects, the old stream's deferred
+// Unregister must not drop the new stream or end its session
+func (sm *StreamManager) Unregister(clientID string, stream proto.CoreService_SyncClientServer) {
 	sm.mu.Lock()
-	defer sm.mu.Unlock()
-	if _, exists := sm.streams[clientID]; exists {
+	current, exists := sm.streams[clientID]
+	exists = exists && current.stream == stream
+	if exists {
 		delete(sm.streams, clientID)
 		log.Printf("[StreamManager] Unregistered stream for client: %s", clientID)
 	}
+	listeners := sm.unregisterListeners
+	sm.mu.Unlock()
+
+	if !exists {
+		return
+	}
+	for _, listener := range listeners {
+		listener(clientID)
+	}
+}
+
+// OnUnregister registers a callback invoked after a client's stream is unregistered
+func (sm *StreamManager) OnUnregister(listener func(clientID string)) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.unregisterListeners = append(sm.unregisterListeners, listener)
 }
 
 // Get returns the stream for a client
+// Use SendCommand to send on it, so sends stay serialized
 func (sm *StreamManager) Get(clientID string) (proto.CoreService_SyncClientServer, bool) {
+	cs, exists := sm.lookup(clientID)
+	if !exists {
+		return nil, false
+	}
+	return cs.stream, true
+}
+
+// lookup returns the wrapped stream for a client
+func (sm *StreamManager) lookup(clientID string) (*clientStream, bool) {
 	sm.mu.RLock()
 	defer sm.mu.RUnlock()
-	stream, exists := sm.streams[clientID]
-	return stream, exists
+	cs, exists := sm.streams[clientID]
+	return cs, exists
 }
 
 // SendCommand sends a command to a specific client
+// An offline client is only logged, so command routing does not fail for it
 func (sm *StreamManager) SendCommand(clientID string, cmd *proto.ServerCommand) error {
-	stream, exists := sm.Get(clientID)
+	cs, exists := sm.lookup(clientID)
 	if !exists {
 		log.Printf("[StreamManager] Client not found: %s", clientID)
 		return nil // Return nil to avoid erroring out caller, just log warning
 	}
-	return stream.Send(cmd)
+	return cs.send(cmd)
+}
+
+// TrySendCommand sends a command to a specific client
+// Returns ErrClientNotConnected when the client has no active stream, for callers
+// that must know whether the command was delivered (score gauge, notifications)
+func (sm *StreamManager) TrySendCommand(clientID string, cmd *proto.ServerCommand) error {
+	cs, exists := sm.lookup(clientID)
+	if !exists {
+		return fmt.Errorf("%w: %s", ErrClientNotConnected, clientID)
+	}
+	return cs.send(cmd)
 }
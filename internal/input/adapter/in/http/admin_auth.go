@@ -0,0 +1,49 @@
+package http
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminAuth 관리자 전용 API 인증 (Authorization: Bearer <ADMIN_API_TOKEN>)
+// 토큰이 설정되지 않으면 관리자 요청을 모두 거부
+type AdminAuth struct {
+	token string
+}
+
+// NewAdminAuth AdminAuth 생성자 (빈 토큰이면 관리자 API 비활성)
+func NewAdminAuth(token string) *AdminAuth {
+	return &AdminAuth{token: strings.TrimSpace(token)}
+}
+
+// Enabled 관리자 토큰 설정 여부
+func (a *AdminAuth) Enabled() bool {
+	return a != nil && a.token != ""
+}
+
+// IsAdmin 요청의 Bearer 토큰이 관리자 토큰과 일치하는지 확인
+func (a *AdminAuth) IsAdmin(c echo.Context) bool {
+	if !a.Enabled() {
+		return false
+	}
+	scheme, token, found := strings.Cut(c.Request().Header.Get(echo.HeaderAuthorization), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimSpace(token)), []byte(a.token)) == 1
+}
+
+// Require 관리자 전용 라우트 미들웨어
+func (a *AdminAuth) Require(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !a.IsAdmin(c) {
+			return c.JSON(http.StatusForbidden, map[string]string{
+				"error": "admin token required",
+			})
+		}
+		return next(c)
+	}
+}
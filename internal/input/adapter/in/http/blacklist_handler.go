@@ -0,0 +1,593 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+	"jiaa-server-core/internal/input/service"
+)
+
+// BlacklistHandler 차단 규칙 HTTP Driving Adapter
+// 정책 대상은 경로의 :target으로 지정 ("global", "group:algo-study", "client:pc-01")
+type BlacklistHandler struct {
+	previewUseCase portin.BlacklistPreviewUseCase
+	adminUseCase   portin.BlacklistAdminUseCase
+	adminAuth      *AdminAuth // 규칙 변경 권한 (없으면 변경 불가)
+}
+
+// NewBlacklistHandler BlacklistHandler 생성자
+func NewBlacklistHandler(previewUseCase portin.BlacklistPreviewUseCase, adminUseCase portin.BlacklistAdminUseCase) *BlacklistHandler {
+	return &BlacklistHandler{
+		previewUseCase: previewUseCase,
+		adminUseCase:   adminUseCase,
+	}
+}
+
+// SetAdminAuth 관리자 인증 설정 (규칙 변경과 시험 모드 전환은 관리자만, RegisterRoutes 전에 호출)
+func (h *BlacklistHandler) SetAdminAuth(adminAuth *AdminAuth) {
+	h.adminAuth = adminAuth
+}
+
+// RuleScheduleDTO 규칙 일정 (생략하면 항상 적용)
+type RuleScheduleDTO struct {
+	Windows           []string `json:"windows,omitempty"` // "mon-fri 09:00-18:00" (클라이언트 시간대)
+	StudySessionsOnly bool     `json:"study_sessions_only,omitempty"`
+}
+
+// URLRuleDTO URL 규칙 요청/응답 구조체
+type URLRuleDTO struct {
+	Pattern   string           `json:"pattern"` // "youtube.com", "youtube.com/shorts"
+	Allow     bool             `json:"allow,omitempty"`
+	Schedule  *RuleScheduleDTO `json:"schedule,omitempty"`
+	CreatedBy string           `json:"created_by,omitempty"`
+	CreatedAt *time.Time       `json:"created_at,omitempty"` // 응답 전용
+	Reason    string           `json:"reason,omitempty"`
+	Source    string           `json:"source,omitempty"` // 응답 전용 (외부 동기화 출처, 규칙을 수정해도 유지)
+}
+
+// PatternRuleDTO 앱 이름/창 제목 규칙 요청/응답 구조체
+type PatternRuleDTO struct {
+	Field     string           `json:"field"`          // app | window_title
+	Kind      string           `json:"kind,omitempty"` // glob(기본) | regex
+	Pattern   string           `json:"pattern"`
+	Action    string           `json:"action,omitempty"`    // CLOSE_APP(기본) | MINIMIZE_ALL | BLOCK_URL
+	Intensity int              `json:"intensity,omitempty"` // 1-10 (생략 시 10)
+	Message   string           `json:"message,omitempty"`
+	Allow     bool             `json:"allow,omitempty"`
+	Schedule  *RuleScheduleDTO `json:"schedule,omitempty"`
+	CreatedBy string           `json:"created_by,omitempty"`
+	CreatedAt *time.Time       `json:"created_at,omitempty"` // 응답 전용
+	Reason    string           `json:"reason,omitempty"`
+	Source    string           `json:"source,omitempty"` // 응답 전용 (외부 동기화 출처, 규칙을 수정해도 유지)
+}
+
+// BlacklistPolicyDTO 정책 요청/응답 구조체
+type BlacklistPolicyDTO struct {
+	Target       string           `json:"target"`
+	URLRules     []URLRuleDTO     `json:"url_rules"`
+	PatternRules []PatternRuleDTO `json:"pattern_rules"`
+	Lockdown     *LockdownDTO     `json:"lockdown,omitempty"` // 응답 전용 (켜고 끄기는 /lockdown)
+}
+
+// AllowedAppDTO 시험 모드 허용 앱 패턴
+type AllowedAppDTO struct {
+	Pattern string `json:"pattern"`
+	Kind    string `json:"kind,omitempty"` // glob(기본) | regex
+}
+
+// LockdownDTO 시험 모드(허용 목록 전용) 요청/응답 구조체
+type LockdownDTO struct {
+	AllowedURLs []string        `json:"allowed_urls"` // "docs.python.org", "exam.school.kr/test"
+	AllowedApps []AllowedAppDTO `json:"allowed_apps"`
+	CreatedBy   string          `json:"created_by,omitempty"`
+	CreatedAt   *time.Time      `json:"created_at,omitempty"` // 응답 전용
+	Reason      string          `json:"reason,omitempty"`
+}
+
+// HandleListPolicies 모든 정책 조회 핸들러
+// GET /api/v1/blacklist/policies
+func (h *BlacklistHandler) HandleListPolicies(c echo.Context) error {
+	policies := h.adminUseCase.ListPolicies()
+	response := make([]BlacklistPolicyDTO, len(policies))
+	for i, scoped := range policies {
+		response[i] = toBlacklistPolicyDTO(scoped.Target, scoped.Policy)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// HandleGetPolicy 정책 조회 핸들러
+// GET /api/v1/blacklist/policies/:target
+func (h *BlacklistHandler) HandleGetPolicy(c echo.Context) error {
+	target, err := domain.ParsePolicyTarget(c.Param("target"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	policy, err := h.adminUseCase.GetPolicy(target)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, toBlacklistPolicyDTO(target, policy))
+}
+
+// HandleReplacePolicy 정책 일괄 교체 핸들러
+// PUT /api/v1/blacklist/policies/:target
+// 본문의 규칙 목록으로 대상 정책 전체를 교체 (빈 목록이면 모두 삭제)
+func (h *BlacklistHandler) HandleReplacePolicy(c echo.Context) error {
+	target, err := domain.ParsePolicyTarget(c.Param("target"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	var req BlacklistPolicyDTO
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request body",
+		})
+	}
+
+	policy := &domain.BlacklistPolicy{}
+	for _, dto := range req.URLRules {
+		rule, err := dto.toDomain()
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": err.Error(),
+			})
+		}
+		policy.AddURLRule(rule)
+	}
+	for _, dto := range req.PatternRules {
+		rule, err := dto.toDomain()
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": err.Error(),
+			})
+		}
+		policy.AddPatternRule(rule)
+	}
+
+	if err := h.adminUseCase.ReplacePolicy(target, policy); err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+	updated, _ := h.adminUseCase.GetPolicy(target)
+	return c.JSON(http.StatusOK, toBlacklistPolicyDTO(target, updated))
+}
+
+// HandleSetLockdown 시험 모드 켜기 핸들러 (그룹/클라이언트만)
+// PUT /api/v1/blacklist/policies/:target/lockdown
+// 켜져 있는 동안 허용 목록 밖의 URL은 BLOCK_URL, 앱은 CLOSE_APP
+func (h *BlacklistHandler) HandleSetLockdown(c echo.Context) error {
+	target, err := domain.ParsePolicyTarget(c.Param("target"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	var req LockdownDTO
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request body",
+		})
+	}
+	lockdown, err := req.toDomain()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	enabled, err := h.adminUseCase.SetLockdown(target, lockdown)
+	if errors.Is(err, service.ErrLockdownScope) {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, toLockdownDTO(&enabled))
+}
+
+// HandleClearLockdown 시험 모드 끄기 핸들러 (평소 정책으로 복귀)
+// DELETE /api/v1/blacklist/policies/:target/lockdown
+func (h *BlacklistHandler) HandleClearLockdown(c echo.Context) error {
+	target, err := domain.ParsePolicyTarget(c.Param("target"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	err = h.adminUseCase.ClearLockdown(target)
+	if errors.Is(err, service.ErrLockdownNotActive) {
+		return c.JSON(http.StatusNotFound, map[string]string{
+			"error": err.Error(),
+		})
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// HandleAddURLRule URL 규칙 추가 핸들러
+// POST /api/v1/blacklist/policies/:target/urls
+func (h *BlacklistHandler) HandleAddURLRule(c echo.Context) error {
+	target, err := domain.ParsePolicyTarget(c.Param("target"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	var req URLRuleDTO
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request body",
+		})
+	}
+	rule, err := req.toDomain()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	added, err := h.adminUseCase.AddURLRule(target, rule)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+	return c.JSON(http.StatusCreated, toURLRuleDTO(added))
+}
+
+// HandleRemoveURLRule URL 규칙 제거 핸들러
+// DELETE /api/v1/blacklist/policies/:target/urls?pattern=youtube.com/shorts
+func (h *BlacklistHandler) HandleRemoveURLRule(c echo.Context) error {
+	target, err := domain.ParsePolicyTarget(c.Param("target"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	err = h.adminUseCase.RemoveURLRule(target, c.QueryParam("pattern"))
+	return h.removeResult(c, err)
+}
+
+// HandleAddPatternRule 앱 이름/창 제목 규칙 추가 핸들러
+// POST /api/v1/blacklist/policies/:target/patterns
+func (h *BlacklistHandler) HandleAddPatternRule(c echo.Context) error {
+	target, err := domain.ParsePolicyTarget(c.Param("target"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	var req PatternRuleDTO
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request body",
+		})
+	}
+	rule, err := req.toDomain()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	added, err := h.adminUseCase.AddPatternRule(target, rule)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+	return c.JSON(http.StatusCreated, toPatternRuleDTO(added))
+}
+
+// HandleRemovePatternRule 앱 이름/창 제목 규칙 제거 핸들러
+// DELETE /api/v1/blacklist/policies/:target/patterns?field=app&kind=glob&pattern=steam*
+func (h *BlacklistHandler) HandleRemovePatternRule(c echo.Context) error {
+	target, err := domain.ParsePolicyTarget(c.Param("target"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	kind := c.QueryParam("kind")
+	if kind == "" {
+		kind = string(domain.PatternGlob)
+	}
+	rule, err := domain.NewAllowPatternRule(domain.PatternField(c.QueryParam("field")), domain.PatternKind(kind), c.QueryParam("pattern"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	err = h.adminUseCase.RemovePatternRule(target, rule.Key())
+	return h.removeResult(c, err)
+}
+
+// removeResult 규칙 제거 결과 응답
+func (h *BlacklistHandler) removeResult(c echo.Context, err error) error {
+	if errors.Is(err, service.ErrBlacklistRuleNotFound) {
+		return c.JSON(http.StatusNotFound, map[string]string{
+			"error": err.Error(),
+		})
+	}
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RulePreviewResponse 규칙 미리보기 응답 구조체
+type RulePreviewResponse struct {
+	Scope     string `json:"scope"`              // global | group | client
+	ScopeID   string `json:"scope_id,omitempty"` // 그룹/클라이언트 ID
+	Field     string `json:"field"`              // url | app | window_title
+	Kind      string `json:"kind,omitempty"`     // glob | regex (앱/창 제목 규칙)
+	Pattern   string `json:"pattern"`
+	Allow     bool   `json:"allow"`
+	Action    string `json:"action,omitempty"`
+	Intensity int    `json:"intensity,omitempty"`
+	Schedule  string `json:"schedule"`
+	Active    bool   `json:"active"`
+}
+
+// HandlePreview 규칙 미리보기 핸들러
+// GET /api/v1/clients/:id/blacklist/preview?at=
+// at: Unix ms 또는 RFC3339 (생략 시 현재), 클라이언트 시간대와 학습 세션 기준으로 적용 여부 계산
+func (h *BlacklistHandler) HandlePreview(c echo.Context) error {
+	clientID := c.Param("id")
+	if clientID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "client id is required",
+		})
+	}
+
+	at, err := parseTimeParam(c.QueryParam("at"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "invalid at",
+		})
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	previews := h.previewUseCase.PreviewRules(clientID, at)
+	response := make([]RulePreviewResponse, len(previews))
+	for i, preview := range previews {
+		response[i] = toRulePreviewResponse(preview)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// toRulePreviewResponse Domain 미리보기를 DTO로 변환
+func toRulePreviewResponse(preview domain.RulePreview) RulePreviewResponse {
+	return RulePreviewResponse{
+		Scope:     string(preview.Target.Scope),
+		ScopeID:   preview.Target.ID,
+		Field:     preview.Field,
+		Kind:      string(preview.Kind),
+		Pattern:   preview.Pattern,
+		Allow:     preview.Allow,
+		Action:    string(preview.Action),
+		Intensity: preview.Intensity,
+		Schedule:  preview.Schedule,
+		Active:    preview.Active,
+	}
+}
+
+// toDomain 요청을 Domain URL 규칙으로 변환
+func (d URLRuleDTO) toDomain() (domain.URLRule, error) {
+	rule, err := domain.ParseURLRule(d.Pattern)
+	if err != nil {
+		return domain.URLRule{}, err
+	}
+	if rule.Schedule, err = d.Schedule.toDomain(); err != nil {
+		return domain.URLRule{}, err
+	}
+	rule.Allow = d.Allow
+	rule.Meta = domain.RuleMetadata{CreatedBy: d.CreatedBy, Reason: d.Reason}
+	return rule, nil
+}
+
+// toDomain 요청을 Domain 패턴 규칙으로 변환 (kind 생략 시 glob, action 생략 시 CLOSE_APP)
+func (d PatternRuleDTO) toDomain() (domain.PatternRule, error) {
+	kind := domain.PatternKind(d.Kind)
+	if kind == "" {
+		kind = domain.PatternGlob
+	}
+	var rule domain.PatternRule
+	var err error
+	if d.Allow {
+		rule, err = domain.NewAllowPatternRule(domain.PatternField(d.Field), kind, d.Pattern)
+	} else {
+		action := domain.ActionType(d.Action)
+		if action == "" {
+			action = domain.ActionCloseApp
+		}
+		rule, err = domain.NewPatternRule(domain.PatternField(d.Field), kind, d.Pattern, action, d.Intensity)
+	}
+	if err != nil {
+		return domain.PatternRule{}, err
+	}
+	if rule.Schedule, err = d.Schedule.toDomain(); err != nil {
+		return domain.PatternRule{}, err
+	}
+	rule.Message = d.Message
+	rule.Meta = domain.RuleMetadata{CreatedBy: d.CreatedBy, Reason: d.Reason}
+	return rule, nil
+}
+
+// toDomain 요청을 Domain 시험 모드로 변환
+func (d LockdownDTO) toDomain() (domain.Lockdown, error) {
+	urls := make([]domain.URLRule, 0, len(d.AllowedURLs))
+	for _, pattern := range d.AllowedURLs {
+		rule, err := domain.ParseURLRule(pattern)
+		if err != nil {
+			return domain.Lockdown{}, err
+		}
+		urls = append(urls, rule)
+	}
+	apps := make([]domain.PatternRule, 0, len(d.AllowedApps))
+	for _, app := range d.AllowedApps {
+		kind := domain.PatternKind(app.Kind)
+		if kind == "" {
+			kind = domain.PatternGlob
+		}
+		rule, err := domain.NewAllowPatternRule(domain.PatternFieldApp, kind, app.Pattern)
+		if err != nil {
+			return domain.Lockdown{}, err
+		}
+		apps = append(apps, rule)
+	}
+
+	lockdown, err := domain.NewLockdown(urls, apps)
+	if err != nil {
+		return domain.Lockdown{}, err
+	}
+	lockdown.Meta = domain.RuleMetadata{CreatedBy: d.CreatedBy, Reason: d.Reason}
+	return lockdown, nil
+}
+
+// toLockdownDTO Domain 시험 모드를 DTO로 변환 (nil이면 nil)
+func toLockdownDTO(lockdown *domain.Lockdown) *LockdownDTO {
+	if lockdown == nil {
+		return nil
+	}
+	dto := &LockdownDTO{
+		AllowedURLs: make([]string, len(lockdown.AllowedURLs)),
+		AllowedApps: make([]AllowedAppDTO, len(lockdown.AllowedApps)),
+		CreatedBy:   lockdown.Meta.CreatedBy,
+		CreatedAt:   createdAtOf(lockdown.Meta),
+		Reason:      lockdown.Meta.Reason,
+	}
+	for i, rule := range lockdown.AllowedURLs {
+		dto.AllowedURLs[i] = rule.String()
+	}
+	for i, rule := range lockdown.AllowedApps {
+		dto.AllowedApps[i] = AllowedAppDTO{Pattern: rule.Pattern, Kind: string(rule.Kind)}
+	}
+	return dto
+}
+
+// toDomain 요청 일정을 Domain 일정으로 변환 (nil이면 항상)
+func (d *RuleScheduleDTO) toDomain() (*domain.RuleSchedule, error) {
+	if d == nil {
+		return nil, nil
+	}
+	return domain.NewRuleSchedule(d.Windows, d.StudySessionsOnly)
+}
+
+// toRuleScheduleDTO Domain 일정을 DTO로 변환
+func toRuleScheduleDTO(schedule *domain.RuleSchedule) *RuleScheduleDTO {
+	if schedule == nil {
+		return nil
+	}
+	dto := &RuleScheduleDTO{StudySessionsOnly: schedule.StudySessionsOnly}
+	for _, window := range schedule.Windows {
+		dto.Windows = append(dto.Windows, window.String())
+	}
+	return dto
+}
+
+// toURLRuleDTO Domain URL 규칙을 DTO로 변환
+func toURLRuleDTO(rule domain.URLRule) URLRuleDTO {
+	return URLRuleDTO{
+		Pattern:   rule.String(),
+		Allow:     rule.Allow,
+		Schedule:  toRuleScheduleDTO(rule.Schedule),
+		CreatedBy: rule.Meta.CreatedBy,
+		CreatedAt: createdAtOf(rule.Meta),
+		Reason:    rule.Meta.Reason,
+		Source:    rule.Meta.Source,
+	}
+}
+
+// toPatternRuleDTO Domain 패턴 규칙을 DTO로 변환
+func toPatternRuleDTO(rule domain.PatternRule) PatternRuleDTO {
+	return PatternRuleDTO{
+		Field:     string(rule.Field),
+		Kind:      string(rule.Kind),
+		Pattern:   rule.Pattern,
+		Action:    string(rule.Action),
+		Intensity: rule.Intensity,
+		Message:   rule.Message,
+		Allow:     rule.Allow,
+		Schedule:  toRuleScheduleDTO(rule.Schedule),
+		CreatedBy: rule.Meta.CreatedBy,
+		CreatedAt: createdAtOf(rule.Meta),
+		Reason:    rule.Meta.Reason,
+		Source:    rule.Meta.Source,
+	}
+}
+
+// toBlacklistPolicyDTO Domain 정책을 DTO로 변환
+func toBlacklistPolicyDTO(target domain.PolicyTarget, policy *domain.BlacklistPolicy) BlacklistPolicyDTO {
+	dto := BlacklistPolicyDTO{
+		Target:       target.String(),
+		URLRules:     make([]URLRuleDTO, len(policy.URLRules)),
+		PatternRules: make([]PatternRuleDTO, len(policy.PatternRules)),
+		Lockdown:     toLockdownDTO(policy.Lockdown),
+	}
+	for i, rule := range policy.URLRules {
+		dto.URLRules[i] = toURLRuleDTO(rule)
+	}
+	for i, rule := range policy.PatternRules {
+		dto.PatternRules[i] = toPatternRuleDTO(rule)
+	}
+	return dto
+}
+
+// createdAtOf 등록 시각 (기록이 없으면 nil)
+func createdAtOf(meta domain.RuleMetadata) *time.Time {
+	if meta.CreatedAt.IsZero() {
+		return nil
+	}
+	createdAt := meta.CreatedAt
+	return &createdAt
+}
+
+// RegisterRoutes Echo 라우터에 핸들러 등록
+func (h *BlacklistHandler) RegisterRoutes(e *echo.Echo) {
+	api := e.Group("/api/v1")
+	api.GET("/clients/:id/blacklist/preview", h.HandlePreview)
+	api.GET("/blacklist/policies", h.HandleListPolicies)
+	api.GET("/blacklist/policies/:target", h.HandleGetPolicy)
+	// 규칙 변경과 시험 모드 전환은 관리자만
+	api.PUT("/blacklist/policies/:target", h.HandleReplacePolicy, h.adminAuth.Require)
+	api.POST("/blacklist/policies/:target/urls", h.HandleAddURLRule, h.adminAuth.Require)
+	api.DELETE("/blacklist/policies/:target/urls", h.HandleRemoveURLRule, h.adminAuth.Require)
+	api.POST("/blacklist/policies/:target/patterns", h.HandleAddPatternRule, h.adminAuth.Require)
+	api.DELETE("/blacklist/policies/:target/patterns", h.HandleRemovePatternRule, h.adminAuth.Require)
+	api.PUT("/blacklist/policies/:target/lockdown", h.HandleSetLockdown, h.adminAuth.Require)
+	api.DELETE("/blacklist/policies/:target/lockdown", h.HandleClearLockdown, h.adminAuth.Require)
+}
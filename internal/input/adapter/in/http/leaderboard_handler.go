@@ -0,0 +1,234 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+	"jiaa-server-core/internal/input/service"
+)
+
+// LeaderboardHandler 스터디 그룹 리더보드 HTTP Driving Adapter
+type LeaderboardHandler struct {
+	leaderboardUseCase portin.LeaderboardUseCase
+	memberUseCase      portin.LeaderboardMemberUseCase
+	adminAuth          *AdminAuth // 그룹 변경 권한 (없으면 그룹 변경 불가)
+}
+
+// NewLeaderboardHandler LeaderboardHandler 생성자
+func NewLeaderboardHandler(leaderboardUseCase portin.LeaderboardUseCase, memberUseCase portin.LeaderboardMemberUseCase) *LeaderboardHandler {
+	return &LeaderboardHandler{
+		leaderboardUseCase: leaderboardUseCase,
+		memberUseCase:      memberUseCase,
+	}
+}
+
+// SetAdminAuth 관리자 인증 설정 (그룹 변경은 관리자만)
+func (h *LeaderboardHandler) SetAdminAuth(adminAuth *AdminAuth) {
+	h.adminAuth = adminAuth
+}
+
+// MembershipRequest 그룹 소속/공개 설정 요청 구조체
+// group_id는 관리자만 바꿀 수 있고, 생략하면 현재 그룹 유지
+type MembershipRequest struct {
+	GroupID     *string `json:"group_id,omitempty"`
+	DisplayName string  `json:"display_name,omitempty"`
+	OptOut      bool    `json:"opt_out"`
+}
+
+// MembershipResponse 그룹 소속/공개 설정 응답 구조체
+type MembershipResponse struct {
+	ClientID    string `json:"client_id"`
+	GroupID     string `json:"group_id"`
+	DisplayName string `json:"display_name,omitempty"`
+	OptOut      bool   `json:"opt_out"`
+}
+
+// LeaderboardEntryResponse 리더보드 한 줄 응답 구조체
+type LeaderboardEntryResponse struct {
+	Rank           int     `json:"rank"`
+	ClientID       string  `json:"client_id"`
+	DisplayName    string  `json:"display_name"`
+	FocusedSeconds int64   `json:"focused_seconds"`
+	AverageScore   float64 `json:"average_score"`
+}
+
+// LeaderboardResponse 리더보드 응답 구조체
+type LeaderboardResponse struct {
+	GroupID string                     `json:"group_id"`
+	Period  string                     `json:"period"`
+	Metric  string                     `json:"metric"`
+	From    int64                      `json:"from,omitempty"` // Unix ms (all_time이면 생략)
+	To      int64                      `json:"to,omitempty"`   // Unix ms (all_time이면 생략)
+	Entries []LeaderboardEntryResponse `json:"entries"`
+}
+
+// HandleGetLeaderboard 그룹 리더보드 조회 핸들러
+// GET /api/v1/groups/:id/leaderboard?period=&metric=&limit=
+// period: daily | weekly | all_time (기본 daily), metric: focused_time | average_score (기본 focused_time)
+func (h *LeaderboardHandler) HandleGetLeaderboard(c echo.Context) error {
+	groupID := c.Param("id")
+	if groupID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "group id is required",
+		})
+	}
+
+	period, err := domain.ParseLeaderboardPeriod(c.QueryParam("period"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+	metric, err := domain.ParseLeaderboardMetric(c.QueryParam("metric"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+	var limit int
+	if raw := c.QueryParam("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit <= 0 {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": "limit must be a positive integer",
+			})
+		}
+	}
+
+	board, err := h.leaderboardUseCase.GetLeaderboard(groupID, period, metric, limit)
+	if errors.Is(err, service.ErrAllTimeUnavailable) {
+		return c.JSON(http.StatusNotImplemented, map[string]string{
+			"error": err.Error(),
+		})
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, toLeaderboardResponse(board))
+}
+
+// HandleGetMembership 그룹 소속/공개 설정 조회 핸들러
+// GET /api/v1/clients/:id/leaderboard
+func (h *LeaderboardHandler) HandleGetMembership(c echo.Context) error {
+	clientID := c.Param("id")
+	if clientID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "client id is required",
+		})
+	}
+
+	member, err := h.memberUseCase.GetMembership(clientID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, toMembershipResponse(member))
+}
+
+// HandleSetMembership 그룹 소속/공개 설정 핸들러
+// PUT /api/v1/clients/:id/leaderboard
+// 표시 이름과 비공개 여부는 본인이 설정하고, 그룹 변경은 관리자 토큰 필요 (그룹 차단 정책/시험 잠금 회피 방지)
+func (h *LeaderboardHandler) HandleSetMembership(c echo.Context) error {
+	clientID := c.Param("id")
+	if clientID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "client id is required",
+		})
+	}
+
+	var req MembershipRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request body",
+		})
+	}
+
+	current, err := h.memberUseCase.GetMembership(clientID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	member := domain.LeaderboardMember{
+		ClientID:    clientID,
+		GroupID:     current.GroupID,
+		DisplayName: req.DisplayName,
+		OptOut:      req.OptOut,
+	}
+	if req.GroupID != nil && strings.TrimSpace(*req.GroupID) != current.GroupID {
+		if !h.adminAuth.IsAdmin(c) {
+			return c.JSON(http.StatusForbidden, map[string]string{
+				"error": "changing group_id requires an admin token",
+			})
+		}
+		member.GroupID = *req.GroupID
+	}
+	if err := member.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	member, err = h.memberUseCase.SetMembership(member)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, toMembershipResponse(member))
+}
+
+// toLeaderboardResponse Domain 리더보드를 DTO로 변환
+func toLeaderboardResponse(board domain.Leaderboard) LeaderboardResponse {
+	response := LeaderboardResponse{
+		GroupID: board.GroupID,
+		Period:  string(board.Period),
+		Metric:  string(board.Metric),
+		Entries: make([]LeaderboardEntryResponse, 0, len(board.Entries)),
+	}
+	if !board.From.IsZero() {
+		response.From = board.From.UnixMilli()
+		response.To = board.To.UnixMilli()
+	}
+	for _, entry := range board.Entries {
+		response.Entries = append(response.Entries, LeaderboardEntryResponse{
+			Rank:           entry.Rank,
+			ClientID:       entry.ClientID,
+			DisplayName:    entry.DisplayName,
+			FocusedSeconds: entry.FocusedSeconds,
+			AverageScore:   entry.AverageScore,
+		})
+	}
+	return response
+}
+
+// toMembershipResponse Domain 소속을 DTO로 변환
+func toMembershipResponse(member domain.LeaderboardMember) MembershipResponse {
+	return MembershipResponse{
+		ClientID:    member.ClientID,
+		GroupID:     member.GroupID,
+		DisplayName: member.DisplayName,
+		OptOut:      member.OptOut,
+	}
+}
+
+// RegisterRoutes Echo 라우터에 핸들러 등록
+func (h *LeaderboardHandler) RegisterRoutes(e *echo.Echo) {
+	api := e.Group("/api/v1")
+	api.GET("/groups/:id/leaderboard", h.HandleGetLeaderboard)
+	api.GET("/clients/:id/leaderboard", h.HandleGetMembership)
+	api.PUT("/clients/:id/leaderboard", h.HandleSetMembership)
+}
@@ -0,0 +1,75 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+)
+
+// ReportHandler 주간 학습 리포트 HTTP Driving Adapter
+type ReportHandler struct {
+	reportUseCase portin.WeeklyReportUseCase
+}
+
+// NewReportHandler ReportHandler 생성자
+func NewReportHandler(reportUseCase portin.WeeklyReportUseCase) *ReportHandler {
+	return &ReportHandler{
+		reportUseCase: reportUseCase,
+	}
+}
+
+// HandleGetWeeklyReport 주간 리포트 다운로드 핸들러 (text/markdown)
+// GET /api/v1/clients/:id/reports/weekly?week=
+// week: this_week(기본) | last_week | YYYY-MM-DD (해당 날짜가 속한 주)
+func (h *ReportHandler) HandleGetWeeklyReport(c echo.Context) error {
+	clientID := c.Param("id")
+	if clientID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "client id is required",
+		})
+	}
+
+	weekOf, err := parseWeekParam(c.QueryParam("week"), time.Now())
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	report, err := h.reportUseCase.GetWeeklyReport(clientID, weekOf)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	filename := fmt.Sprintf("weekly-report-%s-%s.md", clientID, report.WeekStart.Format(domain.DayLayout))
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	return c.Blob(http.StatusOK, "text/markdown; charset=utf-8", []byte(report.Markdown()))
+}
+
+// parseWeekParam 리포트 주 파라미터 파싱 (해당 주에 속한 시간 반환)
+func parseWeekParam(value string, now time.Time) (time.Time, error) {
+	switch value {
+	case "", string(domain.PeriodThisWeek):
+		return now, nil
+	case string(domain.PeriodLastWeek):
+		return now.AddDate(0, 0, -7), nil
+	}
+	day, err := time.ParseInLocation(domain.DayLayout, value, now.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid week %q (this_week | last_week | YYYY-MM-DD)", value)
+	}
+	return day, nil
+}
+
+// RegisterRoutes Echo 라우터에 핸들러 등록
+func (h *ReportHandler) RegisterRoutes(e *echo.Echo) {
+	api := e.Group("/api/v1")
+	api.GET("/clients/:id/reports/weekly", h.HandleGetWeeklyReport)
+}
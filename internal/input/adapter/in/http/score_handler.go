@@ -0,0 +1,130 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+)
+
+// ScoreHistoryHandler 점수 이력 조회 HTTP Driving Adapter
+// 세션 그래프 및 판정 사후 디버깅용
+type ScoreHistoryHandler struct {
+	historyUseCase portin.ScoreHistoryUseCase
+}
+
+// NewScoreHistoryHandler ScoreHistoryHandler 생성자
+func NewScoreHistoryHandler(historyUseCase portin.ScoreHistoryUseCase) *ScoreHistoryHandler {
+	return &ScoreHistoryHandler{
+		historyUseCase: historyUseCase,
+	}
+}
+
+// ScoreSampleResponse 점수 샘플 응답 구조체
+type ScoreSampleResponse struct {
+	Timestamp int64   `json:"timestamp"` // Unix ms (버킷 시작)
+	AvgScore  float64 `json:"avg_score"`
+	MinScore  int     `json:"min_score"`
+	MaxScore  int     `json:"max_score"`
+	State     string  `json:"state"`
+	Count     int     `json:"count"`
+}
+
+// ScoreHistoryResponse 점수 이력 응답 구조체
+type ScoreHistoryResponse struct {
+	ClientID   string                `json:"client_id"`
+	Resolution string                `json:"resolution"`
+	Samples    []ScoreSampleResponse `json:"samples"`
+}
+
+// HandleGetScores 점수 이력 조회 핸들러
+// GET /api/v1/clients/:id/scores?from=&to=&resolution=
+// from/to: Unix ms 또는 RFC3339, resolution: 1s | 1m | 1h (생략 시 기간에 맞춰 자동)
+func (h *ScoreHistoryHandler) HandleGetScores(c echo.Context) error {
+	clientID := c.Param("id")
+	if clientID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "client id is required",
+		})
+	}
+
+	from, err := parseTimeParam(c.QueryParam("from"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": fmt.Sprintf("invalid from: %v", err),
+		})
+	}
+	to, err := parseTimeParam(c.QueryParam("to"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": fmt.Sprintf("invalid to: %v", err),
+		})
+	}
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "from must be before to",
+		})
+	}
+
+	var resolution domain.Resolution
+	if raw := c.QueryParam("resolution"); raw != "" {
+		resolution, err = domain.ParseResolution(raw)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	samples, resolution, err := h.historyUseCase.GetScoreHistory(clientID, from, to, resolution)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	response := ScoreHistoryResponse{
+		ClientID:   clientID,
+		Resolution: string(resolution),
+		Samples:    make([]ScoreSampleResponse, 0, len(samples)),
+	}
+	for _, sample := range samples {
+		response.Samples = append(response.Samples, toScoreSampleResponse(sample))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// toScoreSampleResponse Domain 샘플을 DTO로 변환
+func toScoreSampleResponse(sample domain.ScoreSample) ScoreSampleResponse {
+	return ScoreSampleResponse{
+		Timestamp: sample.Timestamp.UnixMilli(),
+		AvgScore:  sample.AvgScore,
+		MinScore:  sample.MinScore,
+		MaxScore:  sample.MaxScore,
+		State:     sample.State,
+		Count:     sample.Count,
+	}
+}
+
+// parseTimeParam 쿼리 시간 파라미터 파싱 (Unix ms 또는 RFC3339, 비어 있으면 zero)
+func parseTimeParam(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if ms, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.UnixMilli(ms), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// RegisterRoutes Echo 라우터에 핸들러 등록
+func (h *ScoreHistoryHandler) RegisterRoutes(e *echo.Echo) {
+	api := e.Group("/api/v1")
+	api.GET("/clients/:id/scores", h.HandleGetScores)
+}
@@ -0,0 +1,125 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+)
+
+// StreakHandler 하루 목표/연속 달성 HTTP Driving Adapter
+type StreakHandler struct {
+	streakUseCase portin.StreakUseCase
+}
+
+// NewStreakHandler StreakHandler 생성자
+func NewStreakHandler(streakUseCase portin.StreakUseCase) *StreakHandler {
+	return &StreakHandler{
+		streakUseCase: streakUseCase,
+	}
+}
+
+// DailyGoalRequest 하루 목표 설정 요청 구조체
+type DailyGoalRequest struct {
+	DailyGoalMinutes int    `json:"daily_goal_minutes"`
+	Timezone         string `json:"timezone,omitempty"` // IANA 시간대 (예: Asia/Seoul)
+}
+
+// StreakResponse 연속 달성 현황 응답 구조체
+type StreakResponse struct {
+	ClientID          string `json:"client_id"`
+	DailyGoalSeconds  int64  `json:"daily_goal_seconds"`
+	Timezone          string `json:"timezone,omitempty"`
+	TodayFocusSeconds int64  `json:"today_focus_seconds"`
+	RemainingSeconds  int64  `json:"remaining_seconds"`
+	GoalMetToday      bool   `json:"goal_met_today"`
+	CurrentStreak     int    `json:"current_streak"`
+	BestStreak        int    `json:"best_streak"`
+	AtRisk            bool   `json:"at_risk"`
+}
+
+// HandleGetStreak 연속 달성 현황 조회 핸들러
+// GET /api/v1/clients/:id/streak
+func (h *StreakHandler) HandleGetStreak(c echo.Context) error {
+	clientID := c.Param("id")
+	if clientID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "client id is required",
+		})
+	}
+
+	status, err := h.streakUseCase.GetStreak(clientID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, toStreakResponse(status))
+}
+
+// HandleSetDailyGoal 하루 목표 설정 핸들러
+// PUT /api/v1/clients/:id/goal
+func (h *StreakHandler) HandleSetDailyGoal(c echo.Context) error {
+	clientID := c.Param("id")
+	if clientID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "client id is required",
+		})
+	}
+
+	var req DailyGoalRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request body",
+		})
+	}
+
+	goal := time.Duration(req.DailyGoalMinutes) * time.Minute
+	if err := domain.ValidateDailyGoal(goal); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+	if req.Timezone != "" {
+		if _, err := time.LoadLocation(req.Timezone); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": "invalid timezone",
+			})
+		}
+	}
+
+	status, err := h.streakUseCase.SetDailyGoal(clientID, goal, req.Timezone)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, toStreakResponse(status))
+}
+
+// toStreakResponse Domain 현황을 DTO로 변환
+func toStreakResponse(status domain.StreakStatus) StreakResponse {
+	return StreakResponse{
+		ClientID:          status.ClientID,
+		DailyGoalSeconds:  int64(status.DailyGoal / time.Second),
+		Timezone:          status.Timezone,
+		TodayFocusSeconds: int64(status.TodayFocus / time.Second),
+		RemainingSeconds:  int64(status.Remaining / time.Second),
+		GoalMetToday:      status.GoalMetToday,
+		CurrentStreak:     status.CurrentStreak,
+		BestStreak:        status.BestStreak,
+		AtRisk:            status.AtRisk,
+	}
+}
+
+// RegisterRoutes Echo 라우터에 핸들러 등록
+func (h *StreakHandler) RegisterRoutes(e *echo.Echo) {
+	api := e.Group("/api/v1")
+	api.GET("/clients/:id/streak", h.HandleGetStreak)
+	api.PUT("/clients/:id/goal", h.HandleSetDailyGoal)
+}
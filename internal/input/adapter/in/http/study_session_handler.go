@@ -0,0 +1,185 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+	"jiaa-server-core/internal/input/service"
+)
+
+// defaultStudySessionListRange 기간을 지정하지 않은 세션 조회 범위 (현재부터)
+const defaultStudySessionListRange = 7 * 24 * time.Hour
+
+// StudySessionHandler 학습 세션 선언 HTTP Driving Adapter
+type StudySessionHandler struct {
+	studySessionUseCase portin.StudySessionUseCase
+}
+
+// NewStudySessionHandler StudySessionHandler 생성자
+func NewStudySessionHandler(studySessionUseCase portin.StudySessionUseCase) *StudySessionHandler {
+	return &StudySessionHandler{
+		studySessionUseCase: studySessionUseCase,
+	}
+}
+
+// StudySessionRequest 학습 세션 선언 요청 구조체
+// start를 생략하면 지금부터, end 대신 duration_minutes로 길이 지정 가능
+type StudySessionRequest struct {
+	Start           string `json:"start,omitempty"` // Unix ms 또는 RFC3339
+	End             string `json:"end,omitempty"`   // Unix ms 또는 RFC3339
+	DurationMinutes int    `json:"duration_minutes,omitempty"`
+	Label           string `json:"label,omitempty"`
+}
+
+// StudySessionResponse 학습 세션 응답 구조체
+type StudySessionResponse struct {
+	ID       string    `json:"id"`
+	ClientID string    `json:"client_id"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	Label    string    `json:"label,omitempty"`
+}
+
+// HandleDeclareSession 학습 세션 선언 핸들러
+// POST /api/v1/clients/:id/study-sessions
+func (h *StudySessionHandler) HandleDeclareSession(c echo.Context) error {
+	clientID := c.Param("id")
+	if clientID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "client id is required",
+		})
+	}
+
+	var req StudySessionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request body",
+		})
+	}
+
+	start, err := parseTimeParam(req.Start)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "invalid start",
+		})
+	}
+	if start.IsZero() {
+		start = time.Now()
+	}
+	end, err := parseTimeParam(req.End)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "invalid end",
+		})
+	}
+	if end.IsZero() {
+		end = start.Add(time.Duration(req.DurationMinutes) * time.Minute)
+	}
+
+	session, err := h.studySessionUseCase.DeclareSession(clientID, start, end, req.Label)
+	if errors.Is(err, service.ErrStudySessionOverlap) {
+		return c.JSON(http.StatusConflict, map[string]string{
+			"error": err.Error(),
+		})
+	}
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusCreated, toStudySessionResponse(session))
+}
+
+// HandleListSessions 학습 세션 조회 핸들러
+// GET /api/v1/clients/:id/study-sessions?from=&to=
+// from/to: Unix ms 또는 RFC3339 (생략 시 지금부터 7일)
+func (h *StudySessionHandler) HandleListSessions(c echo.Context) error {
+	clientID := c.Param("id")
+	if clientID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "client id is required",
+		})
+	}
+
+	from, err := parseTimeParam(c.QueryParam("from"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "invalid from",
+		})
+	}
+	to, err := parseTimeParam(c.QueryParam("to"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "invalid to",
+		})
+	}
+	if from.IsZero() {
+		from = time.Now()
+	}
+	if to.IsZero() {
+		to = from.Add(defaultStudySessionListRange)
+	}
+
+	sessions, err := h.studySessionUseCase.ListSessions(clientID, from, to)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	response := make([]StudySessionResponse, len(sessions))
+	for i, session := range sessions {
+		response[i] = toStudySessionResponse(session)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// HandleCancelSession 학습 세션 취소 핸들러
+// DELETE /api/v1/clients/:id/study-sessions/:session_id
+func (h *StudySessionHandler) HandleCancelSession(c echo.Context) error {
+	clientID := c.Param("id")
+	if clientID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "client id is required",
+		})
+	}
+
+	err := h.studySessionUseCase.CancelSession(clientID, c.Param("session_id"))
+	if errors.Is(err, service.ErrStudySessionNotFound) {
+		return c.JSON(http.StatusNotFound, map[string]string{
+			"error": err.Error(),
+		})
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// toStudySessionResponse Domain 세션을 DTO로 변환
+func toStudySessionResponse(session domain.StudySession) StudySessionResponse {
+	return StudySessionResponse{
+		ID:       session.ID,
+		ClientID: session.ClientID,
+		Start:    session.Start,
+		End:      session.End,
+		Label:    session.Label,
+	}
+}
+
+// RegisterRoutes Echo 라우터에 핸들러 등록
+func (h *StudySessionHandler) RegisterRoutes(e *echo.Echo) {
+	api := e.Group("/api/v1")
+	api.POST("/clients/:id/study-sessions", h.HandleDeclareSession)
+	api.GET("/clients/:id/study-sessions", h.HandleListSessions)
+	api.DELETE("/clients/:id/study-sessions/:session_id", h.HandleCancelSession)
+}
@@ -0,0 +1,265 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+	"jiaa-server-core/internal/input/service"
+)
+
+// UnlockPassHandler 차단 해제 이용권 HTTP Driving Adapter
+type UnlockPassHandler struct {
+	unlockPassUseCase portin.UnlockPassUseCase
+	adminAuth         *AdminAuth // 발급/회수 권한 (없으면 발급/회수 불가)
+}
+
+// NewUnlockPassHandler UnlockPassHandler 생성자
+func NewUnlockPassHandler(unlockPassUseCase portin.UnlockPassUseCase) *UnlockPassHandler {
+	return &UnlockPassHandler{
+		unlockPassUseCase: unlockPassUseCase,
+	}
+}
+
+// SetAdminAuth 관리자 인증 설정 (발급/회수는 관리자만, RegisterRoutes 전에 호출)
+func (h *UnlockPassHandler) SetAdminAuth(adminAuth *AdminAuth) {
+	h.adminAuth = adminAuth
+}
+
+// UnlockPassRequest 이용권 발급 요청 구조체
+// 규칙은 블랙리스트 규칙과 같은 형식으로 지정 (field=url이면 pattern이 URL 규칙, app/window_title이면 kind+pattern)
+type UnlockPassRequest struct {
+	Field           string `json:"field"`
+	Kind            string `json:"kind,omitempty"` // glob(기본), regex
+	Pattern         string `json:"pattern"`
+	DurationMinutes int    `json:"duration_minutes,omitempty"` // 생략 시 기본 시간
+	GrantedBy       string `json:"granted_by"`
+	Reason          string `json:"reason,omitempty"`
+}
+
+// UnlockPassResponse 이용권 응답 구조체
+type UnlockPassResponse struct {
+	ID        string     `json:"id"`
+	ClientID  string     `json:"client_id"`
+	Field     string     `json:"field"`
+	Rule      string     `json:"rule"`
+	GrantedBy string     `json:"granted_by,omitempty"`
+	Reason    string     `json:"reason,omitempty"`
+	IssuedAt  time.Time  `json:"issued_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	Active    bool       `json:"active"`
+}
+
+// UnlockAuditResponse 감사 기록 응답 구조체
+type UnlockAuditResponse struct {
+	At       time.Time `json:"at"`
+	ClientID string    `json:"client_id"`
+	Action   string    `json:"action"`
+	PassID   string    `json:"pass_id,omitempty"`
+	Rule     string    `json:"rule,omitempty"`
+	Actor    string    `json:"actor,omitempty"`
+	Target   string    `json:"target,omitempty"`
+	Detail   string    `json:"detail,omitempty"`
+}
+
+// HandleGrantPass 이용권 발급 핸들러 (관리자 토큰 필요)
+// POST /api/v1/clients/:id/unlock-passes
+func (h *UnlockPassHandler) HandleGrantPass(c echo.Context) error {
+	clientID := c.Param("id")
+	if clientID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "client id is required",
+		})
+	}
+
+	var req UnlockPassRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request body",
+		})
+	}
+	if req.GrantedBy == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "granted_by is required",
+		})
+	}
+	ruleKey, err := req.ruleKey()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	duration := time.Duration(req.DurationMinutes) * time.Minute
+	pass, err := h.unlockPassUseCase.GrantPass(clientID, req.Field, ruleKey, duration, req.GrantedBy, req.Reason)
+	switch {
+	case errors.Is(err, service.ErrUnlockRuleNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{
+			"error": err.Error(),
+		})
+	case errors.Is(err, service.ErrUnlockQuotaExceeded):
+		return c.JSON(http.StatusTooManyRequests, map[string]string{
+			"error": err.Error(),
+		})
+	case err != nil:
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusCreated, toUnlockPassResponse(pass, time.Now()))
+}
+
+// HandleListPasses 이용권 조회 핸들러
+// GET /api/v1/clients/:id/unlock-passes?from=&to=
+// from/to: Unix ms 또는 RFC3339 (생략 시 오늘)
+func (h *UnlockPassHandler) HandleListPasses(c echo.Context) error {
+	clientID := c.Param("id")
+	from, to, err := parseDayRange(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	passes, err := h.unlockPassUseCase.ListPasses(clientID, from, to)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	now := time.Now()
+	response := make([]UnlockPassResponse, len(passes))
+	for i, pass := range passes {
+		response[i] = toUnlockPassResponse(pass, now)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// HandleRevokePass 이용권 조기 회수 핸들러 (관리자 토큰 필요)
+// DELETE /api/v1/clients/:id/unlock-passes/:pass_id?revoked_by=
+func (h *UnlockPassHandler) HandleRevokePass(c echo.Context) error {
+	err := h.unlockPassUseCase.RevokePass(c.Param("id"), c.Param("pass_id"), c.QueryParam("revoked_by"))
+	if errors.Is(err, service.ErrUnlockPassNotFound) {
+		return c.JSON(http.StatusNotFound, map[string]string{
+			"error": err.Error(),
+		})
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// HandleListAudit 이용권 감사 기록 조회 핸들러
+// GET /api/v1/clients/:id/unlock-passes/audit?from=&to=
+// from/to: Unix ms 또는 RFC3339 (생략 시 오늘)
+func (h *UnlockPassHandler) HandleListAudit(c echo.Context) error {
+	clientID := c.Param("id")
+	from, to, err := parseDayRange(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	entries, err := h.unlockPassUseCase.ListAudit(clientID, from, to)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	response := make([]UnlockAuditResponse, len(entries))
+	for i, entry := range entries {
+		response[i] = UnlockAuditResponse{
+			At:       entry.At,
+			ClientID: entry.ClientID,
+			Action:   string(entry.Action),
+			PassID:   entry.PassID,
+			Rule:     entry.RuleKey,
+			Actor:    entry.Actor,
+			Target:   entry.Target,
+			Detail:   entry.Detail,
+		}
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// ruleKey 요청한 규칙의 식별자 (URL 규칙은 정규화한 패턴, 앱/창 제목 규칙은 PatternRule.Key)
+func (r UnlockPassRequest) ruleKey() (string, error) {
+	if r.Field == domain.RuleFieldURL {
+		rule, err := domain.ParseURLRule(r.Pattern)
+		if err != nil {
+			return "", err
+		}
+		return rule.String(), nil
+	}
+
+	kind := domain.PatternKind(r.Kind)
+	if kind == "" {
+		kind = domain.PatternGlob
+	}
+	rule, err := domain.NewAllowPatternRule(domain.PatternField(r.Field), kind, r.Pattern)
+	if err != nil {
+		return "", err
+	}
+	return rule.Key(), nil
+}
+
+// parseDayRange from/to 쿼리 (생략 시 서버 시간대 오늘 하루)
+func parseDayRange(c echo.Context) (time.Time, time.Time, error) {
+	from, err := parseTimeParam(c.QueryParam("from"))
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("invalid from")
+	}
+	to, err := parseTimeParam(c.QueryParam("to"))
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("invalid to")
+	}
+	if from.IsZero() {
+		from = domain.StartOfDay(time.Now())
+	}
+	if to.IsZero() {
+		to = from.AddDate(0, 0, 1)
+	}
+	return from, to, nil
+}
+
+// toUnlockPassResponse Domain 이용권을 DTO로 변환
+func toUnlockPassResponse(pass domain.UnlockPass, now time.Time) UnlockPassResponse {
+	response := UnlockPassResponse{
+		ID:        pass.ID,
+		ClientID:  pass.ClientID,
+		Field:     pass.Field,
+		Rule:      pass.RuleKey,
+		GrantedBy: pass.GrantedBy,
+		Reason:    pass.Reason,
+		IssuedAt:  pass.IssuedAt,
+		ExpiresAt: pass.ExpiresAt,
+		Active:    pass.Active(now),
+	}
+	if !pass.RevokedAt.IsZero() {
+		revokedAt := pass.RevokedAt
+		response.RevokedAt = &revokedAt
+	}
+	return response
+}
+
+// RegisterRoutes Echo 라우터에 핸들러 등록
+func (h *UnlockPassHandler) RegisterRoutes(e *echo.Echo) {
+	api := e.Group("/api/v1")
+	api.POST("/clients/:id/unlock-passes", h.HandleGrantPass, h.adminAuth.Require)
+	api.GET("/clients/:id/unlock-passes", h.HandleListPasses)
+	api.GET("/clients/:id/unlock-passes/audit", h.HandleListAudit)
+	api.DELETE("/clients/:id/unlock-passes/:pass_id", h.HandleRevokePass, h.adminAuth.Require)
+}
@@ -0,0 +1,100 @@
+package bolt
+
+import (
+	"encoding/json"
+	"time"
+
+	bbolt "go.etcd.io/bbolt"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// achievementBucket 업적 진행도 버킷 (key: clientID, value: JSON)
+var achievementBucket = []byte("achievements")
+
+// AchievementStore bbolt 기반 업적 진행도 저장소
+// AchievementStorePort 구현
+type AchievementStore struct {
+	db *bbolt.DB
+}
+
+// achievementRecord 저장 형식
+type achievementRecord struct {
+	Rules      map[string]achievementRuleRecord `json:"rules"`
+	UnlockedAt map[string]time.Time             `json:"unlocked_at"`
+}
+
+// achievementRuleRecord 규칙별 진행 상태 저장 형식
+type achievementRuleRecord struct {
+	AccumulatedSeconds float64 `json:"accumulated_seconds,omitempty"`
+	Count              int     `json:"count,omitempty"`
+	Day                string  `json:"day,omitempty"`
+	StreakDay          string  `json:"streak_day,omitempty"`
+}
+
+// NewAchievementStore AchievementStore 생성자 (버킷이 없으면 생성)
+func NewAchievementStore(db *bbolt.DB) (*AchievementStore, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(achievementBucket)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &AchievementStore{db: db}, nil
+}
+
+// LoadAchievements 업적 진행도 조회
+func (s *AchievementStore) LoadAchievements(clientID string) (*domain.AchievementProgress, bool, error) {
+	var record *achievementRecord
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket(achievementBucket).Get([]byte(clientID))
+		if data == nil {
+			return nil
+		}
+		record = &achievementRecord{}
+		return json.Unmarshal(data, record)
+	})
+	if err != nil || record == nil {
+		return nil, false, err
+	}
+
+	progress := domain.NewAchievementProgress(clientID)
+	for id, rule := range record.Rules {
+		progress.Rules[id] = &domain.AchievementRuleProgress{
+			Accumulated: time.Duration(rule.AccumulatedSeconds * float64(time.Second)),
+			Count:       rule.Count,
+			Day:         rule.Day,
+			StreakDay:   rule.StreakDay,
+		}
+	}
+	for id, at := range record.UnlockedAt {
+		progress.UnlockedAt[id] = at
+	}
+	return progress, true, nil
+}
+
+// SaveAchievements 업적 진행도 저장
+func (s *AchievementStore) SaveAchievements(progress *domain.AchievementProgress) error {
+	record := achievementRecord{
+		Rules:      make(map[string]achievementRuleRecord, len(progress.Rules)),
+		UnlockedAt: progress.UnlockedAt,
+	}
+	for id, rule := range progress.Rules {
+		record.Rules[id] = achievementRuleRecord{
+			AccumulatedSeconds: rule.Accumulated.Seconds(),
+			Count:              rule.Count,
+			Day:                rule.Day,
+			StreakDay:          rule.StreakDay,
+		}
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(achievementBucket).Put([]byte(progress.ClientID), data)
+	})
+}
@@ -0,0 +1,147 @@
+package bolt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"sort"
+	"time"
+
+	bbolt "go.etcd.io/bbolt"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// DefaultUsageRetentionDays 일별 사용 시간 보관 일수 (지난주 조회가 가능하도록 5주)
+const DefaultUsageRetentionDays = 35
+
+// activityUsageBucket 일별 도메인/앱 사용 시간 버킷
+// (key: clientID + 0x00 + 그날 00:00의 Unix 초(big-endian 8바이트), value: JSON)
+var activityUsageBucket = []byte("activity_usage")
+
+// ActivityUsageStore bbolt 기반 일별 사용 시간 저장소
+// ActivityUsagePort 구현
+type ActivityUsageStore struct {
+	db            *bbolt.DB
+	retentionDays int
+}
+
+// activityUsageRecord 하루 사용 시간 저장 형식
+type activityUsageRecord struct {
+	Targets []usageTargetRecord `json:"targets"`
+}
+
+// usageTargetRecord 대상별 누적
+type usageTargetRecord struct {
+	Kind    domain.UsageKind `json:"kind"`
+	Name    string           `json:"name"`
+	Seconds float64          `json:"seconds"`
+}
+
+// NewActivityUsageStore ActivityUsageStore 생성자 (버킷이 없으면 생성)
+func NewActivityUsageStore(db *bbolt.DB, retentionDays int) (*ActivityUsageStore, error) {
+	if retentionDays <= 0 {
+		retentionDays = DefaultUsageRetentionDays
+	}
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(activityUsageBucket)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ActivityUsageStore{db: db, retentionDays: retentionDays}, nil
+}
+
+// AddUsage 날짜별 누적 (읽기-수정-쓰기를 한 트랜잭션에서 처리, 새 날짜가 생길 때 보관 기간이 지난 날짜 정리)
+func (s *ActivityUsageStore) AddUsage(clientID string, target domain.UsageTarget, day time.Time, duration time.Duration) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(activityUsageBucket)
+		key := clientUsageKey(clientID, day.Unix())
+
+		var record activityUsageRecord
+		if data := bucket.Get(key); data != nil {
+			if err := json.Unmarshal(data, &record); err != nil {
+				return err
+			}
+		} else if err := pruneUsage(bucket, clientID, day.AddDate(0, 0, -s.retentionDays).Unix()); err != nil {
+			return err
+		}
+
+		added := false
+		for i := range record.Targets {
+			if record.Targets[i].Kind == target.Kind && record.Targets[i].Name == target.Name {
+				record.Targets[i].Seconds += duration.Seconds()
+				added = true
+				break
+			}
+		}
+		if !added {
+			record.Targets = append(record.Targets, usageTargetRecord{Kind: target.Kind, Name: target.Name, Seconds: duration.Seconds()})
+		}
+
+		data, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		return bucket.Put(key, data)
+	})
+}
+
+// QueryUsage 기간 내 대상별 합산 (사용 시간 내림차순, 같으면 이름순)
+func (s *ActivityUsageStore) QueryUsage(clientID string, from, to time.Time) ([]domain.UsageStat, error) {
+	totals := make(map[domain.UsageTarget]float64)
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(activityUsageBucket).Cursor()
+		end := clientUsageKey(clientID, to.Unix())
+		for key, data := cursor.Seek(clientUsageKey(clientID, from.Unix())); key != nil && bytes.Compare(key, end) < 0; key, data = cursor.Next() {
+			var record activityUsageRecord
+			if err := json.Unmarshal(data, &record); err != nil {
+				return err
+			}
+			for _, target := range record.Targets {
+				totals[domain.UsageTarget{Kind: target.Kind, Name: target.Name}] += target.Seconds
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]domain.UsageStat, 0, len(totals))
+	for target, seconds := range totals {
+		stats = append(stats, domain.UsageStat{Target: target, Seconds: int64(seconds)})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Seconds != stats[j].Seconds {
+			return stats[i].Seconds > stats[j].Seconds
+		}
+		return stats[i].Target.Name < stats[j].Target.Name
+	})
+	return stats, nil
+}
+
+// pruneUsage 클라이언트의 cutoff 이전 날짜 삭제 (호출자의 쓰기 트랜잭션 안에서 실행)
+func pruneUsage(bucket *bbolt.Bucket, clientID string, cutoff int64) error {
+	var expired [][]byte
+	cursor := bucket.Cursor()
+	end := clientUsageKey(clientID, cutoff)
+	for key, _ := cursor.Seek(clientUsageKey(clientID, 0)); key != nil && bytes.Compare(key, end) < 0; key, _ = cursor.Next() {
+		expired = append(expired, append([]byte(nil), key...))
+	}
+	for _, key := range expired {
+		if err := bucket.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clientUsageKey 클라이언트별 하루 사용 시간 키 (클라이언트별로 날짜순 정렬되도록 Unix 초를 big-endian으로)
+func clientUsageKey(clientID string, day int64) []byte {
+	key := make([]byte, len(clientID)+1+8)
+	copy(key, clientID)
+	binary.BigEndian.PutUint64(key[len(clientID)+1:], uint64(day))
+	return key
+}
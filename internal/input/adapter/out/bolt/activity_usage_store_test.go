@@ -0,0 +1,73 @@
+package bolt
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+func TestActivityUsageStore_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.db")
+	db, err := OpenDB(path)
+	if err != nil {
+		t.Fatalf("OpenDB failed: %v", err)
+	}
+	store, err := NewActivityUsageStore(db, 7)
+	if err != nil {
+		t.Fatalf("NewActivityUsageStore failed: %v", err)
+	}
+
+	youtube := domain.UsageTarget{Kind: domain.UsageDomain, Name: "youtube.com"}
+	steam := domain.UsageTarget{Kind: domain.UsageApp, Name: "steam.exe"}
+	monday := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	add := func(clientID string, target domain.UsageTarget, day time.Time, duration time.Duration) {
+		t.Helper()
+		if err := store.AddUsage(clientID, target, day, duration); err != nil {
+			t.Fatalf("AddUsage failed: %v", err)
+		}
+	}
+	add("client-1", youtube, monday, 10*time.Minute)
+	add("client-1", youtube, monday, 5*time.Minute)
+	add("client-1", steam, monday.AddDate(0, 0, 1), 20*time.Minute)
+	add("client-2", youtube, monday, time.Hour)
+
+	// 재시작 후에도 남아 있음
+	if err := db.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	db, err = OpenDB(path)
+	if err != nil {
+		t.Fatalf("reopen failed: %v", err)
+	}
+	defer db.Close()
+	store, err = NewActivityUsageStore(db, 7)
+	if err != nil {
+		t.Fatalf("NewActivityUsageStore failed: %v", err)
+	}
+
+	stats, err := store.QueryUsage("client-1", monday, monday.AddDate(0, 0, 7))
+	if err != nil {
+		t.Fatalf("QueryUsage failed: %v", err)
+	}
+	if len(stats) != 2 || stats[0].Target != steam || stats[0].Seconds != 1200 || stats[1].Target != youtube || stats[1].Seconds != 900 {
+		t.Errorf("Unexpected weekly usage: %+v", stats)
+	}
+
+	// 기간 [from, to) 밖의 날짜와 다른 클라이언트는 제외
+	stats, _ = store.QueryUsage("client-1", monday, monday.AddDate(0, 0, 1))
+	if len(stats) != 1 || stats[0].Target != youtube {
+		t.Errorf("Expected only Monday usage, got %+v", stats)
+	}
+
+	// 보관 기간(7일)이 지난 날짜는 새 날짜가 생길 때 정리
+	add("client-1", youtube, monday.AddDate(0, 0, 8), time.Minute)
+	stats, _ = store.QueryUsage("client-1", monday, monday.AddDate(0, 0, 2))
+	if len(stats) != 1 || stats[0].Target != steam {
+		t.Errorf("Expected expired Monday to be pruned, got %+v", stats)
+	}
+	if stats, _ := store.QueryUsage("client-2", monday, monday.AddDate(0, 0, 1)); len(stats) != 1 {
+		t.Errorf("Expected other clients to keep their usage, got %+v", stats)
+	}
+}
@@ -0,0 +1,280 @@
+package bolt
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	bbolt "go.etcd.io/bbolt"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// blacklistBucket 차단 정책 버킷 (key: PolicyTarget.String(), value: JSON)
+var blacklistBucket = []byte("blacklist_policies")
+
+// blacklistMetaBucket 차단 정책 저장소 상태 버킷 (key: initialized, 정책을 한 번이라도 저장하면 기록)
+var blacklistMetaBucket = []byte("blacklist_meta")
+
+// blacklistInitializedKey 초기화 표시 키
+var blacklistInitializedKey = []byte("initialized")
+
+// BlacklistStore bbolt 기반 차단 정책 저장소
+// BlacklistStorePort 구현
+type BlacklistStore struct {
+	db *bbolt.DB
+}
+
+// blacklistPolicyRecord 저장 형식
+type blacklistPolicyRecord struct {
+	URLRules     []urlRuleRecord     `json:"url_rules,omitempty"`
+	PatternRules []patternRuleRecord `json:"pattern_rules,omitempty"`
+	Lockdown     *lockdownRecord     `json:"lockdown,omitempty"`
+}
+
+// lockdownRecord 시험 모드 저장 형식
+type lockdownRecord struct {
+	AllowedURLs []string           `json:"allowed_urls,omitempty"`
+	AllowedApps []allowedAppRecord `json:"allowed_apps,omitempty"`
+	Meta        ruleMetaRecord     `json:"meta"`
+}
+
+// allowedAppRecord 시험 모드 허용 앱 패턴 저장 형식
+type allowedAppRecord struct {
+	Kind    string `json:"kind"`
+	Pattern string `json:"pattern"`
+}
+
+// ruleScheduleRecord 규칙 일정 저장 형식 (TimeWindow.String 목록)
+type ruleScheduleRecord struct {
+	Windows           []string `json:"windows,omitempty"`
+	StudySessionsOnly bool     `json:"study_sessions_only,omitempty"`
+}
+
+// ruleMetaRecord 규칙 관리 정보 저장 형식
+type ruleMetaRecord struct {
+	CreatedBy string    `json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	Reason    string    `json:"reason,omitempty"`
+	Source    string    `json:"source,omitempty"`
+}
+
+// urlRuleRecord URL 규칙 저장 형식
+type urlRuleRecord struct {
+	Pattern  string              `json:"pattern"`
+	Allow    bool                `json:"allow,omitempty"`
+	Schedule *ruleScheduleRecord `json:"schedule,omitempty"`
+	Meta     ruleMetaRecord      `json:"meta"`
+}
+
+// patternRuleRecord 앱 이름/창 제목 규칙 저장 형식
+type patternRuleRecord struct {
+	Field     string              `json:"field"`
+	Kind      string              `json:"kind"`
+	Pattern   string              `json:"pattern"`
+	Action    string              `json:"action,omitempty"`
+	Intensity int                 `json:"intensity,omitempty"`
+	Message   string              `json:"message,omitempty"`
+	Allow     bool                `json:"allow,omitempty"`
+	Schedule  *ruleScheduleRecord `json:"schedule,omitempty"`
+	Meta      ruleMetaRecord      `json:"meta"`
+}
+
+// NewBlacklistStore BlacklistStore 생성자 (버킷이 없으면 생성)
+func NewBlacklistStore(db *bbolt.DB) (*BlacklistStore, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		for _, name := range [][]byte{blacklistBucket, blacklistMetaBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &BlacklistStore{db: db}, nil
+}
+
+// LoadPolicies 저장된 모든 정책 (해석할 수 없는 대상/규칙은 건너뜀)
+// 초기화 표시가 없던 이전 저장소는 정책이 남아 있으면 초기화된 것으로 간주
+func (s *BlacklistStore) LoadPolicies() ([]domain.ScopedPolicy, bool, error) {
+	var policies []domain.ScopedPolicy
+	initialized := false
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(blacklistBucket)
+		first, _ := bucket.Cursor().First()
+		initialized = tx.Bucket(blacklistMetaBucket).Get(blacklistInitializedKey) != nil || first != nil
+		return bucket.ForEach(func(key, data []byte) error {
+			target, err := domain.ParsePolicyTarget(string(key))
+			if err != nil {
+				log.Printf("[BLACKLIST_STORE] Skipping policy %q: %v", key, err)
+				return nil
+			}
+			var record blacklistPolicyRecord
+			if err := json.Unmarshal(data, &record); err != nil {
+				return err
+			}
+			policies = append(policies, domain.ScopedPolicy{Target: target, Policy: record.toDomain(target)})
+			return nil
+		})
+	})
+	return policies, initialized, err
+}
+
+// SavePolicy 대상 정책 저장 (빈 정책이면 삭제)
+// 같은 트랜잭션에서 초기화 표시를 남겨 규칙을 모두 지워도 다음 실행에서 기본값을 다시 넣지 않음
+func (s *BlacklistStore) SavePolicy(target domain.PolicyTarget, policy *domain.BlacklistPolicy) error {
+	key := []byte(target.String())
+	var data []byte
+	if policy != nil && !policy.IsEmpty() {
+		var err error
+		if data, err = json.Marshal(newBlacklistPolicyRecord(policy)); err != nil {
+			return err
+		}
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		if err := tx.Bucket(blacklistMetaBucket).Put(blacklistInitializedKey, []byte{1}); err != nil {
+			return err
+		}
+		if data == nil {
+			return tx.Bucket(blacklistBucket).Delete(key)
+		}
+		return tx.Bucket(blacklistBucket).Put(key, data)
+	})
+}
+
+// newBlacklistPolicyRecord Domain 정책을 저장 형식으로 변환
+func newBlacklistPolicyRecord(policy *domain.BlacklistPolicy) blacklistPolicyRecord {
+	var record blacklistPolicyRecord
+	for _, rule := range policy.URLRules {
+		record.URLRules = append(record.URLRules, urlRuleRecord{
+			Pattern:  rule.String(),
+			Allow:    rule.Allow,
+			Schedule: newRuleScheduleRecord(rule.Schedule),
+			Meta:     newRuleMetaRecord(rule.Meta),
+		})
+	}
+	for _, rule := range policy.PatternRules {
+		record.PatternRules = append(record.PatternRules, patternRuleRecord{
+			Field:     string(rule.Field),
+			Kind:      string(rule.Kind),
+			Pattern:   rule.Pattern,
+			Action:    string(rule.Action),
+			Intensity: rule.Intensity,
+			Message:   rule.Message,
+			Allow:     rule.Allow,
+			Schedule:  newRuleScheduleRecord(rule.Schedule),
+			Meta:      newRuleMetaRecord(rule.Meta),
+		})
+	}
+	if lockdown := policy.Lockdown; lockdown != nil {
+		record.Lockdown = &lockdownRecord{Meta: newRuleMetaRecord(lockdown.Meta)}
+		for _, rule := range lockdown.AllowedURLs {
+			record.Lockdown.AllowedURLs = append(record.Lockdown.AllowedURLs, rule.String())
+		}
+		for _, rule := range lockdown.AllowedApps {
+			record.Lockdown.AllowedApps = append(record.Lockdown.AllowedApps, allowedAppRecord{Kind: string(rule.Kind), Pattern: rule.Pattern})
+		}
+	}
+	return record
+}
+
+// toDomain 저장 형식을 Domain 정책으로 변환 (해석할 수 없는 규칙은 건너뜀)
+func (r blacklistPolicyRecord) toDomain(target domain.PolicyTarget) *domain.BlacklistPolicy {
+	policy := &domain.BlacklistPolicy{}
+	for _, record := range r.URLRules {
+		rule, err := domain.ParseURLRule(record.Pattern)
+		if err == nil {
+			rule.Schedule, err = record.Schedule.toDomain()
+		}
+		if err != nil {
+			log.Printf("[BLACKLIST_STORE] Skipping url rule %q of %s: %v", record.Pattern, target, err)
+			continue
+		}
+		rule.Allow = record.Allow
+		rule.Meta = record.Meta.toDomain()
+		policy.AddURLRule(rule)
+	}
+	for _, record := range r.PatternRules {
+		field, kind := domain.PatternField(record.Field), domain.PatternKind(record.Kind)
+		var rule domain.PatternRule
+		var err error
+		if record.Allow {
+			rule, err = domain.NewAllowPatternRule(field, kind, record.Pattern)
+		} else {
+			rule, err = domain.NewPatternRule(field, kind, record.Pattern, domain.ActionType(record.Action), record.Intensity)
+		}
+		if err == nil {
+			rule.Schedule, err = record.Schedule.toDomain()
+		}
+		if err != nil {
+			log.Printf("[BLACKLIST_STORE] Skipping %s rule %q of %s: %v", record.Field, record.Pattern, target, err)
+			continue
+		}
+		rule.Message = record.Message
+		rule.Meta = record.Meta.toDomain()
+		policy.AddPatternRule(rule)
+	}
+	if r.Lockdown != nil {
+		policy.Lockdown = r.Lockdown.toDomain(target)
+	}
+	return policy
+}
+
+// toDomain 시험 모드 복원 (해석할 수 없는 항목은 건너뛰어 더 엄격해지는 쪽으로)
+func (r *lockdownRecord) toDomain(target domain.PolicyTarget) *domain.Lockdown {
+	var urls []domain.URLRule
+	for _, pattern := range r.AllowedURLs {
+		rule, err := domain.ParseURLRule(pattern)
+		if err != nil {
+			log.Printf("[BLACKLIST_STORE] Skipping lockdown url %q of %s: %v", pattern, target, err)
+			continue
+		}
+		urls = append(urls, rule)
+	}
+	var apps []domain.PatternRule
+	for _, record := range r.AllowedApps {
+		rule, err := domain.NewAllowPatternRule(domain.PatternFieldApp, domain.PatternKind(record.Kind), record.Pattern)
+		if err != nil {
+			log.Printf("[BLACKLIST_STORE] Skipping lockdown app %q of %s: %v", record.Pattern, target, err)
+			continue
+		}
+		apps = append(apps, rule)
+	}
+
+	lockdown, _ := domain.NewLockdown(urls, apps)
+	lockdown.Meta = r.Meta.toDomain()
+	return &lockdown
+}
+
+// newRuleScheduleRecord 일정 변환 (nil이면 nil)
+func newRuleScheduleRecord(schedule *domain.RuleSchedule) *ruleScheduleRecord {
+	if schedule == nil {
+		return nil
+	}
+	record := &ruleScheduleRecord{StudySessionsOnly: schedule.StudySessionsOnly}
+	for _, window := range schedule.Windows {
+		record.Windows = append(record.Windows, window.String())
+	}
+	return record
+}
+
+// toDomain 일정 복원 (nil이면 항상)
+func (r *ruleScheduleRecord) toDomain() (*domain.RuleSchedule, error) {
+	if r == nil {
+		return nil, nil
+	}
+	return domain.NewRuleSchedule(r.Windows, r.StudySessionsOnly)
+}
+
+// newRuleMetaRecord 관리 정보 변환
+func newRuleMetaRecord(meta domain.RuleMetadata) ruleMetaRecord {
+	return ruleMetaRecord{CreatedBy: meta.CreatedBy, CreatedAt: meta.CreatedAt, Reason: meta.Reason, Source: meta.Source}
+}
+
+// toDomain 관리 정보 복원
+func (r ruleMetaRecord) toDomain() domain.RuleMetadata {
+	return domain.RuleMetadata{CreatedBy: r.CreatedBy, CreatedAt: r.CreatedAt, Reason: r.Reason, Source: r.Source}
+}
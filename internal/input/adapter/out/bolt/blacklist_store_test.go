@@ -0,0 +1,152 @@
+package bolt
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+func TestBlacklistStore_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.db")
+	db, err := OpenDB(path)
+	if err != nil {
+		t.Fatalf("OpenDB failed: %v", err)
+	}
+	store, err := NewBlacklistStore(db)
+	if err != nil {
+		t.Fatalf("NewBlacklistStore failed: %v", err)
+	}
+
+	// 빈 저장소는 첫 실행
+	if policies, initialized, err := store.LoadPolicies(); err != nil || initialized || len(policies) != 0 {
+		t.Fatalf("Expected uninitialized empty store, got %d policies, initialized=%v, err=%v", len(policies), initialized, err)
+	}
+
+	createdAt := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+	meta := domain.RuleMetadata{CreatedBy: "teacher-kim", CreatedAt: createdAt, Reason: "수업 중 쇼츠 금지", Source: "admin"}
+
+	shorts, _ := domain.ParseURLRule("youtube.com/shorts")
+	shorts.Schedule, _ = domain.NewRuleSchedule([]string{"mon-fri 09:00-18:00"}, true)
+	shorts.Meta = meta
+	docs, _ := domain.ParseURLRule("docs.google.com")
+	docs.Allow = true
+	discord, _ := domain.NewPatternRule(domain.PatternFieldApp, domain.PatternGlob, "discord*", domain.ActionMinimizeAll, 4)
+	discord.Message = "수업 중에는 Discord 금지"
+	discord.Schedule, _ = domain.NewRuleSchedule([]string{"weekdays 22:00-02:00"}, false)
+	discord.Meta = meta
+	allowCode, _ := domain.NewAllowPatternRule(domain.PatternFieldWindowTitle, domain.PatternRegex, `(?i)visual studio code`)
+
+	group := &domain.BlacklistPolicy{}
+	group.AddURLRule(shorts)
+	group.AddURLRule(docs)
+	group.AddPatternRule(discord)
+	group.AddPatternRule(allowCode)
+
+	examURL, _ := domain.ParseURLRule("exam.school.kr")
+	examApp, _ := domain.NewAllowPatternRule(domain.PatternFieldApp, domain.PatternGlob, "safe-exam*")
+	lockdown, err := domain.NewLockdown([]domain.URLRule{examURL}, []domain.PatternRule{examApp})
+	if err != nil {
+		t.Fatalf("NewLockdown failed: %v", err)
+	}
+	lockdown.Meta = meta
+	client := &domain.BlacklistPolicy{Lockdown: &lockdown}
+
+	target := domain.GroupPolicy("class-3a")
+	if err := store.SavePolicy(target, group); err != nil {
+		t.Fatalf("SavePolicy failed: %v", err)
+	}
+	if err := store.SavePolicy(domain.ClientPolicy("pc-01"), client); err != nil {
+		t.Fatalf("SavePolicy failed: %v", err)
+	}
+
+	// 재시작 후에도 일정, 허용 규칙, 시험 모드, 관리 정보 유지
+	if err := db.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	db, err = OpenDB(path)
+	if err != nil {
+		t.Fatalf("reopen failed: %v", err)
+	}
+	defer db.Close()
+	store, err = NewBlacklistStore(db)
+	if err != nil {
+		t.Fatalf("NewBlacklistStore failed: %v", err)
+	}
+
+	policies, initialized, err := store.LoadPolicies()
+	if err != nil || !initialized || len(policies) != 2 {
+		t.Fatalf("Expected 2 initialized policies, got %d, initialized=%v, err=%v", len(policies), initialized, err)
+	}
+	loaded := make(map[domain.PolicyTarget]*domain.BlacklistPolicy)
+	for _, scoped := range policies {
+		loaded[scoped.Target] = scoped.Policy
+	}
+
+	restored := loaded[target]
+	if restored == nil || len(restored.URLRules) != 2 || len(restored.PatternRules) != 2 {
+		t.Fatalf("Unexpected group policy: %+v", restored)
+	}
+	for _, rule := range restored.URLRules {
+		switch rule.String() {
+		case shorts.String():
+			if rule.Allow || rule.Schedule == nil || !rule.Schedule.StudySessionsOnly || len(rule.Schedule.Windows) != 1 ||
+				rule.Schedule.Windows[0].String() != shorts.Schedule.Windows[0].String() {
+				t.Errorf("Expected shorts schedule restored, got %+v", rule.Schedule)
+			}
+			if rule.Meta != meta {
+				t.Errorf("Expected shorts metadata restored, got %+v", rule.Meta)
+			}
+		case docs.String():
+			if !rule.Allow || rule.Schedule != nil {
+				t.Errorf("Expected docs allow rule without schedule, got %+v", rule)
+			}
+		default:
+			t.Errorf("Unexpected url rule %s", rule.String())
+		}
+	}
+	for _, rule := range restored.PatternRules {
+		switch rule.Pattern {
+		case discord.Pattern:
+			if rule.Allow || rule.Field != domain.PatternFieldApp || rule.Kind != domain.PatternGlob ||
+				rule.Action != domain.ActionMinimizeAll || rule.Intensity != 4 || rule.Message != discord.Message {
+				t.Errorf("Expected discord rule restored, got %+v", rule)
+			}
+			if rule.Schedule == nil || rule.Schedule.StudySessionsOnly || len(rule.Schedule.Windows) != 1 {
+				t.Errorf("Expected discord schedule restored, got %+v", rule.Schedule)
+			}
+			if rule.Meta != meta {
+				t.Errorf("Expected discord metadata restored, got %+v", rule.Meta)
+			}
+		case allowCode.Pattern:
+			if !rule.Allow || rule.Field != domain.PatternFieldWindowTitle || rule.Kind != domain.PatternRegex {
+				t.Errorf("Expected window title allow rule restored, got %+v", rule)
+			}
+		default:
+			t.Errorf("Unexpected pattern rule %q", rule.Pattern)
+		}
+	}
+
+	exam := loaded[domain.ClientPolicy("pc-01")]
+	if exam == nil || exam.Lockdown == nil {
+		t.Fatalf("Expected lockdown restored, got %+v", exam)
+	}
+	if len(exam.Lockdown.AllowedURLs) != 1 || exam.Lockdown.AllowedURLs[0].String() != examURL.String() ||
+		len(exam.Lockdown.AllowedApps) != 1 || exam.Lockdown.AllowedApps[0].Pattern != examApp.Pattern {
+		t.Errorf("Unexpected lockdown allow list: %+v", exam.Lockdown)
+	}
+	if exam.Lockdown.Meta != meta {
+		t.Errorf("Expected lockdown metadata restored, got %+v", exam.Lockdown.Meta)
+	}
+
+	// 모든 정책을 지워도 초기화 표시는 남음 (기본값 재적용 방지)
+	for _, scoped := range policies {
+		if err := store.SavePolicy(scoped.Target, &domain.BlacklistPolicy{}); err != nil {
+			t.Fatalf("SavePolicy failed: %v", err)
+		}
+	}
+	if policies, initialized, err := store.LoadPolicies(); err != nil || !initialized || len(policies) != 0 {
+		t.Errorf("Expected initialized empty store, got %d policies, initialized=%v, err=%v", len(policies), initialized, err)
+	}
+}
@@ -0,0 +1,21 @@
+package bolt
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	bbolt "go.etcd.io/bbolt"
+)
+
+// OpenDB 임베디드 bbolt 파일 열기 (없으면 디렉터리와 함께 생성)
+// 같은 파일은 한 프로세스에서 한 번만 열 수 있으므로 어댑터들이 하나의 DB를 공유
+func OpenDB(path string) (*bbolt.DB, error) {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+	// 다른 프로세스가 잠그고 있으면 무한 대기하지 않고 실패
+	return bbolt.Open(path, 0o600, &bbolt.Options{Timeout: time.Second})
+}
@@ -0,0 +1,116 @@
+package bolt
+
+import (
+	"bytes"
+	"encoding/json"
+
+	bbolt "go.etcd.io/bbolt"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// eventLogBucket 하루 활동 이벤트 집계 버킷 (key: clientID + 0x00 + YYYY-MM-DD, value: JSON)
+var eventLogBucket = []byte("event_logs")
+
+// reportWeekBucket 마지막 주간 리포트 전달 주 버킷 (key: clientID, value: 월요일 YYYY-MM-DD)
+var reportWeekBucket = []byte("report_weeks")
+
+// EventLogStore bbolt 기반 하루 활동 이벤트 집계 저장소
+// EventLogPort 구현
+type EventLogStore struct {
+	db *bbolt.DB
+}
+
+// eventLogRecord 저장 형식
+type eventLogRecord struct {
+	Counts  map[domain.ProgressEventType]int `json:"counts"`
+	Targets map[string]int                   `json:"targets,omitempty"`
+}
+
+// NewEventLogStore EventLogStore 생성자 (버킷이 없으면 생성)
+func NewEventLogStore(db *bbolt.DB) (*EventLogStore, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		for _, name := range [][]byte{eventLogBucket, reportWeekBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &EventLogStore{db: db}, nil
+}
+
+// AddEvent 이벤트 한 건을 하루 집계에 누적 (읽기-수정-쓰기를 한 트랜잭션에서 처리)
+func (s *EventLogStore) AddEvent(event domain.ProgressEvent, day string) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(eventLogBucket)
+		key := clientDayKey(event.ClientID, day)
+
+		eventLog := domain.NewDailyEventLog(event.ClientID, day)
+		if data := bucket.Get(key); data != nil {
+			var record eventLogRecord
+			if err := json.Unmarshal(data, &record); err != nil {
+				return err
+			}
+			eventLog = toDailyEventLog(event.ClientID, day, record)
+		}
+		eventLog.Add(event)
+
+		data, err := json.Marshal(eventLogRecord{Counts: eventLog.Counts, Targets: eventLog.Targets})
+		if err != nil {
+			return err
+		}
+		return bucket.Put(key, data)
+	})
+}
+
+// QueryEventLogs 기간 [fromDay, toDay] 의 하루 집계 목록 (날짜 오름차순)
+func (s *EventLogStore) QueryEventLogs(clientID, fromDay, toDay string) ([]domain.DailyEventLog, error) {
+	var result []domain.DailyEventLog
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(eventLogBucket).Cursor()
+		end := clientDayKey(clientID, toDay)
+		for key, data := cursor.Seek(clientDayKey(clientID, fromDay)); key != nil && bytes.Compare(key, end) <= 0; key, data = cursor.Next() {
+			var record eventLogRecord
+			if err := json.Unmarshal(data, &record); err != nil {
+				return err
+			}
+			day := string(key[len(clientID)+1:])
+			result = append(result, *toDailyEventLog(clientID, day, record))
+		}
+		return nil
+	})
+	return result, err
+}
+
+// GetReportWeek 마지막으로 주간 리포트를 전달한 주
+func (s *EventLogStore) GetReportWeek(clientID string) (string, error) {
+	var week string
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		week = string(tx.Bucket(reportWeekBucket).Get([]byte(clientID)))
+		return nil
+	})
+	return week, err
+}
+
+// SaveReportWeek 주간 리포트를 전달한 주 기록
+func (s *EventLogStore) SaveReportWeek(clientID, week string) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(reportWeekBucket).Put([]byte(clientID), []byte(week))
+	})
+}
+
+// toDailyEventLog 저장 형식을 도메인으로 변환
+func toDailyEventLog(clientID, day string, record eventLogRecord) *domain.DailyEventLog {
+	eventLog := domain.NewDailyEventLog(clientID, day)
+	for eventType, count := range record.Counts {
+		eventLog.Counts[eventType] = count
+	}
+	for target, count := range record.Targets {
+		eventLog.Targets[target] = count
+	}
+	return eventLog
+}
@@ -0,0 +1,86 @@
+package bolt
+
+import (
+	"encoding/json"
+	"time"
+
+	bbolt "go.etcd.io/bbolt"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// gamificationBucket 게이미피케이션 진행도 버킷 (key: clientID, value: JSON)
+var gamificationBucket = []byte("gamification")
+
+// GamificationStore bbolt 기반 게이미피케이션 진행도 저장소
+// GamificationStorePort 구현
+type GamificationStore struct {
+	db *bbolt.DB
+}
+
+// gamificationRecord 저장 형식 (도메인 구조체와 분리하여 필드 변경에 대비)
+type gamificationRecord struct {
+	TotalXP         int64     `json:"total_xp"`
+	FocusedSeconds  float64   `json:"focused_seconds"`
+	ThinkingSeconds float64   `json:"thinking_seconds"`
+	ScoreSum        int64     `json:"score_sum"`
+	ScoreCount      int64     `json:"score_count"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// NewGamificationStore GamificationStore 생성자 (버킷이 없으면 생성)
+func NewGamificationStore(db *bbolt.DB) (*GamificationStore, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(gamificationBucket)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &GamificationStore{db: db}, nil
+}
+
+// LoadProgress 진행도 조회
+func (s *GamificationStore) LoadProgress(clientID string) (*domain.GamificationProgress, bool, error) {
+	var record *gamificationRecord
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket(gamificationBucket).Get([]byte(clientID))
+		if data == nil {
+			return nil
+		}
+		record = &gamificationRecord{}
+		return json.Unmarshal(data, record)
+	})
+	if err != nil || record == nil {
+		return nil, false, err
+	}
+
+	return &domain.GamificationProgress{
+		ClientID:     clientID,
+		TotalXP:      record.TotalXP,
+		FocusedTime:  time.Duration(record.FocusedSeconds * float64(time.Second)),
+		ThinkingTime: time.Duration(record.ThinkingSeconds * float64(time.Second)),
+		ScoreSum:     record.ScoreSum,
+		ScoreCount:   record.ScoreCount,
+		UpdatedAt:    record.UpdatedAt,
+	}, true, nil
+}
+
+// SaveProgress 진행도 저장
+func (s *GamificationStore) SaveProgress(progress *domain.GamificationProgress) error {
+	data, err := json.Marshal(gamificationRecord{
+		TotalXP:         progress.TotalXP,
+		FocusedSeconds:  progress.FocusedTime.Seconds(),
+		ThinkingSeconds: progress.ThinkingTime.Seconds(),
+		ScoreSum:        progress.ScoreSum,
+		ScoreCount:      progress.ScoreCount,
+		UpdatedAt:       progress.UpdatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(gamificationBucket).Put([]byte(progress.ClientID), data)
+	})
+}
@@ -0,0 +1,186 @@
+package bolt
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	bbolt "go.etcd.io/bbolt"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+var (
+	// memberBucket 스터디 그룹 소속 버킷 (key: clientID, value: JSON)
+	memberBucket = []byte("leaderboard_members")
+	// dailyStatsBucket 하루 집중 시간/점수 버킷 (key: clientID + 0x00 + YYYY-MM-DD, value: JSON)
+	dailyStatsBucket = []byte("daily_stats")
+)
+
+// LeaderboardStore bbolt 기반 리더보드 저장소
+// LeaderboardMemberPort, DailyStatsPort 구현
+type LeaderboardStore struct {
+	db *bbolt.DB
+}
+
+// memberRecord 소속 저장 형식
+type memberRecord struct {
+	GroupID     string    `json:"group_id,omitempty"`
+	DisplayName string    `json:"display_name,omitempty"`
+	OptOut      bool      `json:"opt_out,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// dailyStatsRecord 하루 기록 저장 형식
+type dailyStatsRecord struct {
+	FocusedSeconds float64 `json:"focused_seconds"`
+	ScoreSum       int64   `json:"score_sum"`
+	ScoreCount     int64   `json:"score_count"`
+}
+
+// NewLeaderboardStore LeaderboardStore 생성자 (버킷이 없으면 생성)
+func NewLeaderboardStore(db *bbolt.DB) (*LeaderboardStore, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		for _, bucket := range [][]byte{memberBucket, dailyStatsBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &LeaderboardStore{db: db}, nil
+}
+
+// LoadMember 소속 조회
+func (s *LeaderboardStore) LoadMember(clientID string) (domain.LeaderboardMember, bool, error) {
+	var record *memberRecord
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket(memberBucket).Get([]byte(clientID))
+		if data == nil {
+			return nil
+		}
+		record = &memberRecord{}
+		return json.Unmarshal(data, record)
+	})
+	if err != nil || record == nil {
+		return domain.LeaderboardMember{}, false, err
+	}
+	return toLeaderboardMember(clientID, *record), true, nil
+}
+
+// SaveMember 소속 저장
+func (s *LeaderboardStore) SaveMember(member domain.LeaderboardMember) error {
+	data, err := json.Marshal(memberRecord{
+		GroupID:     member.GroupID,
+		DisplayName: member.DisplayName,
+		OptOut:      member.OptOut,
+		UpdatedAt:   member.UpdatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(memberBucket).Put([]byte(member.ClientID), data)
+	})
+}
+
+// ListGroupMembers 그룹에 속한 클라이언트 목록
+func (s *LeaderboardStore) ListGroupMembers(groupID string) ([]domain.LeaderboardMember, error) {
+	var members []domain.LeaderboardMember
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(memberBucket).ForEach(func(key, data []byte) error {
+			var record memberRecord
+			if err := json.Unmarshal(data, &record); err != nil {
+				return err
+			}
+			if record.GroupID == groupID {
+				members = append(members, toLeaderboardMember(string(key), record))
+			}
+			return nil
+		})
+	})
+	return members, err
+}
+
+// LoadDailyStats 하루 기록 조회
+func (s *LeaderboardStore) LoadDailyStats(clientID, day string) (domain.DailyStudyStats, bool, error) {
+	var record *dailyStatsRecord
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket(dailyStatsBucket).Get(clientDayKey(clientID, day))
+		if data == nil {
+			return nil
+		}
+		record = &dailyStatsRecord{}
+		return json.Unmarshal(data, record)
+	})
+	if err != nil || record == nil {
+		return domain.DailyStudyStats{}, false, err
+	}
+	return toDailyStudyStats(clientID, day, *record), true, nil
+}
+
+// SaveDailyStats 하루 기록 저장
+func (s *LeaderboardStore) SaveDailyStats(stats domain.DailyStudyStats) error {
+	data, err := json.Marshal(dailyStatsRecord{
+		FocusedSeconds: stats.FocusedTime.Seconds(),
+		ScoreSum:       stats.ScoreSum,
+		ScoreCount:     stats.ScoreCount,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(dailyStatsBucket).Put(clientDayKey(stats.ClientID, stats.Day), data)
+	})
+}
+
+// QueryDailyStats 기간 [fromDay, toDay] 의 하루 기록 목록 (날짜 오름차순)
+func (s *LeaderboardStore) QueryDailyStats(clientID, fromDay, toDay string) ([]domain.DailyStudyStats, error) {
+	var result []domain.DailyStudyStats
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(dailyStatsBucket).Cursor()
+		end := clientDayKey(clientID, toDay)
+		for key, data := cursor.Seek(clientDayKey(clientID, fromDay)); key != nil && bytes.Compare(key, end) <= 0; key, data = cursor.Next() {
+			var record dailyStatsRecord
+			if err := json.Unmarshal(data, &record); err != nil {
+				return err
+			}
+			day := string(key[len(clientID)+1:])
+			result = append(result, toDailyStudyStats(clientID, day, record))
+		}
+		return nil
+	})
+	return result, err
+}
+
+// clientDayKey 클라이언트별 하루 기록 키 (클라이언트별로 날짜순 정렬되도록 clientID 뒤에 구분자)
+func clientDayKey(clientID, day string) []byte {
+	return []byte(clientID + "\x00" + day)
+}
+
+// toLeaderboardMember 저장 형식을 도메인으로 변환
+func toLeaderboardMember(clientID string, record memberRecord) domain.LeaderboardMember {
+	return domain.LeaderboardMember{
+		ClientID:    clientID,
+		GroupID:     record.GroupID,
+		DisplayName: record.DisplayName,
+		OptOut:      record.OptOut,
+		UpdatedAt:   record.UpdatedAt,
+	}
+}
+
+// toDailyStudyStats 저장 형식을 도메인으로 변환
+func toDailyStudyStats(clientID, day string, record dailyStatsRecord) domain.DailyStudyStats {
+	return domain.DailyStudyStats{
+		ClientID:    clientID,
+		Day:         day,
+		FocusedTime: time.Duration(record.FocusedSeconds * float64(time.Second)),
+		ScoreSum:    record.ScoreSum,
+		ScoreCount:  record.ScoreCount,
+	}
+}
@@ -0,0 +1,92 @@
+package bolt
+
+import (
+	"encoding/json"
+	"time"
+
+	bbolt "go.etcd.io/bbolt"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// streakBucket 하루 목표/연속 달성 기록 버킷 (key: clientID, value: JSON)
+var streakBucket = []byte("streaks")
+
+// StreakStore bbolt 기반 하루 목표/연속 달성 기록 저장소
+// StreakStorePort 구현
+type StreakStore struct {
+	db *bbolt.DB
+}
+
+// streakRecord 저장 형식
+type streakRecord struct {
+	DailyGoalSeconds float64 `json:"daily_goal_seconds"`
+	Timezone         string  `json:"timezone,omitempty"`
+	Day              string  `json:"day,omitempty"`
+	DayFocusSeconds  float64 `json:"day_focus_seconds,omitempty"`
+	CurrentStreak    int     `json:"current_streak,omitempty"`
+	BestStreak       int     `json:"best_streak,omitempty"`
+	LastMetDay       string  `json:"last_met_day,omitempty"`
+	WarnedDay        string  `json:"warned_day,omitempty"`
+}
+
+// NewStreakStore StreakStore 생성자 (버킷이 없으면 생성)
+func NewStreakStore(db *bbolt.DB) (*StreakStore, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(streakBucket)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &StreakStore{db: db}, nil
+}
+
+// LoadStreak 기록 조회
+func (s *StreakStore) LoadStreak(clientID string) (*domain.StudyStreak, bool, error) {
+	var record *streakRecord
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket(streakBucket).Get([]byte(clientID))
+		if data == nil {
+			return nil
+		}
+		record = &streakRecord{}
+		return json.Unmarshal(data, record)
+	})
+	if err != nil || record == nil {
+		return nil, false, err
+	}
+
+	return &domain.StudyStreak{
+		ClientID:      clientID,
+		DailyGoal:     time.Duration(record.DailyGoalSeconds * float64(time.Second)),
+		Timezone:      record.Timezone,
+		Day:           record.Day,
+		DayFocus:      time.Duration(record.DayFocusSeconds * float64(time.Second)),
+		CurrentStreak: record.CurrentStreak,
+		BestStreak:    record.BestStreak,
+		LastMetDay:    record.LastMetDay,
+		WarnedDay:     record.WarnedDay,
+	}, true, nil
+}
+
+// SaveStreak 기록 저장
+func (s *StreakStore) SaveStreak(streak *domain.StudyStreak) error {
+	data, err := json.Marshal(streakRecord{
+		DailyGoalSeconds: streak.DailyGoal.Seconds(),
+		Timezone:         streak.Timezone,
+		Day:              streak.Day,
+		DayFocusSeconds:  streak.DayFocus.Seconds(),
+		CurrentStreak:    streak.CurrentStreak,
+		BestStreak:       streak.BestStreak,
+		LastMetDay:       streak.LastMetDay,
+		WarnedDay:        streak.WarnedDay,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(streakBucket).Put([]byte(streak.ClientID), data)
+	})
+}
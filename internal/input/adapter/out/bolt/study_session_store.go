@@ -0,0 +1,110 @@
+package bolt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	bbolt "go.etcd.io/bbolt"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// studySessionBucket 선언한 학습 세션 버킷 (key: clientID + "\x00" + 시작 Unix 초(20자리), value: JSON)
+var studySessionBucket = []byte("study_sessions")
+
+// StudySessionStore bbolt 기반 학습 세션 저장소
+// StudySessionPort 구현
+type StudySessionStore struct {
+	db *bbolt.DB
+}
+
+// studySessionRecord 저장 형식
+type studySessionRecord struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	Label string    `json:"label,omitempty"`
+}
+
+// NewStudySessionStore StudySessionStore 생성자 (버킷이 없으면 생성)
+func NewStudySessionStore(db *bbolt.DB) (*StudySessionStore, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(studySessionBucket)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &StudySessionStore{db: db}, nil
+}
+
+// SaveSession 세션 저장
+func (s *StudySessionStore) SaveSession(session domain.StudySession) error {
+	data, err := json.Marshal(studySessionRecord{Start: session.Start, End: session.End, Label: session.Label})
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(studySessionBucket).Put(studySessionKey(session.ClientID, session.Start.Unix()), data)
+	})
+}
+
+// DeleteSession 세션 삭제 (sessionID: 시작 Unix 초)
+func (s *StudySessionStore) DeleteSession(clientID, sessionID string) (bool, error) {
+	start, err := strconv.ParseInt(sessionID, 10, 64)
+	if err != nil {
+		return false, nil
+	}
+
+	deleted := false
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(studySessionBucket)
+		key := studySessionKey(clientID, start)
+		if bucket.Get(key) == nil {
+			return nil
+		}
+		deleted = true
+		return bucket.Delete(key)
+	})
+	return deleted, err
+}
+
+// ListSessions 기간 [from, to)와 겹치는 세션 조회
+// 세션은 최대 MaxStudySessionLength이므로 그만큼 앞에서부터 시작 시각순으로 훑음
+func (s *StudySessionStore) ListSessions(clientID string, from, to time.Time) ([]domain.StudySession, error) {
+	var result []domain.StudySession
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(studySessionBucket).Cursor()
+		end := studySessionKey(clientID, to.Unix())
+		seek := studySessionKey(clientID, from.Add(-domain.MaxStudySessionLength).Unix())
+		for key, data := cursor.Seek(seek); key != nil && bytes.Compare(key, end) <= 0; key, data = cursor.Next() {
+			var record studySessionRecord
+			if err := json.Unmarshal(data, &record); err != nil {
+				return err
+			}
+			if !record.Start.Before(to) || !record.End.After(from) {
+				continue
+			}
+			result = append(result, domain.StudySession{
+				ID:       strconv.FormatInt(record.Start.Unix(), 10),
+				ClientID: clientID,
+				Start:    record.Start,
+				End:      record.End,
+				Label:    record.Label,
+			})
+		}
+		return nil
+	})
+	return result, err
+}
+
+// studySessionKey 클라이언트별 시작 시각순 키 (음수 없는 Unix 초를 20자리로 채워 사전순 = 시간순)
+func studySessionKey(clientID string, start int64) []byte {
+	if start < 0 {
+		start = 0
+	}
+	return []byte(clientID + "\x00" + fmt.Sprintf("%020d", start))
+}
@@ -0,0 +1,203 @@
+package bolt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	bbolt "go.etcd.io/bbolt"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+var (
+	// unlockPassBucket 차단 해제 이용권 버킷 (key: clientID + "\x00" + 발급 Unix 나노초(20자리), value: JSON)
+	unlockPassBucket = []byte("unlock_passes")
+	// unlockAuditBucket 이용권 감사 기록 버킷 (key: clientID + "\x00" + 시각 Unix 나노초(20자리) + "\x00" + 순번, value: JSON)
+	unlockAuditBucket = []byte("unlock_audit")
+)
+
+// UnlockPassStore bbolt 기반 이용권/감사 기록 저장소
+// UnlockPassPort, UnlockAuditPort 구현
+type UnlockPassStore struct {
+	db *bbolt.DB
+}
+
+// unlockPassRecord 이용권 저장 형식
+type unlockPassRecord struct {
+	Field     string    `json:"field"`
+	RuleKey   string    `json:"rule_key"`
+	GrantedBy string    `json:"granted_by,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	RevokedAt time.Time `json:"revoked_at"`
+}
+
+// unlockAuditRecord 감사 기록 저장 형식
+type unlockAuditRecord struct {
+	At      time.Time `json:"at"`
+	Action  string    `json:"action"`
+	PassID  string    `json:"pass_id,omitempty"`
+	RuleKey string    `json:"rule_key,omitempty"`
+	Actor   string    `json:"actor,omitempty"`
+	Target  string    `json:"target,omitempty"`
+	Detail  string    `json:"detail,omitempty"`
+}
+
+// NewUnlockPassStore UnlockPassStore 생성자 (버킷이 없으면 생성)
+func NewUnlockPassStore(db *bbolt.DB) (*UnlockPassStore, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		for _, name := range [][]byte{unlockPassBucket, unlockAuditBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &UnlockPassStore{db: db}, nil
+}
+
+// SavePass 이용권 저장 (같은 ID는 덮어씀)
+func (s *UnlockPassStore) SavePass(pass domain.UnlockPass) error {
+	data, err := json.Marshal(unlockPassRecord{
+		Field:     pass.Field,
+		RuleKey:   pass.RuleKey,
+		GrantedBy: pass.GrantedBy,
+		Reason:    pass.Reason,
+		IssuedAt:  pass.IssuedAt,
+		ExpiresAt: pass.ExpiresAt,
+		RevokedAt: pass.RevokedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(unlockPassBucket).Put(unlockKey(pass.ClientID, pass.IssuedAt.UnixNano()), data)
+	})
+}
+
+// LoadPass 이용권 조회 (passID: 발급 Unix 나노초)
+func (s *UnlockPassStore) LoadPass(clientID, passID string) (domain.UnlockPass, bool, error) {
+	issued, err := strconv.ParseInt(passID, 10, 64)
+	if err != nil {
+		return domain.UnlockPass{}, false, nil
+	}
+
+	var pass domain.UnlockPass
+	exists := false
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket(unlockPassBucket).Get(unlockKey(clientID, issued))
+		if data == nil {
+			return nil
+		}
+		exists = true
+		pass, err = decodeUnlockPass(clientID, data)
+		return err
+	})
+	return pass, exists, err
+}
+
+// ListPasses 발급 시각이 [from, to)인 이용권 조회 (발급 시각순)
+func (s *UnlockPassStore) ListPasses(clientID string, from, to time.Time) ([]domain.UnlockPass, error) {
+	var result []domain.UnlockPass
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(unlockPassBucket).Cursor()
+		end := unlockKey(clientID, to.UnixNano())
+		for key, data := cursor.Seek(unlockKey(clientID, from.UnixNano())); key != nil && bytes.Compare(key, end) < 0; key, data = cursor.Next() {
+			pass, err := decodeUnlockPass(clientID, data)
+			if err != nil {
+				return err
+			}
+			result = append(result, pass)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// AppendAudit 감사 기록 추가 (같은 시각의 기록은 순번으로 구분)
+func (s *UnlockPassStore) AppendAudit(entry domain.UnlockAuditEntry) error {
+	data, err := json.Marshal(unlockAuditRecord{
+		At:      entry.At,
+		Action:  string(entry.Action),
+		PassID:  entry.PassID,
+		RuleKey: entry.RuleKey,
+		Actor:   entry.Actor,
+		Target:  entry.Target,
+		Detail:  entry.Detail,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(unlockAuditBucket)
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		key := append(unlockKey(entry.ClientID, entry.At.UnixNano()), fmt.Sprintf("\x00%020d", seq)...)
+		return bucket.Put(key, data)
+	})
+}
+
+// ListAudit 기간 [from, to)의 감사 기록 조회 (시각순)
+func (s *UnlockPassStore) ListAudit(clientID string, from, to time.Time) ([]domain.UnlockAuditEntry, error) {
+	var result []domain.UnlockAuditEntry
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(unlockAuditBucket).Cursor()
+		end := unlockKey(clientID, to.UnixNano())
+		for key, data := cursor.Seek(unlockKey(clientID, from.UnixNano())); key != nil && bytes.Compare(key, end) < 0; key, data = cursor.Next() {
+			var record unlockAuditRecord
+			if err := json.Unmarshal(data, &record); err != nil {
+				return err
+			}
+			result = append(result, domain.UnlockAuditEntry{
+				At:       record.At,
+				ClientID: clientID,
+				Action:   domain.UnlockAuditAction(record.Action),
+				PassID:   record.PassID,
+				RuleKey:  record.RuleKey,
+				Actor:    record.Actor,
+				Target:   record.Target,
+				Detail:   record.Detail,
+			})
+		}
+		return nil
+	})
+	return result, err
+}
+
+// decodeUnlockPass 저장 형식을 이용권으로
+func decodeUnlockPass(clientID string, data []byte) (domain.UnlockPass, error) {
+	var record unlockPassRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return domain.UnlockPass{}, err
+	}
+	return domain.UnlockPass{
+		ID:        strconv.FormatInt(record.IssuedAt.UnixNano(), 10),
+		ClientID:  clientID,
+		Field:     record.Field,
+		RuleKey:   record.RuleKey,
+		GrantedBy: record.GrantedBy,
+		Reason:    record.Reason,
+		IssuedAt:  record.IssuedAt,
+		ExpiresAt: record.ExpiresAt,
+		RevokedAt: record.RevokedAt,
+	}, nil
+}
+
+// unlockKey 클라이언트별 시각순 키 (음수 없는 Unix 나노초를 20자리로 채워 사전순 = 시간순)
+func unlockKey(clientID string, nanos int64) []byte {
+	if nanos < 0 {
+		nanos = 0
+	}
+	return []byte(clientID + "\x00" + fmt.Sprintf("%020d", nanos))
+}
@@ -1,6 +1,7 @@
 package grpc
 
 import (
+	"encoding/json"
 	"log"
 
 	"jiaa-server-core/internal/input/adapter/in/grpc" // Import for StreamManager
@@ -89,6 +90,79 @@ func (a *ScreenControlAdapter) SendAIResult(clientID string, markdown string) er
 	return nil
 }
 
+// UpdateScoreGauge 점수 게이지 갱신
+// SyncClient 스트림의 UPDATE_SCORE 명령으로 ScoreUpdateRequest(JSON)를 전송
+func (a *ScreenControlAdapter) UpdateScoreGauge(snapshot domain.ScoreSnapshot) error {
+	sm := grpc.GetStreamManager()
+
+	payload, err := json.Marshal(&proto.ScoreUpdateRequest{
+		ClientId:     snapshot.ClientID,
+		CurrentScore: int32(snapshot.Score),
+		State:        snapshot.State,
+		Breakdown:    grpc.ToProtoScoreBreakdown(snapshot.Breakdown),
+	})
+	if err != nil {
+		return err
+	}
+
+	serverCmd := &proto.ServerCommand{
+		Type:    proto.ServerCommand_UPDATE_SCORE,
+		Payload: string(payload),
+	}
+
+	if err := sm.TrySendCommand(snapshot.ClientID, serverCmd); err != nil {
+		log.Printf("[SCREEN_CONTROL] Failed to update score gauge: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// ShowMessage 알림 메시지 표시 (SHOW_MESSAGE)
+func (a *ScreenControlAdapter) ShowMessage(clientID string, message string) error {
+	sm := grpc.GetStreamManager()
+
+	serverCmd := &proto.ServerCommand{
+		Type:    proto.ServerCommand_SHOW_MESSAGE,
+		Payload: message,
+	}
+
+	if err := sm.TrySendCommand(clientID, serverCmd); err != nil {
+		log.Printf("[SCREEN_CONTROL] Failed to show message: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// NotifyAchievement 업적 달성 알림 (ACHIEVEMENT_UNLOCKED, payload: Achievement JSON)
+func (a *ScreenControlAdapter) NotifyAchievement(clientID string, achievement domain.Achievement) error {
+	payload, err := json.Marshal(&proto.Achievement{
+		Id:          achievement.ID,
+		Name:        achievement.Name,
+		Description: achievement.Description,
+		Unlocked:    achievement.Unlocked,
+		UnlockedAt:  achievement.UnlockedAt.Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	sm := grpc.GetStreamManager()
+
+	serverCmd := &proto.ServerCommand{
+		Type:    proto.ServerCommand_ACHIEVEMENT_UNLOCKED,
+		Payload: string(payload),
+	}
+
+	if err := sm.TrySendCommand(clientID, serverCmd); err != nil {
+		log.Printf("[SCREEN_CONTROL] Failed to notify achievement: %v", err)
+		return err
+	}
+
+	return nil
+}
+
 // Close (No-op)
 func (a *ScreenControlAdapter) Close() error {
 	return nil
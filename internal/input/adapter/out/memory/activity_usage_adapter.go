@@ -0,0 +1,87 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// DefaultUsageRetentionDays 일별 사용 시간 보관 일수 (지난주 조회가 가능하도록 5주)
+const DefaultUsageRetentionDays = 35
+
+// ActivityUsageAdapter 인메모리 일별 사용 시간 저장소
+// ActivityUsagePort 구현
+type ActivityUsageAdapter struct {
+	retentionDays int
+	clients       map[string]map[int64]map[domain.UsageTarget]time.Duration // clientID → 날짜(Unix) → 대상 → 누적
+	mu            sync.RWMutex
+}
+
+// NewActivityUsageAdapter ActivityUsageAdapter 생성자
+func NewActivityUsageAdapter(retentionDays int) *ActivityUsageAdapter {
+	if retentionDays <= 0 {
+		retentionDays = DefaultUsageRetentionDays
+	}
+	return &ActivityUsageAdapter{
+		retentionDays: retentionDays,
+		clients:       make(map[string]map[int64]map[domain.UsageTarget]time.Duration),
+	}
+}
+
+// AddUsage 날짜별 누적 (보관 기간이 지난 날짜는 함께 정리)
+func (a *ActivityUsageAdapter) AddUsage(clientID string, target domain.UsageTarget, day time.Time, duration time.Duration) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	days, exists := a.clients[clientID]
+	if !exists {
+		days = make(map[int64]map[domain.UsageTarget]time.Duration)
+		a.clients[clientID] = days
+	}
+
+	key := day.Unix()
+	targets, exists := days[key]
+	if !exists {
+		targets = make(map[domain.UsageTarget]time.Duration)
+		days[key] = targets
+
+		// 새 날짜가 생길 때만 오래된 날짜 정리
+		cutoff := day.AddDate(0, 0, -a.retentionDays).Unix()
+		for d := range days {
+			if d < cutoff {
+				delete(days, d)
+			}
+		}
+	}
+	targets[target] += duration
+	return nil
+}
+
+// QueryUsage 기간 내 대상별 합산 (사용 시간 내림차순, 같으면 이름순)
+func (a *ActivityUsageAdapter) QueryUsage(clientID string, from, to time.Time) ([]domain.UsageStat, error) {
+	a.mu.RLock()
+	totals := make(map[domain.UsageTarget]time.Duration)
+	for day, targets := range a.clients[clientID] {
+		if day < from.Unix() || day >= to.Unix() {
+			continue
+		}
+		for target, duration := range targets {
+			totals[target] += duration
+		}
+	}
+	a.mu.RUnlock()
+
+	stats := make([]domain.UsageStat, 0, len(totals))
+	for target, duration := range totals {
+		stats = append(stats, domain.UsageStat{Target: target, Seconds: int64(duration / time.Second)})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Seconds != stats[j].Seconds {
+			return stats[i].Seconds > stats[j].Seconds
+		}
+		return stats[i].Target.Name < stats[j].Target.Name
+	})
+	return stats, nil
+}
@@ -1,17 +1,30 @@
 package memory
 
+import (
+	"log"
+	"sort"
+	"sync"
+
+	"jiaa-server-core/internal/input/domain"
+	portout "jiaa-server-core/internal/input/port/out"
+)
+
 // BlacklistAdapter 인메모리 블랙리스트 어댑터 (Driven Adapter)
 // 테스트/개발용 - 프로덕션에서는 Redis 등으로 교체
+// 동기화 고루틴의 갱신과 HTTP/gRPC 핸들러의 조회가 동시에 일어나므로 RWMutex로 보호
+// 정책은 global → group → client 순으로 상속되며, 더 구체적인 단계의 규칙(허용 포함)이 우선
 type BlacklistAdapter struct {
-	urlBlacklist map[string]bool
-	appBlacklist map[string]bool
+	policies map[domain.PolicyTarget]*domain.BlacklistPolicy
+	members  portout.LeaderboardMemberPort // 클라이언트의 그룹 조회 (선택, 없으면 그룹 정책 미적용)
+	groups   map[string]string             // 클라이언트 → 그룹 캐시 (UpdateMembership으로 갱신)
+	mu       sync.RWMutex
 }
 
 // NewBlacklistAdapter BlacklistAdapter 생성자
 func NewBlacklistAdapter() *BlacklistAdapter {
 	return &BlacklistAdapter{
-		urlBlacklist: make(map[string]bool),
-		appBlacklist: make(map[string]bool),
+		policies: make(map[domain.PolicyTarget]*domain.BlacklistPolicy),
+		groups:   make(map[string]string),
 	}
 }
 
@@ -31,83 +44,297 @@ func NewBlacklistAdapterWithDefaults() *BlacklistAdapter {
 		"reddit.com",
 	}
 	for _, url := range defaultURLs {
-		adapter.AddURLToBlacklist(url)
+		if err := adapter.AddURLToBlacklist(url); err != nil {
+			log.Printf("[BLACKLIST] Skipping default url rule: %v", err)
+		}
 	}
 
-	// 기본 앱 블랙리스트 (예시)
+	// 기본 앱 블랙리스트 (예시) - steam.exe, Steam Client 등 변형까지 포함하도록 glob
 	defaultApps := []string{
-		"League of Legends",
-		"Steam",
-		"Discord",
-		"Slack",
-		"KakaoTalk",
+		"league of legends*",
+		"steam*",
+		"discord*",
+		"slack*",
+		"kakaotalk*",
 	}
 	for _, app := range defaultApps {
-		adapter.AddAppToBlacklist(app)
+		rule, err := domain.NewPatternRule(domain.PatternFieldApp, domain.PatternGlob, app, domain.ActionCloseApp, 0)
+		if err != nil {
+			log.Printf("[BLACKLIST] Skipping default app rule: %v", err)
+			continue
+		}
+		adapter.AddPatternRule(domain.GlobalPolicy, rule)
 	}
 
 	return adapter
 }
 
-// IsBlacklisted 주어진 URL이 블랙리스트에 있는지 확인
-func (a *BlacklistAdapter) IsBlacklisted(url string) bool {
-	// 정확한 매칭 체크
-	if a.urlBlacklist[url] {
-		return true
+// SetMemberStore 그룹 정책 적용을 위한 스터디 그룹 멤버십 설정
+func (a *BlacklistAdapter) SetMemberStore(members portout.LeaderboardMemberPort) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.members = members
+	a.groups = make(map[string]string)
+}
+
+// UpdateMembership 멤버십 변경 반영 (LeaderboardService.SetMembership 이후 호출)
+func (a *BlacklistAdapter) UpdateMembership(member domain.LeaderboardMember) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.groups[member.ClientID] = member.GroupID
+}
+
+// IsBlacklisted 주어진 URL이 클라이언트 정책상 차단 대상인지 확인
+func (a *BlacklistAdapter) IsBlacklisted(ctx domain.RuleContext, url string) bool {
+	groupID := a.groupOf(ctx.ClientID)
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return domain.ResolveURL(a.chain(ctx.ClientID, groupID), ctx, url)
+}
+
+// MatchApp 앱 이름에 일치하는 규칙 조회
+func (a *BlacklistAdapter) MatchApp(ctx domain.RuleContext, appName string) (domain.PatternRule, bool) {
+	groupID := a.groupOf(ctx.ClientID)
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return domain.ResolvePattern(a.chain(ctx.ClientID, groupID), ctx, domain.PatternFieldApp, appName)
+}
+
+// MatchWindowTitle 활성 창 제목에 일치하는 규칙 조회
+func (a *BlacklistAdapter) MatchWindowTitle(ctx domain.RuleContext, title string) (domain.PatternRule, bool) {
+	groupID := a.groupOf(ctx.ClientID)
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return domain.ResolvePattern(a.chain(ctx.ClientID, groupID), ctx, domain.PatternFieldWindowTitle, title)
+}
+
+// PolicyChain 클라이언트에 적용되는 정책 (client → group → global 순, 없는 단계는 제외한 복사본)
+func (a *BlacklistAdapter) PolicyChain(clientID string) []domain.ScopedPolicy {
+	targets := []domain.PolicyTarget{domain.ClientPolicy(clientID)}
+	if groupID := a.groupOf(clientID); groupID != "" {
+		targets = append(targets, domain.GroupPolicy(groupID))
 	}
+	targets = append(targets, domain.GlobalPolicy)
 
-	// 부분 매칭 체크 (URL에 블랙리스트 도메인이 포함되어 있는지)
-	for blacklistedURL := range a.urlBlacklist {
-		if containsDomain(url, blacklistedURL) {
-			return true
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	chain := make([]domain.ScopedPolicy, 0, len(targets))
+	for _, target := range targets {
+		if policy, exists := a.policies[target]; exists {
+			chain = append(chain, domain.ScopedPolicy{Target: target, Policy: policy.Clone()})
 		}
 	}
+	return chain
+}
 
+// InLockdown 클라이언트에 시험 모드가 적용 중인지 (활동마다 호출되므로 정책을 복사하지 않음)
+func (a *BlacklistAdapter) InLockdown(clientID string) bool {
+	groupID := a.groupOf(clientID)
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for _, policy := range a.chain(clientID, groupID) {
+		if policy != nil && policy.Lockdown != nil {
+			return true
+		}
+	}
 	return false
 }
 
-// IsAppBlacklisted 주어진 앱이 블랙리스트에 있는지 확인
-func (a *BlacklistAdapter) IsAppBlacklisted(appName string) bool {
-	return a.appBlacklist[appName]
+// Policy 대상의 정책 조회 (복사본, 없으면 빈 정책)
+func (a *BlacklistAdapter) Policy(target domain.PolicyTarget) *domain.BlacklistPolicy {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if policy, exists := a.policies[target]; exists {
+		return policy.Clone()
+	}
+	return &domain.BlacklistPolicy{}
+}
+
+// Policies 모든 정책 (global → group → client, 같은 범위는 ID 순, 복사본)
+func (a *BlacklistAdapter) Policies() []domain.ScopedPolicy {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	policies := make([]domain.ScopedPolicy, 0, len(a.policies))
+	for target, policy := range a.policies {
+		policies = append(policies, domain.ScopedPolicy{Target: target, Policy: policy.Clone()})
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		ri, rj := scopeOrder[policies[i].Target.Scope], scopeOrder[policies[j].Target.Scope]
+		if ri != rj {
+			return ri < rj
+		}
+		return policies[i].Target.ID < policies[j].Target.ID
+	})
+	return policies
+}
+
+// scopeOrder Policies 정렬 순서
+var scopeOrder = map[domain.PolicyScope]int{
+	domain.PolicyGlobal: 0,
+	domain.PolicyGroup:  1,
+	domain.PolicyClient: 2,
 }
 
-// AddURLToBlacklist URL을 블랙리스트에 추가
-func (a *BlacklistAdapter) AddURLToBlacklist(url string) {
-	a.urlBlacklist[url] = true
+// ReplacePolicy 대상 정책 전체 교체 (빈 정책이면 그룹/클라이언트 정책 삭제)
+func (a *BlacklistAdapter) ReplacePolicy(target domain.PolicyTarget, policy *domain.BlacklistPolicy) error {
+	if err := target.Validate(); err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.policies[target] = policy.Clone()
+	a.prune(target)
+	return nil
 }
 
-// RemoveURLFromBlacklist URL을 블랙리스트에서 제거
-func (a *BlacklistAdapter) RemoveURLFromBlacklist(url string) {
-	delete(a.urlBlacklist, url)
+// AddURLRule 대상 정책에 URL 규칙 추가 (rule.Allow이면 허용 규칙)
+func (a *BlacklistAdapter) AddURLRule(target domain.PolicyTarget, rule domain.URLRule) error {
+	if err := target.Validate(); err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.policy(target).AddURLRule(rule)
+	return nil
 }
 
-// AddAppToBlacklist 앱을 블랙리스트에 추가
+// RemoveURLRule 대상 정책에서 URL 규칙 제거
+func (a *BlacklistAdapter) RemoveURLRule(target domain.PolicyTarget, rule domain.URLRule) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if policy, exists := a.policies[target]; exists {
+		policy.RemoveURLRule(rule)
+		a.prune(target)
+	}
+}
+
+// AddPatternRule 대상 정책에 앱 이름/창 제목 규칙 추가 (같은 Key의 규칙은 교체)
+func (a *BlacklistAdapter) AddPatternRule(target domain.PolicyTarget, rule domain.PatternRule) error {
+	if err := target.Validate(); err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.policy(target).AddPatternRule(rule)
+	return nil
+}
+
+// RemovePatternRule 대상 정책에서 규칙 제거 (key: PatternRule.Key)
+func (a *BlacklistAdapter) RemovePatternRule(target domain.PolicyTarget, key string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if policy, exists := a.policies[target]; exists {
+		policy.RemovePatternRule(key)
+		a.prune(target)
+	}
+}
+
+// AddURLToBlacklist 전역 정책에 URL 차단 규칙 추가 ("youtube.com", "youtube.com/shorts")
+func (a *BlacklistAdapter) AddURLToBlacklist(pattern string) error {
+	rule, err := domain.ParseURLRule(pattern)
+	if err != nil {
+		return err
+	}
+	return a.AddURLRule(domain.GlobalPolicy, rule)
+}
+
+// RemoveURLFromBlacklist 전역 정책에서 URL 규칙 제거
+func (a *BlacklistAdapter) RemoveURLFromBlacklist(pattern string) {
+	rule, err := domain.ParseURLRule(pattern)
+	if err != nil {
+		return
+	}
+	a.RemoveURLRule(domain.GlobalPolicy, rule)
+}
+
+// AddAppToBlacklist 전역 정책에 앱 이름 추가 (대소문자 무시 전체 일치, CLOSE_APP)
 func (a *BlacklistAdapter) AddAppToBlacklist(appName string) {
-	a.appBlacklist[appName] = true
+	rule, err := domain.NewPatternRule(domain.PatternFieldApp, domain.PatternGlob, appName, domain.ActionCloseApp, 0)
+	if err != nil {
+		log.Printf("[BLACKLIST] Skipping app %q: %v", appName, err)
+		return
+	}
+	a.AddPatternRule(domain.GlobalPolicy, rule)
 }
 
-// RemoveAppFromBlacklist 앱을 블랙리스트에서 제거
+// RemoveAppFromBlacklist 전역 정책에서 앱 이름 제거
 func (a *BlacklistAdapter) RemoveAppFromBlacklist(appName string) {
-	delete(a.appBlacklist, appName)
+	rule, err := domain.NewPatternRule(domain.PatternFieldApp, domain.PatternGlob, appName, domain.ActionCloseApp, 0)
+	if err != nil {
+		return
+	}
+	a.RemovePatternRule(domain.GlobalPolicy, rule.Key())
 }
 
-// containsDomain URL에 도메인이 포함되어 있는지 확인
-func containsDomain(url, domain string) bool {
-	// 간단한 문자열 포함 체크
-	// 실제 프로덕션에서는 URL 파싱 후 정확한 도메인 비교 필요
-	return len(url) >= len(domain) &&
-		(url == domain ||
-			contains(url, "."+domain) ||
-			contains(url, "://"+domain) ||
-			contains(url, "/"+domain))
+// groupOf 클라이언트의 스터디 그룹 (처음 조회할 때만 멤버십 저장소를 읽으므로 락 밖에서 호출)
+// 조회에 실패하면 캐시하지 않고 다음 호출에서 다시 읽음
+func (a *BlacklistAdapter) groupOf(clientID string) string {
+	a.mu.RLock()
+	members := a.members
+	groupID, cached := a.groups[clientID]
+	a.mu.RUnlock()
+
+	if members == nil || clientID == "" {
+		return ""
+	}
+	if cached {
+		return groupID
+	}
+	member, exists, err := members.LoadMember(clientID)
+	if err != nil {
+		log.Printf("[BLACKLIST] Failed to load group of %s: %v", clientID, err)
+		return ""
+	}
+	if exists {
+		groupID = member.GroupID
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	// 읽는 동안 UpdateMembership으로 갱신됐으면 그 값을 유지
+	if updated, exists := a.groups[clientID]; exists {
+		return updated
+	}
+	a.groups[clientID] = groupID
+	return groupID
 }
 
-// contains 문자열 포함 여부 확인 (strings.Contains 대체)
-func contains(s, substr string) bool {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		if s[i:i+len(substr)] == substr {
-			return true
-		}
+// chain 클라이언트에 적용할 정책 (client → group → global 순, 없는 단계는 nil, 호출자가 락 보유)
+func (a *BlacklistAdapter) chain(clientID, groupID string) []*domain.BlacklistPolicy {
+	policies := make([]*domain.BlacklistPolicy, 0, 3)
+	if clientID != "" {
+		policies = append(policies, a.policies[domain.ClientPolicy(clientID)])
+	}
+	if groupID != "" {
+		policies = append(policies, a.policies[domain.GroupPolicy(groupID)])
+	}
+	return append(policies, a.policies[domain.GlobalPolicy])
+}
+
+// policy 대상 정책 (없으면 생성, 호출자가 락 보유)
+func (a *BlacklistAdapter) policy(target domain.PolicyTarget) *domain.BlacklistPolicy {
+	policy, exists := a.policies[target]
+	if !exists {
+		policy = &domain.BlacklistPolicy{}
+		a.policies[target] = policy
+	}
+	return policy
+}
+
+// prune 빈 그룹/클라이언트 정책 정리 (호출자가 락 보유)
+func (a *BlacklistAdapter) prune(target domain.PolicyTarget) {
+	if policy := a.policies[target]; policy != nil && policy.IsEmpty() && target.Scope != domain.PolicyGlobal {
+		delete(a.policies, target)
 	}
-	return false
 }
@@ -0,0 +1,234 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// scoreHistorySweepInterval 떠난 클라이언트 이력 정리 주기 (샘플 시간 기준)
+const scoreHistorySweepInterval = time.Minute
+
+// ScoreRetention 해상도별 보존 기간
+type ScoreRetention struct {
+	Second time.Duration // 1s 원본
+	Minute time.Duration // 1m 다운샘플
+	Hour   time.Duration // 1h 다운샘플
+}
+
+// DefaultScoreRetention 기본 보존 정책 (원본 2시간, 1분 2일, 1시간 7일)
+// 이력은 메모리에만 있어 재시작하면 사라지므로 한 배포 주기 안에서 의미 있는 기간만 보존
+// (장기 추세는 LeaderboardService의 일별 기록, 주간 리포트 참고)
+func DefaultScoreRetention() ScoreRetention {
+	return ScoreRetention{
+		Second: 2 * time.Hour,
+		Minute: 2 * 24 * time.Hour,
+		Hour:   7 * 24 * time.Hour,
+	}
+}
+
+// scoreTier 한 해상도의 시계열
+// pending에는 아직 닫히지 않은 버킷(현재 분/시)에 속한 하위 해상도 샘플이 쌓임
+type scoreTier struct {
+	resolution   domain.Resolution
+	retention    time.Duration
+	samples      []domain.ScoreSample // 닫힌 버킷 (시간순)
+	pending      []domain.ScoreSample
+	pendingStart time.Time
+}
+
+// scoreSeries 클라이언트 하나의 1s → 1m → 1h 시계열
+type scoreSeries struct {
+	tiers  []*scoreTier // [0]=1s, [1]=1m, [2]=1h
+	latest time.Time
+}
+
+// ScoreHistoryAdapter 인메모리 점수 이력 어댑터 (Driven Adapter)
+// 재시작하면 이력이 비어 있음 (영속 저장 없음)
+// 원본 샘플이 들어올 때마다 분/시 버킷을 갱신하고, 버킷이 닫히면 상위 해상도로 다운샘플
+// 보존 기간이 지난 샘플은 기록 시점에 정리
+type ScoreHistoryAdapter struct {
+	retention ScoreRetention
+	series    map[string]*scoreSeries
+	lastSweep time.Time
+	mu        sync.RWMutex
+}
+
+// NewScoreHistoryAdapter ScoreHistoryAdapter 생성자
+func NewScoreHistoryAdapter(retention ScoreRetention) *ScoreHistoryAdapter {
+	return &ScoreHistoryAdapter{
+		retention: retention,
+		series:    make(map[string]*scoreSeries),
+	}
+}
+
+// AppendScore 점수 스냅샷을 원본 샘플로 기록
+func (a *ScoreHistoryAdapter) AppendScore(snapshot domain.ScoreSnapshot) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	series, exists := a.series[snapshot.ClientID]
+	if !exists {
+		series = a.newSeries()
+		a.series[snapshot.ClientID] = series
+	} else if snapshot.Timestamp.Before(series.latest) {
+		return fmt.Errorf("score sample for %s is out of order: %s before %s",
+			snapshot.ClientID, snapshot.Timestamp.Format(time.RFC3339), series.latest.Format(time.RFC3339))
+	}
+
+	series.latest = snapshot.Timestamp
+	series.append(domain.NewScoreSample(snapshot))
+	series.prune(snapshot.Timestamp)
+
+	if snapshot.Timestamp.Sub(a.lastSweep) >= scoreHistorySweepInterval {
+		a.sweep(snapshot.Timestamp)
+		a.lastSweep = snapshot.Timestamp
+	}
+	return nil
+}
+
+// QueryScores 기간 [from, to) 내 샘플 조회
+// 아직 닫히지 않은 현재 버킷도 부분 집계로 포함
+func (a *ScoreHistoryAdapter) QueryScores(clientID string, from, to time.Time, resolution domain.Resolution) ([]domain.ScoreSample, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	series, exists := a.series[clientID]
+	if !exists {
+		return []domain.ScoreSample{}, nil
+	}
+
+	level := -1
+	for i, tier := range series.tiers {
+		if tier.resolution == resolution {
+			level = i
+		}
+	}
+	if level < 0 {
+		return nil, fmt.Errorf("unsupported resolution: %q", resolution)
+	}
+
+	tier := series.tiers[level]
+	start := sort.Search(len(tier.samples), func(i int) bool {
+		return !tier.samples[i].Timestamp.Before(from)
+	})
+
+	result := make([]domain.ScoreSample, 0)
+	for _, sample := range tier.samples[start:] {
+		if !sample.Timestamp.Before(to) {
+			return result, nil
+		}
+		result = append(result, sample)
+	}
+
+	for _, open := range series.openBuckets(level) {
+		if !open.Timestamp.Before(from) && open.Timestamp.Before(to) {
+			result = append(result, open)
+		}
+	}
+	return result, nil
+}
+
+// newSeries 보존 정책이 적용된 빈 시계열 생성
+func (a *ScoreHistoryAdapter) newSeries() *scoreSeries {
+	return &scoreSeries{
+		tiers: []*scoreTier{
+			{resolution: domain.ResolutionSecond, retention: a.retention.Second},
+			{resolution: domain.ResolutionMinute, retention: a.retention.Minute},
+			{resolution: domain.ResolutionHour, retention: a.retention.Hour},
+		},
+	}
+}
+
+// sweep 모든 보존 기간이 지난 클라이언트 시계열 제거 (호출자가 락 보유)
+func (a *ScoreHistoryAdapter) sweep(now time.Time) {
+	for clientID, series := range a.series {
+		series.prune(now)
+		if series.empty() {
+			delete(a.series, clientID)
+		}
+	}
+}
+
+// append 원본 샘플 추가 및 상위 해상도로 전파
+func (s *scoreSeries) append(sample domain.ScoreSample) {
+	s.tiers[0].samples = append(s.tiers[0].samples, sample)
+	s.feed(1, sample)
+}
+
+// feed level 해상도의 현재 버킷에 하위 샘플 추가
+// 버킷이 바뀌면 이전 버킷을 닫아 다운샘플하고 다시 상위로 전파
+func (s *scoreSeries) feed(level int, sample domain.ScoreSample) {
+	if level >= len(s.tiers) {
+		return
+	}
+	tier := s.tiers[level]
+	bucketStart := sample.Timestamp.Truncate(tier.resolution.Duration())
+
+	if len(tier.pending) > 0 && !bucketStart.Equal(tier.pendingStart) {
+		closed := domain.DownsampleScores(tier.pendingStart, tier.pending)
+		tier.samples = append(tier.samples, closed)
+		tier.pending = tier.pending[:0]
+		s.feed(level+1, closed)
+	}
+
+	tier.pendingStart = bucketStart
+	tier.pending = append(tier.pending, sample)
+}
+
+// openBuckets level 해상도의 아직 닫히지 않은 버킷 부분 집계
+// 하위 해상도의 열린 버킷까지 포함해야 최신 데이터가 보임
+// (하위 버킷이 이미 다음 구간으로 넘어간 경우 구간별로 나눠 반환)
+func (s *scoreSeries) openBuckets(level int) []domain.ScoreSample {
+	if level == 0 {
+		return nil
+	}
+	tier := s.tiers[level]
+	size := tier.resolution.Duration()
+
+	samples := append(append([]domain.ScoreSample{}, tier.pending...), s.openBuckets(level-1)...)
+
+	var buckets []domain.ScoreSample
+	var group []domain.ScoreSample
+	var groupStart time.Time
+	for _, sample := range samples {
+		start := sample.Timestamp.Truncate(size)
+		if len(group) > 0 && !start.Equal(groupStart) {
+			buckets = append(buckets, domain.DownsampleScores(groupStart, group))
+			group = nil
+		}
+		groupStart = start
+		group = append(group, sample)
+	}
+	if len(group) > 0 {
+		buckets = append(buckets, domain.DownsampleScores(groupStart, group))
+	}
+	return buckets
+}
+
+// prune 보존 기간이 지난 닫힌 샘플 제거
+func (s *scoreSeries) prune(now time.Time) {
+	for _, tier := range s.tiers {
+		cutoff := now.Add(-tier.retention)
+		idx := sort.Search(len(tier.samples), func(i int) bool {
+			return !tier.samples[i].Timestamp.Before(cutoff)
+		})
+		tier.samples = tier.samples[idx:]
+		if len(tier.pending) > 0 && tier.pendingStart.Add(tier.resolution.Duration()).Before(cutoff) {
+			tier.pending = tier.pending[:0]
+		}
+	}
+}
+
+// empty 남은 샘플이 없는지 확인
+func (s *scoreSeries) empty() bool {
+	for _, tier := range s.tiers {
+		if len(tier.samples) > 0 || len(tier.pending) > 0 {
+			return false
+		}
+	}
+	return true
+}
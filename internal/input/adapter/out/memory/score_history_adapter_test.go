@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"testing"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// appendScores sec초부터 count개의 1초 간격 샘플 기록
+func appendScores(t *testing.T, adapter *ScoreHistoryAdapter, start time.Time, sec, count, score int) {
+	t.Helper()
+	for i := 0; i < count; i++ {
+		err := adapter.AppendScore(domain.ScoreSnapshot{
+			ClientID:  "pc-01",
+			Score:     score,
+			State:     "FOCUSING",
+			Timestamp: start.Add(time.Duration(sec+i) * time.Second),
+		})
+		if err != nil {
+			t.Fatalf("AppendScore failed: %v", err)
+		}
+	}
+}
+
+func TestScoreHistoryAdapter_QueryScores(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	adapter := NewScoreHistoryAdapter(DefaultScoreRetention())
+	// 1분째 10점, 2분째 20점, 3분째 앞 30초 30점
+	appendScores(t, adapter, t0, 0, 60, 10)
+	appendScores(t, adapter, t0, 60, 60, 20)
+	appendScores(t, adapter, t0, 120, 30, 30)
+
+	type bucket struct {
+		offset time.Duration
+		avg    float64
+		count  int
+	}
+	tests := []struct {
+		name       string
+		from, to   time.Duration
+		resolution domain.Resolution
+		want       []bucket
+	}{
+		{"raw samples", 0, 3 * time.Second, domain.ResolutionSecond, []bucket{{0, 10, 1}, {time.Second, 10, 1}, {2 * time.Second, 10, 1}}},
+		{"raw across minute boundary", 59 * time.Second, 61 * time.Second, domain.ResolutionSecond, []bucket{{59 * time.Second, 10, 1}, {time.Minute, 20, 1}}},
+		{"minutes with open bucket", 0, 3 * time.Minute, domain.ResolutionMinute, []bucket{{0, 10, 60}, {time.Minute, 20, 60}, {2 * time.Minute, 30, 30}}},
+		{"minute range end is exclusive", time.Minute, 2 * time.Minute, domain.ResolutionMinute, []bucket{{time.Minute, 20, 60}}},
+		{"minute range starting mid bucket", 30 * time.Second, 2 * time.Minute, domain.ResolutionMinute, []bucket{{time.Minute, 20, 60}}},
+		{"open hour includes open minute", 0, time.Hour, domain.ResolutionHour, []bucket{{0, 18, 150}}},
+		{"empty range", time.Hour, 2 * time.Hour, domain.ResolutionHour, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			samples, err := adapter.QueryScores("pc-01", t0.Add(tt.from), t0.Add(tt.to), tt.resolution)
+			if err != nil {
+				t.Fatalf("QueryScores failed: %v", err)
+			}
+			if len(samples) != len(tt.want) {
+				t.Fatalf("Expected %d samples, got %d: %+v", len(tt.want), len(samples), samples)
+			}
+			for i, want := range tt.want {
+				got := samples[i]
+				if !got.Timestamp.Equal(t0.Add(want.offset)) || got.AvgScore != want.avg || got.Count != want.count {
+					t.Errorf("Sample %d = %+v, want offset %v avg %v count %d", i, got, want.offset, want.avg, want.count)
+				}
+			}
+		})
+	}
+
+	// 다음 시간으로 넘어가면 분/시 버킷이 닫혀 상위 해상도로 전파
+	appendScores(t, adapter, t0, 3605, 1, 50)
+	hours, _ := adapter.QueryScores("pc-01", t0, t0.Add(2*time.Hour), domain.ResolutionHour)
+	if len(hours) != 2 || hours[0].Count != 150 || hours[0].AvgScore != 18 || hours[0].MinScore != 10 || hours[0].MaxScore != 30 {
+		t.Fatalf("Expected closed hour and open hour, got %+v", hours)
+	}
+	if hours[1].Count != 1 || hours[1].AvgScore != 50 {
+		t.Errorf("Expected open hour with the new sample, got %+v", hours[1])
+	}
+
+	if _, err := adapter.QueryScores("pc-01", t0, t0.Add(time.Hour), domain.Resolution("5m")); err == nil {
+		t.Error("Expected error for unsupported resolution")
+	}
+	if err := adapter.AppendScore(domain.ScoreSnapshot{ClientID: "pc-01", Timestamp: t0}); err == nil {
+		t.Error("Expected error for out-of-order sample")
+	}
+	if samples, _ := adapter.QueryScores("pc-02", t0, t0.Add(time.Hour), domain.ResolutionSecond); len(samples) != 0 {
+		t.Errorf("Expected no samples for unknown client, got %d", len(samples))
+	}
+}
+
+func TestScoreHistoryAdapter_Retention(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	adapter := NewScoreHistoryAdapter(ScoreRetention{Second: time.Minute, Minute: 10 * time.Minute, Hour: 24 * time.Hour})
+	appendScores(t, adapter, t0, 0, 120, 40)
+	appendScores(t, adapter, t0, 15*60, 1, 60)
+
+	now := t0.Add(15 * time.Minute)
+	raw, _ := adapter.QueryScores("pc-01", t0, now.Add(time.Second), domain.ResolutionSecond)
+	if len(raw) != 1 {
+		t.Errorf("Expected raw samples older than a minute pruned, got %d", len(raw))
+	}
+	minutes, _ := adapter.QueryScores("pc-01", t0, now.Add(time.Second), domain.ResolutionMinute)
+	if len(minutes) != 1 || !minutes[0].Timestamp.Equal(now) {
+		t.Errorf("Expected minute buckets older than 10 minutes pruned, got %+v", minutes)
+	}
+	hours, _ := adapter.QueryScores("pc-01", t0, now.Add(time.Second), domain.ResolutionHour)
+	if len(hours) != 1 || hours[0].Count != 121 {
+		t.Errorf("Expected the hour bucket kept, got %+v", hours)
+	}
+}
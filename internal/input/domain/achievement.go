@@ -0,0 +1,236 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// AchievementRuleType 업적 규칙 유형
+type AchievementRuleType string
+
+const (
+	AchievementFocusDuration AchievementRuleType = "focus_duration" // 지정 상태 누적 시간 (리셋 이벤트 발생 시 초기화)
+	AchievementDailyStreak   AchievementRuleType = "daily_streak"   // 하루 최소 집중 시간을 채운 연속 일수
+	AchievementEventCount    AchievementRuleType = "event_count"    // 이벤트 발생 횟수
+)
+
+// DefaultAchievementStates 규칙에 상태가 없을 때 누적하는 점수 상태 (집중 + 생각)
+var DefaultAchievementStates = []string{"FOCUSING", "THINKING"}
+
+// AchievementRule 규칙 파일로 정의하는 업적 (코드 수정 없이 추가/변경)
+type AchievementRule struct {
+	ID          string              // 업적 식별자
+	Name        string              // 표시 이름
+	Description string              // 설명
+	Type        AchievementRuleType // 규칙 유형
+
+	States       []string            // focus_duration, daily_streak: 누적할 점수 상태
+	Duration     time.Duration       // focus_duration: 목표 누적 시간
+	ResetOn      []ProgressEventType // focus_duration: 누적을 초기화하는 이벤트 (예: BLOCK_URL)
+	Days         int                 // daily_streak: 목표 연속 일수
+	DailyMinimum time.Duration       // daily_streak: 하루로 인정하는 최소 누적 시간
+	Event        ProgressEventType   // event_count: 셀 이벤트
+	Count        int                 // event_count: 목표 횟수
+}
+
+// Validate 규칙 검증
+func (r AchievementRule) Validate() error {
+	if r.ID == "" {
+		return errors.New("achievement id is required")
+	}
+	switch r.Type {
+	case AchievementFocusDuration:
+		if r.Duration <= 0 {
+			return fmt.Errorf("achievement %s: duration must be positive", r.ID)
+		}
+	case AchievementDailyStreak:
+		if r.Days <= 0 {
+			return fmt.Errorf("achievement %s: days must be positive", r.ID)
+		}
+		if r.DailyMinimum <= 0 {
+			return fmt.Errorf("achievement %s: daily_minimum must be positive", r.ID)
+		}
+	case AchievementEventCount:
+		if r.Event == "" {
+			return fmt.Errorf("achievement %s: event is required", r.ID)
+		}
+		if r.Count <= 0 {
+			return fmt.Errorf("achievement %s: count must be positive", r.ID)
+		}
+	default:
+		return fmt.Errorf("achievement %s: unknown type %q", r.ID, r.Type)
+	}
+	return nil
+}
+
+// CountsState 누적 대상 상태인지 확인
+func (r AchievementRule) CountsState(state string) bool {
+	states := r.States
+	if len(states) == 0 {
+		states = DefaultAchievementStates
+	}
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// ResetsOn 누적을 초기화하는 이벤트인지 확인
+func (r AchievementRule) ResetsOn(eventType ProgressEventType) bool {
+	for _, t := range r.ResetOn {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// AchievementRuleSet 버전이 있는 업적 규칙 묶음
+type AchievementRuleSet struct {
+	Version string
+	Rules   []AchievementRule
+}
+
+// Validate 규칙 묶음 검증 (ID 중복 금지)
+func (s AchievementRuleSet) Validate() error {
+	seen := make(map[string]bool, len(s.Rules))
+	for _, rule := range s.Rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+		if seen[rule.ID] {
+			return fmt.Errorf("duplicate achievement id %s", rule.ID)
+		}
+		seen[rule.ID] = true
+	}
+	return nil
+}
+
+// Achievement 클라이언트별 업적 달성 현황 (GamificationResponse.achievements)
+type Achievement struct {
+	ID          string
+	Name        string
+	Description string
+	Unlocked    bool
+	UnlockedAt  time.Time
+}
+
+// AchievementRuleProgress 규칙별 진행 상태
+type AchievementRuleProgress struct {
+	Accumulated time.Duration // focus_duration: 누적 시간, daily_streak: 당일 누적 시간
+	Count       int           // event_count: 발생 횟수, daily_streak: 연속 일수
+	Day         string        // daily_streak: 누적 중인 날짜 (YYYY-MM-DD)
+	StreakDay   string        // daily_streak: 마지막으로 최소 시간을 채운 날짜
+}
+
+// AchievementProgress 클라이언트별 업적 진행도 (영속 저장 대상)
+type AchievementProgress struct {
+	ClientID   string
+	Rules      map[string]*AchievementRuleProgress // 규칙 ID별 진행 상태
+	UnlockedAt map[string]time.Time                // 규칙 ID별 달성 시간
+}
+
+// NewAchievementProgress 새 업적 진행도 생성
+func NewAchievementProgress(clientID string) *AchievementProgress {
+	return &AchievementProgress{
+		ClientID:   clientID,
+		Rules:      make(map[string]*AchievementRuleProgress),
+		UnlockedAt: make(map[string]time.Time),
+	}
+}
+
+// Rule 규칙 진행 상태 조회 (없으면 생성)
+func (p *AchievementProgress) Rule(ruleID string) *AchievementRuleProgress {
+	progress, exists := p.Rules[ruleID]
+	if !exists {
+		progress = &AchievementRuleProgress{}
+		p.Rules[ruleID] = progress
+	}
+	return progress
+}
+
+// IsUnlocked 달성 여부
+func (p *AchievementProgress) IsUnlocked(ruleID string) bool {
+	_, unlocked := p.UnlockedAt[ruleID]
+	return unlocked
+}
+
+// Unlock 업적 달성 기록 (이미 달성했으면 false)
+func (p *AchievementProgress) Unlock(ruleID string, at time.Time) bool {
+	if p.IsUnlocked(ruleID) {
+		return false
+	}
+	p.UnlockedAt[ruleID] = at
+	return true
+}
+
+// Clone 깊은 복사 (락 밖에서 저장하기 위함)
+func (p *AchievementProgress) Clone() *AchievementProgress {
+	clone := NewAchievementProgress(p.ClientID)
+	for id, rule := range p.Rules {
+		copied := *rule
+		clone.Rules[id] = &copied
+	}
+	for id, at := range p.UnlockedAt {
+		clone.UnlockedAt[id] = at
+	}
+	return clone
+}
+
+// Achievements 규칙 목록 기준 달성 현황
+func (p *AchievementProgress) Achievements(rules []AchievementRule) []Achievement {
+	achievements := make([]Achievement, 0, len(rules))
+	for _, rule := range rules {
+		unlockedAt, unlocked := p.UnlockedAt[rule.ID]
+		achievements = append(achievements, Achievement{
+			ID:          rule.ID,
+			Name:        rule.Name,
+			Description: rule.Description,
+			Unlocked:    unlocked,
+			UnlockedAt:  unlockedAt,
+		})
+	}
+	return achievements
+}
+
+// ProgressEventType 학습 진행 이벤트 유형 (점수 외 활동 이벤트)
+type ProgressEventType string
+
+const (
+	ProgressEventBlockURL          ProgressEventType = "BLOCK_URL"          // 블랙리스트 URL 차단
+	ProgressEventCloseApp          ProgressEventType = "CLOSE_APP"          // 블랙리스트 앱 종료
+	ProgressEventMinimizeAll       ProgressEventType = "MINIMIZE_ALL"       // 차단 창 제목/앱으로 전체 창 최소화
+	ProgressEventEmergency         ProgressEventType = "EMERGENCY"          // 응급 상황 발생
+	ProgressEventEmergencyResolved ProgressEventType = "EMERGENCY_RESOLVED" // 응급 상황 해결 (AI 해결책 전달 완료)
+)
+
+// IsBlock 차단 이벤트(BLOCK_URL, CLOSE_APP, MINIMIZE_ALL)인지 확인
+func (t ProgressEventType) IsBlock() bool {
+	return t == ProgressEventBlockURL || t == ProgressEventCloseApp || t == ProgressEventMinimizeAll
+}
+
+// ProgressEvent 학습 진행 이벤트
+type ProgressEvent struct {
+	ClientID  string
+	Type      ProgressEventType
+	Target    string // 차단 대상 (BLOCK_URL: 도메인, CLOSE_APP/MINIMIZE_ALL: 앱 이름 또는 창 제목, 그 외 비어 있음)
+	Timestamp time.Time
+}
+
+// NewProgressEvent ProgressEvent 생성자
+func NewProgressEvent(clientID string, eventType ProgressEventType, timestamp time.Time) ProgressEvent {
+	return ProgressEvent{
+		ClientID:  clientID,
+		Type:      eventType,
+		Timestamp: timestamp,
+	}
+}
+
+// WithTarget 차단 대상 설정
+func (e ProgressEvent) WithTarget(target string) ProgressEvent {
+	e.Target = target
+	return e
+}
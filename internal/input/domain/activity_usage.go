@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// UsageKind 사용 시간 집계 대상 종류
+type UsageKind string
+
+const (
+	UsageDomain UsageKind = "domain" // 웹 사이트 (호스트 기준)
+	UsageApp    UsageKind = "app"    // 앱
+)
+
+// UsageTarget 사용 시간 집계 대상 (youtube.com, steam.exe 등)
+type UsageTarget struct {
+	Kind UsageKind
+	Name string
+}
+
+// UsageTargetOf 활동의 집계 대상 (URL_VISIT → 도메인, APP_OPEN → 앱)
+func UsageTargetOf(activity ClientActivity) (UsageTarget, bool) {
+	switch {
+	case activity.IsURLActivity():
+		host := HostOf(activity.URL)
+		if host == "" {
+			return UsageTarget{}, false
+		}
+		return UsageTarget{Kind: UsageDomain, Name: host}, true
+	case activity.ActivityType == ActivityAppOpen && activity.AppName != "":
+		return UsageTarget{Kind: UsageApp, Name: strings.ToLower(activity.AppName)}, true
+	}
+	return UsageTarget{}, false
+}
+
+// HostOf URL의 호스트 (소문자, www. 와 포트 제거, 스킴이 없어도 처리)
+func HostOf(rawURL string) string {
+	host, _, _ := SplitURL(rawURL)
+	return host
+}
+
+// UsagePeriod 사용 시간 조회 기간
+type UsagePeriod string
+
+const (
+	PeriodToday     UsagePeriod = "today"
+	PeriodYesterday UsagePeriod = "yesterday"
+	PeriodThisWeek  UsagePeriod = "this_week"
+	PeriodLastWeek  UsagePeriod = "last_week"
+)
+
+// Label 기간 표시 이름 (FactBombResponse.time_period)
+func (p UsagePeriod) Label() string {
+	switch p {
+	case PeriodToday:
+		return "오늘"
+	case PeriodYesterday:
+		return "어제"
+	case PeriodThisWeek:
+		return "이번 주"
+	case PeriodLastWeek:
+		return "지난주"
+	default:
+		return string(p)
+	}
+}
+
+// Range 기준 시간(now, 해당 시간대)의 기간 범위 [from, to)
+// 주는 월요일 00:00부터 시작
+func (p UsagePeriod) Range(now time.Time) (from, to time.Time) {
+	today := StartOfDay(now)
+	weekStart := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+
+	switch p {
+	case PeriodYesterday:
+		return today.AddDate(0, 0, -1), today
+	case PeriodThisWeek:
+		return weekStart, weekStart.AddDate(0, 0, 7)
+	case PeriodLastWeek:
+		return weekStart.AddDate(0, 0, -7), weekStart
+	default:
+		return today, today.AddDate(0, 0, 1)
+	}
+}
+
+// StartOfDay 같은 시간대 기준 그날 00:00
+func StartOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// UsageStat 대상별 누적 사용 시간
+type UsageStat struct {
+	Target  UsageTarget
+	Seconds int64
+}
+
+// FactBomb 누적 사용 시간 기반 팩트 폭격
+type FactBomb struct {
+	ClientID           string
+	Target             UsageTarget
+	AccumulatedSeconds int64
+	Period             UsagePeriod
+	Message            string
+}
+
+// NewFactBomb 팩트 폭격 멘트 생성 (예: "지난주 youtube.com에서 4시간 12분 동안 머물렀습니다.")
+func NewFactBomb(clientID string, stat UsageStat, period UsagePeriod) FactBomb {
+	return FactBomb{
+		ClientID:           clientID,
+		Target:             stat.Target,
+		AccumulatedSeconds: stat.Seconds,
+		Period:             period,
+		Message: fmt.Sprintf("%s %s에서 %s 동안 머물렀습니다.",
+			period.Label(), stat.Target.Name, FormatDwell(stat.Seconds)),
+	}
+}
+
+// FormatDwell 사용 시간 표시 (4시간 12분, 12분, 45초)
+func FormatDwell(seconds int64) string {
+	hours := seconds / 3600
+	minutes := seconds % 3600 / 60
+	switch {
+	case hours > 0 && minutes > 0:
+		return fmt.Sprintf("%d시간 %d분", hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%d시간", hours)
+	case minutes > 0:
+		return fmt.Sprintf("%d분", minutes)
+	default:
+		return fmt.Sprintf("%d초", seconds)
+	}
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// MinLoudnessDBFS 16-bit PCM의 하한 (무음)
+const MinLoudnessDBFS = -96.0
+
+// AudioChunk TranscribeAudio 스트림으로 받은 오디오 청크
+// AudioData는 16-bit little-endian signed mono PCM
+type AudioChunk struct {
+	ClientID   string    // 클라이언트 식별자
+	AudioData  []byte    // PCM 데이터
+	SampleRate int       // 샘플 레이트 (0이면 미지정)
+	Transcript string    // 클라이언트 STT 최신 결과 (선택)
+	IsFinal    bool      // 문장 끝 여부
+	Timestamp  time.Time // 수신 시간
+}
+
+// SampleCount 청크의 샘플 수
+func (c *AudioChunk) SampleCount() int {
+	return len(c.AudioData) / 2
+}
+
+// Duration 청크 재생 길이
+func (c *AudioChunk) Duration(sampleRate int) time.Duration {
+	if sampleRate <= 0 {
+		return 0
+	}
+	return time.Duration(c.SampleCount()) * time.Second / time.Duration(sampleRate)
+}
+
+// LoudnessDBFS 청크의 RMS 음량 (dBFS, 최대 0)
+// 빈 청크나 무음은 MinLoudnessDBFS
+func (c *AudioChunk) LoudnessDBFS() float64 {
+	count := c.SampleCount()
+	if count == 0 {
+		return MinLoudnessDBFS
+	}
+
+	var sumSquares float64
+	for i := 0; i < count; i++ {
+		sample := float64(int16(uint16(c.AudioData[2*i]) | uint16(c.AudioData[2*i+1])<<8))
+		sumSquares += sample * sample
+	}
+
+	rms := math.Sqrt(sumSquares/float64(count)) / 32768.0
+	if rms == 0 {
+		return MinLoudnessDBFS
+	}
+	return math.Max(20*math.Log10(rms), MinLoudnessDBFS)
+}
@@ -0,0 +1,465 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PolicyScope 블랙리스트 정책 적용 범위
+type PolicyScope string
+
+const (
+	PolicyGlobal PolicyScope = "global" // 모든 클라이언트
+	PolicyGroup  PolicyScope = "group"  // 스터디 그룹 (LeaderboardMember.GroupID)
+	PolicyClient PolicyScope = "client" // 클라이언트 하나
+)
+
+// PolicyTarget 정책이 붙는 대상 (global은 ID 없음)
+type PolicyTarget struct {
+	Scope PolicyScope
+	ID    string
+}
+
+// GlobalPolicy 전역 정책 대상
+var GlobalPolicy = PolicyTarget{Scope: PolicyGlobal}
+
+// GroupPolicy 그룹 정책 대상
+func GroupPolicy(groupID string) PolicyTarget {
+	return PolicyTarget{Scope: PolicyGroup, ID: groupID}
+}
+
+// ClientPolicy 클라이언트 정책 대상
+func ClientPolicy(clientID string) PolicyTarget {
+	return PolicyTarget{Scope: PolicyClient, ID: clientID}
+}
+
+// Validate 대상 검증
+func (t PolicyTarget) Validate() error {
+	switch t.Scope {
+	case PolicyGlobal:
+		if t.ID != "" {
+			return errors.New("global policy must not have an id")
+		}
+	case PolicyGroup, PolicyClient:
+		if t.ID == "" {
+			return fmt.Errorf("%s policy requires an id", t.Scope)
+		}
+	default:
+		return fmt.Errorf("unknown policy scope %q", t.Scope)
+	}
+	return nil
+}
+
+// String 대상 표시 (global, group:algo-study, client:client-1)
+func (t PolicyTarget) String() string {
+	if t.Scope == PolicyGlobal {
+		return string(t.Scope)
+	}
+	return string(t.Scope) + ":" + t.ID
+}
+
+// ParsePolicyTarget String 형식의 대상 파싱 ("global", "group:algo-study", "client:client-1")
+func ParsePolicyTarget(value string) (PolicyTarget, error) {
+	scope, id, _ := strings.Cut(value, ":")
+	target := PolicyTarget{Scope: PolicyScope(strings.ToLower(scope)), ID: id}
+	if err := target.Validate(); err != nil {
+		return PolicyTarget{}, err
+	}
+	return target, nil
+}
+
+// RuleMetadata 규칙 관리 정보 (누가, 언제, 왜 등록했는지)
+type RuleMetadata struct {
+	CreatedBy string
+	CreatedAt time.Time
+	Reason    string
+	Source    string // 외부 동기화로 들어온 규칙의 출처 (비어 있으면 직접 관리하는 규칙)
+}
+
+// PolicyVerdict 한 정책 단계의 판정
+type PolicyVerdict int
+
+const (
+	VerdictNone  PolicyVerdict = iota // 일치하는 규칙 없음 → 상위 단계로
+	VerdictBlock                      // 차단
+	VerdictAllow                      // 명시적 허용 (상위 단계의 차단을 무시)
+)
+
+// BlacklistPolicy 한 범위(global/group/client)의 차단·허용 규칙 모음
+// 규칙의 Allow가 true이면 허용 규칙
+type BlacklistPolicy struct {
+	URLRules     []URLRule
+	PatternRules []PatternRule
+	Lockdown     *Lockdown // 허용 목록 전용 모드 (group/client, nil이면 평소 정책)
+}
+
+// AddURLRule URL 규칙 추가 (같은 도메인/경로의 규칙은 차단↔허용 교체)
+func (p *BlacklistPolicy) AddURLRule(rule URLRule) {
+	for i, existing := range p.URLRules {
+		if existing.String() == rule.String() {
+			p.URLRules[i] = rule
+			return
+		}
+	}
+	p.URLRules = append(p.URLRules, rule)
+}
+
+// RemoveURLRule URL 규칙 제거 (차단/허용 무관)
+func (p *BlacklistPolicy) RemoveURLRule(rule URLRule) bool {
+	for i, existing := range p.URLRules {
+		if existing.String() == rule.String() {
+			p.URLRules = append(p.URLRules[:i:i], p.URLRules[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// AddPatternRule 패턴 규칙 추가 (같은 Key의 규칙은 교체)
+func (p *BlacklistPolicy) AddPatternRule(rule PatternRule) {
+	for i, existing := range p.PatternRules {
+		if existing.Key() == rule.Key() {
+			p.PatternRules[i] = rule
+			return
+		}
+	}
+	p.PatternRules = append(p.PatternRules, rule)
+}
+
+// RemovePatternRule 패턴 규칙 제거 (key: PatternRule.Key)
+func (p *BlacklistPolicy) RemovePatternRule(key string) bool {
+	for i, existing := range p.PatternRules {
+		if existing.Key() == key {
+			p.PatternRules = append(p.PatternRules[:i:i], p.PatternRules[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// IsEmpty 규칙이 하나도 없는지
+func (p *BlacklistPolicy) IsEmpty() bool {
+	return len(p.URLRules) == 0 && len(p.PatternRules) == 0 && p.Lockdown == nil
+}
+
+// Clone 깊은 복사
+func (p *BlacklistPolicy) Clone() *BlacklistPolicy {
+	return &BlacklistPolicy{
+		URLRules:     append([]URLRule(nil), p.URLRules...),
+		PatternRules: append([]PatternRule(nil), p.PatternRules...),
+		Lockdown:     p.Lockdown.Clone(),
+	}
+}
+
+// RuleSyncDiff 외부 목록 동기화 결과
+type RuleSyncDiff struct {
+	Added     int // 새로 추가된 규칙
+	Updated   int // 액션/강도 등이 바뀐 규칙
+	Removed   int // 목록에서 빠져 제거된 규칙
+	Unchanged int // 그대로 유지된 규칙
+	Skipped   int // 같은 자리에 직접 관리하는 규칙이 있어 건너뛴 규칙
+}
+
+// Changed 정책이 바뀌었는지
+func (d RuleSyncDiff) Changed() bool {
+	return d.Added > 0 || d.Updated > 0 || d.Removed > 0
+}
+
+// SyncRules source가 관리하는 규칙을 주어진 목록과 같게 맞춤 (목록에 없는 규칙은 제거)
+// 다른 출처(직접 관리 포함)의 규칙은 건드리지 않으며, 유지된 규칙은 처음 등록 시각을 보존
+func (p *BlacklistPolicy) SyncRules(source string, urlRules []URLRule, patternRules []PatternRule) RuleSyncDiff {
+	var diff RuleSyncDiff
+
+	existingURLs := make(map[string]URLRule, len(p.URLRules))
+	for _, rule := range p.URLRules {
+		existingURLs[rule.String()] = rule
+	}
+	desiredURLs := make(map[string]bool, len(urlRules))
+	for _, rule := range urlRules {
+		key := rule.String()
+		if desiredURLs[key] {
+			continue
+		}
+		desiredURLs[key] = true
+		existing, exists := existingURLs[key]
+		switch {
+		case exists && existing.Meta.Source != source:
+			diff.Skipped++
+			continue
+		case exists:
+			rule.Meta.CreatedAt = existing.Meta.CreatedAt
+			if rule.Allow == existing.Allow {
+				diff.Unchanged++
+				continue
+			}
+			diff.Updated++
+		default:
+			diff.Added++
+		}
+		rule.Meta.Source = source
+		p.AddURLRule(rule)
+	}
+	for _, rule := range append([]URLRule(nil), p.URLRules...) {
+		if rule.Meta.Source == source && !desiredURLs[rule.String()] {
+			p.RemoveURLRule(rule)
+			diff.Removed++
+		}
+	}
+
+	existingPatterns := make(map[string]PatternRule, len(p.PatternRules))
+	for _, rule := range p.PatternRules {
+		existingPatterns[rule.Key()] = rule
+	}
+	desiredPatterns := make(map[string]bool, len(patternRules))
+	for _, rule := range patternRules {
+		key := rule.Key()
+		if desiredPatterns[key] {
+			continue
+		}
+		desiredPatterns[key] = true
+		existing, exists := existingPatterns[key]
+		switch {
+		case exists && existing.Meta.Source != source:
+			diff.Skipped++
+			continue
+		case exists:
+			rule.Meta.CreatedAt = existing.Meta.CreatedAt
+			if rule.Allow == existing.Allow && rule.Action == existing.Action &&
+				rule.Intensity == existing.Intensity && rule.Message == existing.Message {
+				diff.Unchanged++
+				continue
+			}
+			diff.Updated++
+		default:
+			diff.Added++
+		}
+		rule.Meta.Source = source
+		p.AddPatternRule(rule)
+	}
+	for _, rule := range append([]PatternRule(nil), p.PatternRules...) {
+		if rule.Meta.Source == source && !desiredPatterns[rule.Key()] {
+			p.RemovePatternRule(rule.Key())
+			diff.Removed++
+		}
+	}
+	return diff
+}
+
+// MatchURL 이 단계의 URL 판정 (일정상 비활성인 규칙은 제외)
+// 일치하는 규칙 중 가장 구체적인 규칙(긴 도메인, 긴 경로)이 결정하고, 같으면 허용 우선
+// (youtube.com 차단 + youtube.com/edu 허용 → /edu만 허용)
+func (p *BlacklistPolicy) MatchURL(ctx RuleContext, host, path string) PolicyVerdict {
+	verdict := VerdictNone
+	bestDomain, bestPath := -1, -1
+	for _, rule := range p.URLRules {
+		if !rule.MatchesHost(host) || !rule.MatchesPath(path) || !rule.Schedule.Active(ctx) {
+			continue
+		}
+		domainLen, pathLen := len(rule.Domain), len(rule.PathPrefix)
+		moreSpecific := domainLen > bestDomain || (domainLen == bestDomain && pathLen > bestPath)
+		sameSpecificity := domainLen == bestDomain && pathLen == bestPath
+		if moreSpecific || (sameSpecificity && rule.Allow) {
+			bestDomain, bestPath = domainLen, pathLen
+			verdict = VerdictBlock
+			if rule.Allow {
+				verdict = VerdictAllow
+			}
+		}
+	}
+	return verdict
+}
+
+// MatchPattern 이 단계의 앱 이름/창 제목 판정 (일정상 비활성인 규칙은 제외)
+// 허용 규칙이 하나라도 일치하면 허용, 아니면 일치하는 차단 규칙 중 강도가 가장 높은 규칙 (같으면 먼저 등록된 규칙)
+func (p *BlacklistPolicy) MatchPattern(ctx RuleContext, field PatternField, value string) (PatternRule, PolicyVerdict) {
+	var best PatternRule
+	verdict := VerdictNone
+	for _, rule := range p.PatternRules {
+		if rule.Field != field || !rule.Matches(value) || !rule.Schedule.Active(ctx) {
+			continue
+		}
+		if rule.Allow {
+			return rule, VerdictAllow
+		}
+		if verdict == VerdictNone || rule.Intensity > best.Intensity {
+			best = rule
+			verdict = VerdictBlock
+		}
+	}
+	return best, verdict
+}
+
+// ResolveURL 정책 상속 판정 (policies: client → group → global 순, nil은 건너뜀)
+// 가장 구체적인 단계에서 일치한 규칙이 결정 (시험 모드이면 허용 목록 밖은 모두 차단)
+func ResolveURL(policies []*BlacklistPolicy, ctx RuleContext, rawURL string) bool {
+	host, path, ok := SplitURL(rawURL)
+	if !ok || host == "" {
+		return false
+	}
+	if lockdown := activeLockdown(policies); lockdown != nil {
+		return !lockdown.AllowsURL(host, path)
+	}
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+		switch policy.MatchURL(ctx, host, path) {
+		case VerdictBlock:
+			return true
+		case VerdictAllow:
+			return false
+		}
+	}
+	return false
+}
+
+// ResolvePattern 정책 상속 판정 (policies: client → group → global 순, nil은 건너뜀)
+// 시험 모드이면 허용 목록 밖의 앱은 CLOSE_APP, 창 제목은 평가하지 않음
+func ResolvePattern(policies []*BlacklistPolicy, ctx RuleContext, field PatternField, value string) (PatternRule, bool) {
+	if lockdown := activeLockdown(policies); lockdown != nil {
+		if field != PatternFieldApp || lockdown.AllowsApp(value) {
+			return PatternRule{}, false
+		}
+		return lockdown.BlockRule(), true
+	}
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+		rule, verdict := policy.MatchPattern(ctx, field, value)
+		switch verdict {
+		case VerdictBlock:
+			return rule, true
+		case VerdictAllow:
+			return PatternRule{}, false
+		}
+	}
+	return PatternRule{}, false
+}
+
+// ScopedPolicy 적용 대상이 붙은 정책 (클라이언트 정책 체인 조회용)
+type ScopedPolicy struct {
+	Target PolicyTarget
+	Policy *BlacklistPolicy
+}
+
+// RuleFieldURL 미리보기에서 URL 규칙의 대상 표시 (앱/창 제목은 PatternField)
+const RuleFieldURL = "url"
+
+// RulePreview 특정 시각에 규칙이 적용되는지 미리보기
+type RulePreview struct {
+	Target    PolicyTarget
+	Field     string // url, app, window_title
+	Kind      PatternKind
+	Pattern   string
+	Allow     bool
+	Action    ActionType
+	Intensity int
+	Schedule  string // "always", "mon,tue 09:00-18:00; study sessions"
+	Active    bool   // ctx 시각에 일정상 적용 중인지
+}
+
+// PreviewRules 정책 체인(client → group → global)의 모든 규칙과 ctx 시각의 적용 여부
+// URL 차단 규칙은 ReflexService와 같이 BLOCK_URL 최고 강도로 표시
+// 시험 모드이면 허용 목록과 나머지 차단을 앞에 표시하고 평소 규칙은 모두 비활성
+func PreviewRules(chain []ScopedPolicy, ctx RuleContext) []RulePreview {
+	var previews []RulePreview
+	lockedDown := false
+	for _, scoped := range chain {
+		if scoped.Policy == nil || scoped.Policy.Lockdown == nil {
+			continue
+		}
+		previews = append(previews, previewLockdown(scoped.Target, scoped.Policy.Lockdown)...)
+		lockedDown = true
+		break
+	}
+
+	for _, scoped := range chain {
+		if scoped.Policy == nil {
+			continue
+		}
+		for _, rule := range scoped.Policy.URLRules {
+			preview := RulePreview{
+				Target:   scoped.Target,
+				Field:    RuleFieldURL,
+				Pattern:  rule.String(),
+				Allow:    rule.Allow,
+				Schedule: rule.Schedule.String(),
+				Active:   !lockedDown && rule.Schedule.Active(ctx),
+			}
+			if !rule.Allow {
+				preview.Action = ActionBlockURL
+				preview.Intensity = DefaultPatternIntensity
+			}
+			previews = append(previews, preview)
+		}
+		for _, rule := range scoped.Policy.PatternRules {
+			previews = append(previews, RulePreview{
+				Target:    scoped.Target,
+				Field:     string(rule.Field),
+				Kind:      rule.Kind,
+				Pattern:   rule.Pattern,
+				Allow:     rule.Allow,
+				Action:    rule.Action,
+				Intensity: rule.Intensity,
+				Schedule:  rule.Schedule.String(),
+				Active:    !lockedDown && rule.Schedule.Active(ctx),
+			})
+		}
+	}
+	return previews
+}
+
+// LockdownSchedule 미리보기에서 시험 모드 규칙의 일정 표시
+const LockdownSchedule = "lockdown"
+
+// previewLockdown 시험 모드의 허용 목록과 나머지 차단 미리보기 (항상 적용)
+func previewLockdown(target PolicyTarget, lockdown *Lockdown) []RulePreview {
+	previews := make([]RulePreview, 0, len(lockdown.AllowedURLs)+len(lockdown.AllowedApps)+2)
+	for _, rule := range lockdown.AllowedURLs {
+		previews = append(previews, RulePreview{
+			Target:   target,
+			Field:    RuleFieldURL,
+			Pattern:  rule.String(),
+			Allow:    true,
+			Schedule: LockdownSchedule,
+			Active:   true,
+		})
+	}
+	for _, rule := range lockdown.AllowedApps {
+		previews = append(previews, RulePreview{
+			Target:   target,
+			Field:    string(PatternFieldApp),
+			Kind:     rule.Kind,
+			Pattern:  rule.Pattern,
+			Allow:    true,
+			Schedule: LockdownSchedule,
+			Active:   true,
+		})
+	}
+
+	block := lockdown.BlockRule()
+	return append(previews,
+		RulePreview{
+			Target:    target,
+			Field:     RuleFieldURL,
+			Pattern:   "*",
+			Action:    ActionBlockURL,
+			Intensity: DefaultPatternIntensity,
+			Schedule:  LockdownSchedule,
+			Active:    true,
+		},
+		RulePreview{
+			Target:    target,
+			Field:     string(block.Field),
+			Kind:      block.Kind,
+			Pattern:   block.Pattern,
+			Action:    block.Action,
+			Intensity: block.Intensity,
+			Schedule:  LockdownSchedule,
+			Active:    true,
+		},
+	)
+}
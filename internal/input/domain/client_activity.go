@@ -6,12 +6,13 @@ import "time"
 type ActivityType string
 
 const (
-	ActivityURLVisit   ActivityType = "URL_VISIT"
-	ActivityAppOpen    ActivityType = "APP_OPEN"
-	ActivityAppClose   ActivityType = "APP_CLOSE"
-	ActivityIdleStart  ActivityType = "IDLE_START"
-	ActivityIdleEnd    ActivityType = "IDLE_END"
-	ActivityInputUsage ActivityType = "INPUT_USAGE"
+	ActivityURLVisit    ActivityType = "URL_VISIT"
+	ActivityAppOpen     ActivityType = "APP_OPEN"
+	ActivityAppClose    ActivityType = "APP_CLOSE"
+	ActivityIdleStart   ActivityType = "IDLE_START"
+	ActivityIdleEnd     ActivityType = "IDLE_END"
+	ActivityInputUsage  ActivityType = "INPUT_USAGE"
+	ActivityWindowTitle ActivityType = "WINDOW_TITLE" // 하트비트 활성 창 제목 (억제한 창 제목 차단 릴레이)
 )
 
 // ClientActivity 클라이언트의 활동 데이터를 나타내는 도메인 엔티티
@@ -68,3 +69,8 @@ func (c *ClientActivity) IsURLActivity() bool {
 func (c *ClientActivity) IsAppActivity() bool {
 	return (c.ActivityType == ActivityAppOpen || c.ActivityType == ActivityAppClose) && c.AppName != ""
 }
+
+// IsAppOpen 앱 실행 활동인지 확인
+func (c *ClientActivity) IsAppOpen() bool {
+	return c.ActivityType == ActivityAppOpen && c.AppName != ""
+}
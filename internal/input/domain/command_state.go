@@ -1,6 +1,10 @@
 package domain
 
-import "time"
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
 
 // CommandState Dev 6에서 전송하는 상태 명령
 type CommandState string
@@ -88,3 +92,44 @@ func stateToActionType(state CommandState) ActionType {
 		return ActionWakeScreen
 	}
 }
+
+// EmergencyPayload EMERGENCY StateCommand의 페이로드
+// Dev 6는 에러 로그 원문을, Core 오디오 감지는 JSON을 보냄
+type EmergencyPayload struct {
+	ErrorLog     string  `json:"error_log,omitempty"`
+	ScreamText   string  `json:"scream_text,omitempty"`
+	LoudnessDBFS float64 `json:"loudness_dbfs,omitempty"`
+}
+
+// Encode 페이로드를 JSON으로 직렬화
+func (p EmergencyPayload) Encode() []byte {
+	data, _ := json.Marshal(p)
+	return data
+}
+
+// ParseEmergencyPayload 페이로드 해석
+// 구조화된 해석으로 에러 로그를 얻지 못하면 원문을 에러 로그로 유지
+// (JSON 문자열은 따옴표를 벗긴 값, error_log가 없는 JSON 객체는 객체 원문)
+func ParseEmergencyPayload(payload []byte) EmergencyPayload {
+	trimmed := bytes.TrimSpace(payload)
+	if len(trimmed) == 0 {
+		return EmergencyPayload{ErrorLog: string(payload)}
+	}
+
+	switch trimmed[0] {
+	case '{':
+		var parsed EmergencyPayload
+		if json.Unmarshal(trimmed, &parsed) == nil {
+			if parsed.ErrorLog == "" {
+				parsed.ErrorLog = string(payload)
+			}
+			return parsed
+		}
+	case '"':
+		var errorLog string
+		if json.Unmarshal(trimmed, &errorLog) == nil && errorLog != "" {
+			return EmergencyPayload{ErrorLog: errorLog}
+		}
+	}
+	return EmergencyPayload{ErrorLog: string(payload)}
+}
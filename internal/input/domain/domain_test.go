@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"math"
+	"strings"
 	"testing"
 	"time"
 )
@@ -192,3 +194,654 @@ func TestClientActivity_WithTimestamp(t *testing.T) {
 		t.Errorf("Expected Timestamp %v, got %v", testTime, activity.Timestamp)
 	}
 }
+
+func TestHeartbeat_OSActivityCount(t *testing.T) {
+	hb := Heartbeat{KeystrokeCount: 3, ClickCount: 1, MouseDistance: 250}
+	if got := hb.OSActivityCount(); got != 5 {
+		t.Errorf("OSActivityCount() = %d, want 5", got)
+	}
+
+	dragging := Heartbeat{IsDragging: true}
+	if got := dragging.OSActivityCount(); got != 1 {
+		t.Errorf("OSActivityCount() = %d, want 1 while dragging", got)
+	}
+
+	idle := Heartbeat{}
+	if got := idle.OSActivityCount(); got != 0 {
+		t.Errorf("OSActivityCount() = %d, want 0", got)
+	}
+}
+
+func TestHeartbeat_VisionScore(t *testing.T) {
+	tests := []struct {
+		concentration float64
+		expected      int
+	}{
+		{0.0, 0},
+		{0.755, 76},
+		{1.0, 100},
+		{1.5, 100},
+		{-0.2, 0},
+	}
+
+	for _, tt := range tests {
+		hb := Heartbeat{ConcentrationScore: tt.concentration}
+		if got := hb.VisionScore(); got != tt.expected {
+			t.Errorf("VisionScore(%v) = %d, want %d", tt.concentration, got, tt.expected)
+		}
+	}
+}
+
+func TestScoreSession_Accumulate(t *testing.T) {
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	session := NewScoreSession("client", start)
+
+	session.Accumulate(Heartbeat{IsEyesClosed: true, KeystrokeCount: 4, Timestamp: start})
+	session.Accumulate(Heartbeat{IsEyesClosed: true, Timestamp: start.Add(time.Second)})
+
+	if got := session.EyesClosedDuration(start.Add(time.Second)); got != 2*time.Second {
+		t.Errorf("EyesClosedDuration() = %v, want 2s", got)
+	}
+	// (4 + 0) / 2 = 2
+	if got := session.StableOSActivityCount(); got != 2 {
+		t.Errorf("StableOSActivityCount() = %d, want 2", got)
+	}
+
+	// 눈을 뜨면 연속 구간 초기화
+	session.Accumulate(Heartbeat{Timestamp: start.Add(2 * time.Second)})
+	if got := session.EyesClosedDuration(start.Add(2 * time.Second)); got != 0 {
+		t.Errorf("EyesClosedDuration() = %v, want 0 after eyes opened", got)
+	}
+
+	// 윈도우 크기 초과 시 가장 오래된 값 제거
+	session.Accumulate(Heartbeat{Timestamp: start.Add(3 * time.Second)})
+	if len(session.ActivityWindow) != activityWindowSize {
+		t.Errorf("Expected window size %d, got %d", activityWindowSize, len(session.ActivityWindow))
+	}
+	if got := session.StableOSActivityCount(); got != 0 {
+		t.Errorf("StableOSActivityCount() = %d, want 0", got)
+	}
+}
+
+func TestScoreModel_Validate(t *testing.T) {
+	if err := DefaultScoreModel().Validate(); err != nil {
+		t.Errorf("Default model should be valid: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(m *ScoreModel)
+	}{
+		{"missing version", func(m *ScoreModel) { m.Version = "" }},
+		{"weights do not sum to 1", func(m *ScoreModel) { m.OSWeight = 0.5 }},
+		{"non-positive eyes closed threshold", func(m *ScoreModel) { m.SleepEyesClosedSec = 0 }},
+		{"sleep pitch above thinking pitch", func(m *ScoreModel) { m.SleepHeadPitch = 0 }},
+		{"thinking score out of range", func(m *ScoreModel) { m.ThinkingScore = 120 }},
+		{"negative decay", func(m *ScoreModel) { m.DecayPerSecond = -1 }},
+		{"mash entropy above steady entropy", func(m *ScoreModel) { m.MashEntropyMax = 3.0 }},
+		{"empty steady dwell range", func(m *ScoreModel) { m.SteadyDwellMaxMs = m.SteadyDwellMinMs }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := DefaultScoreModel()
+			tt.modify(&model)
+			if err := model.Validate(); err == nil {
+				t.Error("Expected validation error")
+			}
+		})
+	}
+}
+
+func TestParseResolution(t *testing.T) {
+	if r, err := ParseResolution("1m"); err != nil || r != ResolutionMinute {
+		t.Errorf("ParseResolution(1m) = %v, %v", r, err)
+	}
+	if _, err := ParseResolution("5m"); err == nil {
+		t.Error("Expected error for unsupported resolution")
+	}
+}
+
+func TestDownsampleScores(t *testing.T) {
+	bucket := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	samples := []ScoreSample{
+		NewScoreSample(ScoreSnapshot{Score: 80, State: "FOCUSING", Timestamp: bucket}),
+		NewScoreSample(ScoreSnapshot{Score: 20, State: "DISTRACTED", Timestamp: bucket.Add(time.Second)}),
+		NewScoreSample(ScoreSnapshot{Score: 90, State: "FOCUSING", Timestamp: bucket.Add(2 * time.Second)}),
+	}
+
+	minute := DownsampleScores(bucket, samples)
+	if minute.Count != 3 || minute.MinScore != 20 || minute.MaxScore != 90 {
+		t.Errorf("Unexpected minute bucket: %+v", minute)
+	}
+	if minute.AvgScore != 190.0/3 {
+		t.Errorf("AvgScore = %v, want %v", minute.AvgScore, 190.0/3)
+	}
+	if minute.State != "FOCUSING" {
+		t.Errorf("State = %s, want FOCUSING (most frequent)", minute.State)
+	}
+
+	// 상위 다운샘플은 원본 샘플 수로 가중
+	hour := DownsampleScores(bucket, []ScoreSample{
+		minute,
+		{AvgScore: 10, MinScore: 10, MaxScore: 10, State: "SLEEPING", Count: 1},
+	})
+	if hour.Count != 4 || hour.AvgScore != 50 {
+		t.Errorf("Weighted hour bucket = %+v, want count 4 avg 50", hour)
+	}
+}
+
+// pcm16 같은 값으로 채운 16-bit PCM 생성
+func pcm16(amplitude int16, samples int) []byte {
+	data := make([]byte, samples*2)
+	for i := 0; i < samples; i++ {
+		data[2*i] = byte(uint16(amplitude))
+		data[2*i+1] = byte(uint16(amplitude) >> 8)
+	}
+	return data
+}
+
+func TestAudioChunk_LoudnessDBFS(t *testing.T) {
+	tests := []struct {
+		name      string
+		amplitude int16
+		expected  float64
+	}{
+		{"full scale", -32768, 0},
+		{"half scale", 16384, -6.02},
+		{"silence", 0, MinLoudnessDBFS},
+	}
+
+	for _, tt := range tests {
+		chunk := AudioChunk{AudioData: pcm16(tt.amplitude, 160)}
+		if got := chunk.LoudnessDBFS(); math.Abs(got-tt.expected) > 0.01 {
+			t.Errorf("%s: LoudnessDBFS() = %.2f, want %.2f", tt.name, got, tt.expected)
+		}
+	}
+
+	chunk := AudioChunk{AudioData: pcm16(100, 8000)}
+	if got := chunk.Duration(16000); got != 500*time.Millisecond {
+		t.Errorf("Duration() = %v, want 500ms", got)
+	}
+}
+
+func TestParseEmergencyPayload(t *testing.T) {
+	encoded := EmergencyPayload{ScreamText: "살려줘", LoudnessDBFS: -3}.Encode()
+	if got := ParseEmergencyPayload(encoded); got.ScreamText != "살려줘" || got.LoudnessDBFS != -3 {
+		t.Errorf("Unexpected payload: %+v", got)
+	}
+
+	// 구조화된 해석으로 에러 로그를 얻지 못하면 원문 유지
+	tests := []struct {
+		name     string
+		payload  string
+		errorLog string
+	}{
+		{"raw text", "panic: nil map", "panic: nil map"},
+		{"JSON string", `"panic: nil map"`, "panic: nil map"},
+		{"JSON object with error_log", `{"error_log":"panic: nil map"}`, "panic: nil map"},
+		{"JSON object without error_log", `{"stack":"main.go:42"}`, `{"stack":"main.go:42"}`},
+		{"broken JSON object", `{"error_log":`, `{"error_log":`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseEmergencyPayload([]byte(tt.payload)); got.ErrorLog != tt.errorLog {
+				t.Errorf("Expected error log %q, got %q", tt.errorLog, got.ErrorLog)
+			}
+		})
+	}
+
+	// 오디오 페이로드는 비명 텍스트를 유지하고 원문을 에러 로그로 보존
+	if got := ParseEmergencyPayload(encoded); got.ErrorLog != string(encoded) {
+		t.Errorf("Expected audio payload kept as error log, got %q", got.ErrorLog)
+	}
+}
+
+func TestLevelCurve_LevelFor(t *testing.T) {
+	curve := LevelCurve{BaseXP: 100, Exponent: 1}
+
+	tests := []struct {
+		totalXP    int64
+		level      int
+		experience int64
+		next       int64
+	}{
+		{0, 1, 0, 100},
+		{99, 1, 99, 100},
+		{100, 2, 0, 200},
+		{350, 3, 50, 300},
+	}
+
+	for _, tt := range tests {
+		level, experience, next := curve.LevelFor(tt.totalXP)
+		if level != tt.level || experience != tt.experience || next != tt.next {
+			t.Errorf("LevelFor(%d) = (%d, %d, %d), expected (%d, %d, %d)",
+				tt.totalXP, level, experience, next, tt.level, tt.experience, tt.next)
+		}
+	}
+
+	if err := (LevelCurve{BaseXP: 0, Exponent: 1}).Validate(); err == nil {
+		t.Error("Expected zero base_xp to be rejected")
+	}
+}
+
+func TestAchievementRuleSet_Validate(t *testing.T) {
+	valid := AchievementRule{ID: "a", Type: AchievementEventCount, Event: ProgressEventBlockURL, Count: 1}
+
+	tests := []struct {
+		name    string
+		rules   []AchievementRule
+		wantErr bool
+	}{
+		{"valid", []AchievementRule{valid}, false},
+		{"duplicate id", []AchievementRule{valid, valid}, true},
+		{"unknown type", []AchievementRule{{ID: "b", Type: "unknown"}}, true},
+		{"streak without minimum", []AchievementRule{{ID: "c", Type: AchievementDailyStreak, Days: 7}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := AchievementRuleSet{Rules: tt.rules}.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHostOf(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{"https://www.YouTube.com/watch?v=1", "youtube.com"},
+		{"youtube.com/shorts", "youtube.com"},
+		{"http://localhost:8080/path", "localhost"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if host := HostOf(tt.url); host != tt.expected {
+			t.Errorf("HostOf(%q) = %q, expected %q", tt.url, host, tt.expected)
+		}
+	}
+}
+
+func TestUsagePeriod_Range(t *testing.T) {
+	wednesday := time.Date(2024, 1, 10, 15, 30, 0, 0, time.UTC)
+	monday := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+
+	from, to := PeriodThisWeek.Range(wednesday)
+	if !from.Equal(monday) || !to.Equal(monday.AddDate(0, 0, 7)) {
+		t.Errorf("Unexpected this_week range: %s ~ %s", from, to)
+	}
+	from, to = PeriodLastWeek.Range(wednesday)
+	if !from.Equal(monday.AddDate(0, 0, -7)) || !to.Equal(monday) {
+		t.Errorf("Unexpected last_week range: %s ~ %s", from, to)
+	}
+
+	// 일요일은 그 주의 마지막 날
+	from, _ = PeriodThisWeek.Range(monday.AddDate(0, 0, 6))
+	if !from.Equal(monday) {
+		t.Errorf("Expected Sunday to belong to the week starting %s, got %s", monday, from)
+	}
+
+	if dwell := FormatDwell(4*3600 + 12*60 + 5); dwell != "4시간 12분" {
+		t.Errorf("Unexpected FormatDwell: %s", dwell)
+	}
+}
+
+func TestStudyStreak_AddFocus(t *testing.T) {
+	streak := NewStudyStreak("client-1", time.Hour)
+
+	if streak.AddFocus("2024-01-01", 30*time.Minute) {
+		t.Error("Expected goal not met after 30 minutes")
+	}
+	if !streak.AddFocus("2024-01-01", 30*time.Minute) {
+		t.Error("Expected goal met after 1 hour")
+	}
+	if streak.AddFocus("2024-01-01", time.Hour) {
+		t.Error("Expected goal to be reported only once a day")
+	}
+
+	// 날짜가 바뀌면 당일 누적은 새로 시작, 연속 달성 시 증가
+	if streak.AddFocus("2024-01-02", 59*time.Minute) {
+		t.Error("Expected day focus to reset on a new day")
+	}
+	streak.AddFocus("2024-01-02", time.Minute)
+	if streak.CurrentStreak != 2 || streak.BestStreak != 2 {
+		t.Errorf("Expected 2-day streak, got current=%d best=%d", streak.CurrentStreak, streak.BestStreak)
+	}
+
+	// 하루 건너뛰면 끊김
+	if active := streak.ActiveStreak("2024-01-04"); active != 0 {
+		t.Errorf("Expected broken streak after a missed day, got %d", active)
+	}
+	streak.AddFocus("2024-01-04", time.Hour)
+	if streak.CurrentStreak != 1 || streak.BestStreak != 2 {
+		t.Errorf("Expected restarted streak, got current=%d best=%d", streak.CurrentStreak, streak.BestStreak)
+	}
+
+	evening := time.Date(2024, 1, 5, 22, 0, 0, 0, time.UTC)
+	status := streak.Status(evening, 3*time.Hour)
+	if !status.AtRisk || status.Remaining != time.Hour || status.CurrentStreak != 1 {
+		t.Errorf("Expected at-risk status with 1h remaining, got %+v", status)
+	}
+	if status := streak.Status(evening.Add(-6*time.Hour), 3*time.Hour); status.AtRisk {
+		t.Errorf("Expected not at risk in the afternoon, got %+v", status)
+	}
+}
+
+func TestRankLeaderboard(t *testing.T) {
+	entries := []LeaderboardEntry{
+		{ClientID: "c", FocusedSeconds: 600, AverageScore: 90},
+		{ClientID: "a", FocusedSeconds: 1200, AverageScore: 60},
+		{ClientID: "b", FocusedSeconds: 600, AverageScore: 75},
+	}
+
+	ranked := RankLeaderboard(entries, MetricFocusedTime, 0)
+	if ranked[0].ClientID != "a" || ranked[0].Rank != 1 {
+		t.Errorf("Expected a first, got %+v", ranked[0])
+	}
+	// 동점은 같은 순위 (client_id 순으로 표시)
+	if ranked[1].ClientID != "b" || ranked[1].Rank != 2 || ranked[2].ClientID != "c" || ranked[2].Rank != 2 {
+		t.Errorf("Expected tie at rank 2, got %+v", ranked[1:])
+	}
+
+	ranked = RankLeaderboard(entries, MetricAverageScore, 2)
+	if len(ranked) != 2 || ranked[0].ClientID != "c" || ranked[1].ClientID != "b" {
+		t.Errorf("Expected top 2 by average score, got %+v", ranked)
+	}
+
+	if _, err := ParseLeaderboardPeriod("monthly"); err == nil {
+		t.Error("Expected unknown period to be rejected")
+	}
+}
+
+func TestNewWeeklyReport(t *testing.T) {
+	monday := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	days := []DailyStudyStats{
+		{Day: "2024-01-08", FocusedTime: 2 * time.Hour, ScoreSum: 150, ScoreCount: 2},
+		{Day: "2024-01-10", FocusedTime: 30 * time.Minute, ScoreSum: 60, ScoreCount: 1},
+	}
+	previous := []DailyStudyStats{{Day: "2024-01-02", FocusedTime: time.Hour, ScoreSum: 60, ScoreCount: 1}}
+
+	eventLog := NewDailyEventLog("client-1", "2024-01-09")
+	eventLog.Add(NewProgressEvent("client-1", ProgressEventBlockURL, monday).WithTarget("youtube.com"))
+	eventLog.Add(NewProgressEvent("client-1", ProgressEventBlockURL, monday).WithTarget("youtube.com"))
+	eventLog.Add(NewProgressEvent("client-1", ProgressEventCloseApp, monday).WithTarget("steam.exe"))
+	eventLog.Add(NewProgressEvent("client-1", ProgressEventEmergency, monday))
+	outside := NewDailyEventLog("client-1", "2024-01-15")
+	outside.Add(NewProgressEvent("client-1", ProgressEventBlockURL, monday).WithTarget("netflix.com"))
+
+	report := NewWeeklyReport("client-1", monday, days, previous, []DailyEventLog{*eventLog, *outside}, nil, 5)
+
+	if report.BlockedAttempts() != 3 || report.Emergencies != 1 {
+		t.Errorf("Unexpected counts: blocked=%d emergencies=%d", report.BlockedAttempts(), report.Emergencies)
+	}
+	if len(report.Distractions) != 2 || report.Distractions[0] != (DistractionStat{Target: "youtube.com", Attempts: 2}) {
+		t.Errorf("Expected youtube.com as top distraction, got %+v", report.Distractions)
+	}
+
+	markdown := report.Markdown()
+	for _, expected := range []string{
+		"**기간:** 2024-01-08 ~ 2024-01-14",
+		"**2시간 30분** (지난주 대비 +1시간 30분)",
+		"| 월 | 2024-01-08 | 2시간 | 75.0 |",
+		"| 화 | 2024-01-09 | 0초 | - |",
+		"주간 평균 점수 **70.0점** (지난주 60.0점, ▲10.0)",
+		"1. youtube.com — 2회",
+		"발생 **1회**, 해결 0회",
+	} {
+		if !strings.Contains(markdown, expected) {
+			t.Errorf("Expected report to contain %q\n%s", expected, markdown)
+		}
+	}
+}
+
+func TestURLRule_Matches(t *testing.T) {
+	domainRule, err := ParseURLRule("https://www.YouTube.com/")
+	if err != nil {
+		t.Fatalf("ParseURLRule failed: %v", err)
+	}
+	shortsRule, err := ParseURLRule("youtube.com/shorts/")
+	if err != nil {
+		t.Fatalf("ParseURLRule failed: %v", err)
+	}
+	if shortsRule.String() != "youtube.com/shorts" {
+		t.Errorf("Expected normalized rule youtube.com/shorts, got %s", shortsRule.String())
+	}
+
+	tests := []struct {
+		rule URLRule
+		url  string
+		want bool
+	}{
+		{domainRule, "youtube.com", true},
+		{domainRule, "https://m.youtube.com/watch?v=1", true},
+		{domainRule, "https://notyoutube.com", false},
+		{domainRule, "https://youtube.com.evil.net", false},
+		{domainRule, "https://google.com/search?q=youtube.com", false},
+		{shortsRule, "https://www.youtube.com/shorts/abc", true},
+		{shortsRule, "https://youtube.com/Shorts", true},
+		{shortsRule, "https://youtube.com/edu", false},
+		{shortsRule, "https://youtube.com/shortsfoo", false},
+	}
+	for _, tt := range tests {
+		if got := tt.rule.Matches(tt.url); got != tt.want {
+			t.Errorf("%s.Matches(%q) = %v, want %v", tt.rule, tt.url, got, tt.want)
+		}
+	}
+
+	for _, pattern := range []string{"", "com", "co.kr", "https://"} {
+		if _, err := ParseURLRule(pattern); err == nil {
+			t.Errorf("Expected error for url rule %q", pattern)
+		}
+	}
+}
+
+func TestPatternRule_Matches(t *testing.T) {
+	glob, err := NewPatternRule(PatternFieldApp, PatternGlob, "steam*", ActionCloseApp, 0)
+	if err != nil {
+		t.Fatalf("NewPatternRule failed: %v", err)
+	}
+	if glob.Intensity != DefaultPatternIntensity {
+		t.Errorf("Expected default intensity %d, got %d", DefaultPatternIntensity, glob.Intensity)
+	}
+	for _, app := range []string{"Steam", "steam.exe", "Steam Client"} {
+		if !glob.Matches(app) {
+			t.Errorf("Expected %q to match steam*", app)
+		}
+	}
+	if glob.Matches("MySteam") {
+		t.Error("Glob must match the whole name")
+	}
+
+	regex, err := NewPatternRule(PatternFieldWindowTitle, PatternRegex, `(shorts|reels)`, ActionMinimizeAll, 7)
+	if err != nil {
+		t.Fatalf("NewPatternRule failed: %v", err)
+	}
+	if !regex.Matches("Instagram Reels") || regex.Matches("Go Tutorial") {
+		t.Error("Regex rule should match case-insensitively anywhere in the title")
+	}
+
+	invalid := []struct {
+		kind      PatternKind
+		pattern   string
+		action    ActionType
+		intensity int
+	}{
+		{PatternRegex, "(", ActionCloseApp, 0},
+		{PatternGlob, "", ActionCloseApp, 0},
+		{PatternGlob, "steam", ActionSleepScreen, 0},
+		{PatternGlob, "steam", ActionCloseApp, 11},
+		{"wildcard", "steam", ActionCloseApp, 0},
+	}
+	for _, tt := range invalid {
+		if _, err := NewPatternRule(PatternFieldApp, tt.kind, tt.pattern, tt.action, tt.intensity); err == nil {
+			t.Errorf("Expected error for %+v", tt)
+		}
+	}
+}
+
+func TestBlacklistPolicy_Resolve(t *testing.T) {
+	block, _ := ParseURLRule("youtube.com")
+	shorts, _ := ParseURLRule("youtube.com/shorts")
+	edu, _ := ParseURLRule("youtube.com/edu")
+	edu.Allow = true
+
+	global := &BlacklistPolicy{}
+	global.AddURLRule(block)
+	global.AddURLRule(edu)
+	group := &BlacklistPolicy{}
+	allowAll := block
+	allowAll.Allow = true
+	group.AddURLRule(allowAll)
+	group.AddURLRule(shorts)
+
+	tests := []struct {
+		policies []*BlacklistPolicy
+		url      string
+		want     bool
+	}{
+		{[]*BlacklistPolicy{nil, global}, "youtube.com/watch", true},
+		{[]*BlacklistPolicy{nil, global}, "youtube.com/edu/go", false},
+		{[]*BlacklistPolicy{group, global}, "youtube.com/watch", false},
+		{[]*BlacklistPolicy{group, global}, "m.youtube.com/shorts/1", true},
+		{[]*BlacklistPolicy{group, global}, "netflix.com", false},
+	}
+	for _, tt := range tests {
+		if got := ResolveURL(tt.policies, RuleContext{}, tt.url); got != tt.want {
+			t.Errorf("ResolveURL(%q) = %v, want %v", tt.url, got, tt.want)
+		}
+	}
+
+	if err := (PolicyTarget{Scope: PolicyGroup}).Validate(); err == nil {
+		t.Error("Expected error for group policy without id")
+	}
+}
+
+func TestRuleSchedule_Active(t *testing.T) {
+	workHours, err := NewRuleSchedule([]string{"mon-fri 09:00-18:00"}, false)
+	if err != nil {
+		t.Fatalf("NewRuleSchedule failed: %v", err)
+	}
+	night, err := ParseTimeWindow("weekdays 22:00-02:00")
+	if err != nil {
+		t.Fatalf("ParseTimeWindow failed: %v", err)
+	}
+	sessionOnly, _ := NewRuleSchedule(nil, true)
+
+	seoul := time.FixedZone("KST", 9*3600)
+	at := func(day, hour, minute int) time.Time {
+		// 2024-01-01은 월요일
+		return time.Date(2024, 1, day, hour, minute, 0, 0, seoul)
+	}
+
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"weekday in hours", workHours.Active(RuleContext{At: at(1, 10, 0)}), true},
+		{"weekday at end", workHours.Active(RuleContext{At: at(1, 18, 0)}), false},
+		{"saturday", workHours.Active(RuleContext{At: at(6, 10, 0)}), false},
+		{"friday night", night.Contains(at(5, 23, 0)), true},
+		{"saturday early morning from friday", night.Contains(at(6, 1, 30)), true},
+		{"monday early morning from sunday", night.Contains(at(1, 1, 30)), false},
+		{"outside session", sessionOnly.Active(RuleContext{At: at(1, 10, 0)}), false},
+		{"inside session", sessionOnly.Active(RuleContext{At: at(1, 10, 0), InStudySession: true}), true},
+		{"nil schedule", (*RuleSchedule)(nil).Active(RuleContext{}), true},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	if workHours.String() != "mon,tue,wed,thu,fri 09:00-18:00" {
+		t.Errorf("Unexpected schedule string %q", workHours.String())
+	}
+	if _, err := ParseTimeWindow("mon-fri 9-18"); err == nil {
+		t.Error("Expected error for malformed clock")
+	}
+}
+
+func TestBlacklistPolicy_SyncRules(t *testing.T) {
+	manual, _ := ParseURLRule("youtube.com")
+	policy := &BlacklistPolicy{}
+	policy.AddURLRule(manual)
+
+	synced := func(patterns ...string) []URLRule {
+		var rules []URLRule
+		for _, pattern := range patterns {
+			rule, _ := ParseURLRule(pattern)
+			rules = append(rules, rule)
+		}
+		return rules
+	}
+	steam, _ := NewPatternRule(PatternFieldApp, PatternGlob, "steam", ActionCloseApp, 0)
+
+	diff := policy.SyncRules("data-service", synced("roblox.com", "youtube.com"), []PatternRule{steam})
+	if diff != (RuleSyncDiff{Added: 2, Skipped: 1}) {
+		t.Errorf("Unexpected first sync diff %+v", diff)
+	}
+	if len(policy.URLRules) != 2 || policy.URLRules[0].Meta.Source != "" {
+		t.Errorf("Expected manual youtube.com rule kept, got %+v", policy.URLRules)
+	}
+
+	steam.Intensity = 5
+	diff = policy.SyncRules("data-service", synced("twitch.tv"), []PatternRule{steam})
+	if diff != (RuleSyncDiff{Added: 1, Updated: 1, Removed: 1}) || !diff.Changed() {
+		t.Errorf("Unexpected second sync diff %+v", diff)
+	}
+	for _, rule := range policy.URLRules {
+		if rule.Domain == "roblox.com" {
+			t.Error("Expected roblox.com removed after it left the list")
+		}
+	}
+
+	diff = policy.SyncRules("data-service", synced("twitch.tv"), []PatternRule{steam})
+	if diff.Changed() || diff.Unchanged != 2 {
+		t.Errorf("Expected no change on identical list, got %+v", diff)
+	}
+}
+
+func TestReflexThrottle_Allow(t *testing.T) {
+	throttle := NewReflexThrottle(30*time.Second, 5*time.Second)
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	at := func(seconds int) time.Time { return start.Add(time.Duration(seconds) * time.Second) }
+	youtube := ReflexTargetKey("pc-01", RuleFieldURL, "youtube.com")
+
+	tests := []struct {
+		name string
+		key  string
+		at   time.Time
+		want bool
+	}{
+		{"first report", youtube, at(0), true},
+		{"heartbeat repeat", youtube, at(1), false},
+		{"still on the page", youtube, at(4), false},
+		{"other target", ReflexTargetKey("pc-01", RuleFieldURL, "netflix.com"), at(4), true},
+		{"other client", ReflexTargetKey("pc-02", RuleFieldURL, "youtube.com"), at(4), true},
+		{"cooldown elapsed while staying", youtube, at(30), true},
+		{"repeat after resend", youtube, at(31), false},
+		{"came back after leaving", youtube, at(40), true},
+	}
+	for _, tt := range tests {
+		if got := throttle.Allow(tt.key, tt.at); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// 사건이 끝난 대상은 정리
+	throttle.Allow(youtube, at(100))
+	if throttle.Tracked() != 1 {
+		t.Errorf("Expected finished targets pruned, tracked %d", throttle.Tracked())
+	}
+
+	disabled := NewReflexThrottle(0, 5*time.Second)
+	if !disabled.Allow(youtube, at(0)) || !disabled.Allow(youtube, at(1)) {
+		t.Error("Expected zero cooldown to allow every report")
+	}
+}
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// MaxLevel 레벨 상한 (잘못된 곡선으로 인한 무한 루프 방지)
+const MaxLevel = 999
+
+// LevelCurve 레벨 곡선
+// 레벨 L에서 L+1로 올라가는 데 필요한 경험치 = BaseXP * L^Exponent
+type LevelCurve struct {
+	BaseXP   int64   // Lv.1 → Lv.2 필요 경험치
+	Exponent float64 // 레벨이 오를수록 필요 경험치가 늘어나는 정도 (1이면 선형)
+}
+
+// DefaultLevelCurve 기본 레벨 곡선
+// 집중 1초 = 1 XP 기준 Lv.2까지 5분, Lv.10 → Lv.11은 약 2.6시간
+func DefaultLevelCurve() LevelCurve {
+	return LevelCurve{
+		BaseXP:   300,
+		Exponent: 1.5,
+	}
+}
+
+// Validate 레벨 곡선 검증
+func (c LevelCurve) Validate() error {
+	if c.BaseXP <= 0 {
+		return errors.New("level curve base_xp must be positive")
+	}
+	if c.Exponent < 0 {
+		return errors.New("level curve exponent must not be negative")
+	}
+	return nil
+}
+
+// RequiredXP 현재 레벨에서 다음 레벨까지 필요한 경험치
+func (c LevelCurve) RequiredXP(level int) int64 {
+	if level < 1 {
+		level = 1
+	}
+	return int64(math.Round(float64(c.BaseXP) * math.Pow(float64(level), c.Exponent)))
+}
+
+// LevelFor 누적 경험치로 레벨, 현재 레벨 내 경험치, 다음 레벨 필요 경험치 계산
+func (c LevelCurve) LevelFor(totalXP int64) (level int, experience int64, nextLevelExp int64) {
+	level = 1
+	experience = totalXP
+	for level < MaxLevel {
+		required := c.RequiredXP(level)
+		if required <= 0 || experience < required {
+			return level, experience, required
+		}
+		experience -= required
+		level++
+	}
+	return level, experience, c.RequiredXP(level)
+}
+
+// GamificationProgress 클라이언트별 게이미피케이션 누적 진행도 (영속 저장 대상)
+// 레벨은 저장하지 않고 누적 경험치와 레벨 곡선으로 계산 (곡선 변경 시 자동 재계산)
+type GamificationProgress struct {
+	ClientID     string        // 클라이언트 식별자
+	TotalXP      int64         // 누적 경험치
+	FocusedTime  time.Duration // 누적 집중(FOCUSING) 시간
+	ThinkingTime time.Duration // 누적 생각(THINKING) 시간
+	ScoreSum     int64         // 점수 합계 (평균 점수 계산용)
+	ScoreCount   int64         // 점수 산정 횟수
+	UpdatedAt    time.Time     // 마지막 갱신 시간
+}
+
+// NewGamificationProgress 새 진행도 생성
+func NewGamificationProgress(clientID string) *GamificationProgress {
+	return &GamificationProgress{ClientID: clientID}
+}
+
+// RecordScore 점수 누적 (평균 점수 계산용)
+func (p *GamificationProgress) RecordScore(score int) {
+	p.ScoreSum += int64(score)
+	p.ScoreCount++
+}
+
+// TotalStudySeconds 누적 학습 시간 (집중 + 생각, 초)
+func (p *GamificationProgress) TotalStudySeconds() int64 {
+	return int64((p.FocusedTime + p.ThinkingTime) / time.Second)
+}
+
+// AverageScore 평균 점수
+func (p *GamificationProgress) AverageScore() float64 {
+	if p.ScoreCount == 0 {
+		return 0
+	}
+	return float64(p.ScoreSum) / float64(p.ScoreCount)
+}
+
+// Status 레벨 곡선을 적용한 조회용 상태
+func (p *GamificationProgress) Status(curve LevelCurve) GamificationStatus {
+	level, experience, nextLevelExp := curve.LevelFor(p.TotalXP)
+	return GamificationStatus{
+		ClientID:          p.ClientID,
+		Level:             level,
+		Experience:        experience,
+		NextLevelExp:      nextLevelExp,
+		TotalXP:           p.TotalXP,
+		TotalStudySeconds: p.TotalStudySeconds(),
+		AverageScore:      p.AverageScore(),
+	}
+}
+
+// GamificationStatus GetGamificationInfo 응답용 게이미피케이션 상태
+type GamificationStatus struct {
+	ClientID          string        // 클라이언트 식별자
+	Level             int           // 현재 레벨
+	Experience        int64         // 현재 레벨 내 경험치
+	NextLevelExp      int64         // 다음 레벨까지 필요한 경험치
+	TotalXP           int64         // 누적 경험치
+	TotalStudySeconds int64         // 누적 학습 시간 (초)
+	AverageScore      float64       // 평균 점수
+	Achievements      []Achievement // 업적 달성 현황
+	Streak            *StreakStatus // 하루 목표 연속 달성 현황 (미사용 시 nil)
+}
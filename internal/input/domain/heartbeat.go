@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// MaxKeyboardEntropy 키보드 엔트로피 최댓값 (ClientHeartbeat.keyboard_entropy 범위 0.0 ~ 5.0)
+const MaxKeyboardEntropy = 5.0
+
+// Heartbeat 클라이언트가 1초마다 전송하는 센서 데이터
+// SyncClient 스트림의 ClientHeartbeat를 도메인으로 옮긴 형태
+type Heartbeat struct {
+	ClientID           string    // 클라이언트 식별자
+	MouseDistance      int       // 1초간 마우스 이동 거리 합
+	ClickCount         int       // 1초간 클릭 수
+	KeystrokeCount     int       // 1초간 키 입력 수
+	IsOSIdle           bool      // OS 유휴 상태 여부
+	IsEyesClosed       bool      // 눈 감음 여부
+	ConcentrationScore float64   // 비전 모델 집중도 (0.0 ~ 1.0)
+	KeyboardEntropy    float64   // 키보드 엔트로피 (0.0 ~ 5.0)
+	ActiveWindowTitle  string    // 활성 창 제목
+	IsDragging         bool      // 마우스 드래그 여부
+	AvgDwellTime       float64   // 평균 키 누름 시간 (ms)
+	Timestamp          time.Time // 수신 시간
+}
+
+// OSActivityCount 키보드+마우스 입력 횟수
+// 마우스 이동(드래그 포함)은 거리와 무관하게 1회로 계산
+func (h *Heartbeat) OSActivityCount() int {
+	count := h.KeystrokeCount + h.ClickCount
+	if h.MouseDistance > 0 || h.IsDragging {
+		count++
+	}
+	return count
+}
+
+// VisionScore 비전 집중도를 0-100 점수로 변환
+func (h *Heartbeat) VisionScore() int {
+	score := int(h.ConcentrationScore*100 + 0.5)
+	if score < 0 {
+		return 0
+	}
+	if score > 100 {
+		return 100
+	}
+	return score
+}
@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// LeaderboardPeriod 리더보드 집계 기간
+type LeaderboardPeriod string
+
+const (
+	LeaderboardDaily   LeaderboardPeriod = "daily"    // 오늘
+	LeaderboardWeekly  LeaderboardPeriod = "weekly"   // 이번 주 (월요일 시작)
+	LeaderboardAllTime LeaderboardPeriod = "all_time" // 누적 (게이미피케이션 진행도)
+)
+
+// ParseLeaderboardPeriod 기간 문자열 파싱 (비어 있으면 daily)
+func ParseLeaderboardPeriod(value string) (LeaderboardPeriod, error) {
+	switch period := LeaderboardPeriod(strings.ToLower(value)); period {
+	case "":
+		return LeaderboardDaily, nil
+	case LeaderboardDaily, LeaderboardWeekly, LeaderboardAllTime:
+		return period, nil
+	default:
+		return "", fmt.Errorf("invalid leaderboard period %q (daily | weekly | all_time)", value)
+	}
+}
+
+// LeaderboardMetric 리더보드 순위 기준
+type LeaderboardMetric string
+
+const (
+	MetricFocusedTime  LeaderboardMetric = "focused_time"  // 집중 시간 (FOCUSING + THINKING)
+	MetricAverageScore LeaderboardMetric = "average_score" // 평균 점수
+)
+
+// ParseLeaderboardMetric 순위 기준 문자열 파싱 (비어 있으면 focused_time)
+func ParseLeaderboardMetric(value string) (LeaderboardMetric, error) {
+	switch metric := LeaderboardMetric(strings.ToLower(value)); metric {
+	case "":
+		return MetricFocusedTime, nil
+	case MetricFocusedTime, MetricAverageScore:
+		return metric, nil
+	default:
+		return "", fmt.Errorf("invalid leaderboard metric %q (focused_time | average_score)", value)
+	}
+}
+
+// LeaderboardMember 클라이언트의 스터디 그룹 소속과 공개 설정
+type LeaderboardMember struct {
+	ClientID    string
+	GroupID     string    // 스터디 그룹 (비어 있으면 어느 리더보드에도 표시되지 않음)
+	DisplayName string    // 리더보드 표시 이름 (비어 있으면 ClientID)
+	OptOut      bool      // 리더보드 비공개
+	UpdatedAt   time.Time // 마지막 변경 시간
+}
+
+// Validate 소속 정보 검증
+func (m LeaderboardMember) Validate() error {
+	if m.ClientID == "" {
+		return errors.New("client_id is required")
+	}
+	if len(m.DisplayName) > 64 {
+		return errors.New("display_name must be at most 64 bytes")
+	}
+	return nil
+}
+
+// Name 리더보드 표시 이름
+func (m LeaderboardMember) Name() string {
+	if m.DisplayName != "" {
+		return m.DisplayName
+	}
+	return m.ClientID
+}
+
+// Visible 그룹 리더보드 표시 여부
+func (m LeaderboardMember) Visible(groupID string) bool {
+	return m.GroupID != "" && m.GroupID == groupID && !m.OptOut
+}
+
+// DailyStudyStats 클라이언트의 하루 집중 시간/점수 합계 (영속 저장 대상)
+// Day는 리더보드 기준 시간대의 YYYY-MM-DD
+type DailyStudyStats struct {
+	ClientID    string
+	Day         string
+	FocusedTime time.Duration // 집중(FOCUSING + THINKING) 시간
+	ScoreSum    int64         // 점수 합계 (평균 점수 계산용)
+	ScoreCount  int64         // 점수 산정 횟수
+}
+
+// Add 다른 기록 합산 (기간 집계용)
+func (s *DailyStudyStats) Add(other DailyStudyStats) {
+	s.FocusedTime += other.FocusedTime
+	s.ScoreSum += other.ScoreSum
+	s.ScoreCount += other.ScoreCount
+}
+
+// AverageScore 평균 점수
+func (s DailyStudyStats) AverageScore() float64 {
+	if s.ScoreCount == 0 {
+		return 0
+	}
+	return float64(s.ScoreSum) / float64(s.ScoreCount)
+}
+
+// LeaderboardEntry 리더보드 한 줄
+type LeaderboardEntry struct {
+	Rank           int
+	ClientID       string
+	DisplayName    string
+	FocusedSeconds int64
+	AverageScore   float64
+}
+
+// Leaderboard 그룹 리더보드
+type Leaderboard struct {
+	GroupID string
+	Period  LeaderboardPeriod
+	Metric  LeaderboardMetric
+	From    time.Time // 집계 시작 (all_time이면 zero)
+	To      time.Time // 집계 끝 (all_time이면 zero)
+	Entries []LeaderboardEntry
+}
+
+// RankLeaderboard 순위 기준으로 정렬하고 순위 부여 (동점은 같은 순위, limit > 0이면 상위 limit개)
+func RankLeaderboard(entries []LeaderboardEntry, metric LeaderboardMetric, limit int) []LeaderboardEntry {
+	value := func(entry LeaderboardEntry) float64 {
+		if metric == MetricAverageScore {
+			return entry.AverageScore
+		}
+		return float64(entry.FocusedSeconds)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		vi, vj := value(entries[i]), value(entries[j])
+		if vi != vj {
+			return vi > vj
+		}
+		return entries[i].ClientID < entries[j].ClientID
+	})
+
+	for i := range entries {
+		if i > 0 && value(entries[i]) == value(entries[i-1]) {
+			entries[i].Rank = entries[i-1].Rank
+		} else {
+			entries[i].Rank = i + 1
+		}
+	}
+
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
@@ -0,0 +1,86 @@
+package domain
+
+import "errors"
+
+// LockdownMessage 시험 모드에서 허용되지 않은 앱을 닫을 때 메시지
+const LockdownMessage = "시험 모드에서는 허용된 앱만 사용할 수 있습니다."
+
+// Lockdown 허용 목록 전용 모드 (시험 등)
+// 켜진 그룹/클라이언트는 평소 정책 대신 허용 목록에 있는 도메인/앱만 쓸 수 있고
+// 나머지 URL은 BLOCK_URL, 앱은 CLOSE_APP (창 제목 규칙은 평가하지 않음)
+type Lockdown struct {
+	AllowedURLs []URLRule     // 허용 도메인/경로 (Allow 규칙)
+	AllowedApps []PatternRule // 허용 앱 이름 패턴 (Allow 규칙)
+	Meta        RuleMetadata  // 누가, 언제, 왜 켰는지
+}
+
+// NewLockdown 허용 목록 검증 후 생성 (앱 패턴은 앱 이름 허용 규칙이어야 함)
+func NewLockdown(allowedURLs []URLRule, allowedApps []PatternRule) (Lockdown, error) {
+	lockdown := Lockdown{}
+	for _, rule := range allowedURLs {
+		rule.Allow = true
+		rule.Schedule = nil
+		lockdown.AllowedURLs = append(lockdown.AllowedURLs, rule)
+	}
+	for _, rule := range allowedApps {
+		if rule.Field != PatternFieldApp || !rule.Allow {
+			return Lockdown{}, errors.New("lockdown allowed apps must be app allow rules")
+		}
+		rule.Schedule = nil
+		lockdown.AllowedApps = append(lockdown.AllowedApps, rule)
+	}
+	return lockdown, nil
+}
+
+// AllowsURL 허용 목록에 있는 URL인지 (SplitURL로 나눈 호스트/경로)
+func (l *Lockdown) AllowsURL(host, path string) bool {
+	for _, rule := range l.AllowedURLs {
+		if rule.MatchesHost(host) && rule.MatchesPath(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsApp 허용 목록에 있는 앱인지
+func (l *Lockdown) AllowsApp(appName string) bool {
+	for _, rule := range l.AllowedApps {
+		if rule.Matches(appName) {
+			return true
+		}
+	}
+	return false
+}
+
+// lockdownBlockRule 허용되지 않은 앱에 적용할 규칙 (모든 앱 일치, CLOSE_APP 최고 강도)
+var lockdownBlockRule = func() PatternRule {
+	rule, _ := NewPatternRule(PatternFieldApp, PatternGlob, "*", ActionCloseApp, 0)
+	return rule.WithMessage(LockdownMessage)
+}()
+
+// BlockRule 허용되지 않은 앱에 적용할 규칙
+func (l *Lockdown) BlockRule() PatternRule {
+	return lockdownBlockRule
+}
+
+// Clone 깊은 복사
+func (l *Lockdown) Clone() *Lockdown {
+	if l == nil {
+		return nil
+	}
+	return &Lockdown{
+		AllowedURLs: append([]URLRule(nil), l.AllowedURLs...),
+		AllowedApps: append([]PatternRule(nil), l.AllowedApps...),
+		Meta:        l.Meta,
+	}
+}
+
+// activeLockdown 정책 체인(client → group → global)에서 가장 구체적인 단계의 시험 모드 (없으면 nil)
+func activeLockdown(policies []*BlacklistPolicy) *Lockdown {
+	for _, policy := range policies {
+		if policy != nil && policy.Lockdown != nil {
+			return policy.Lockdown
+		}
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// PatternField 패턴 규칙이 검사하는 값
+type PatternField string
+
+const (
+	PatternFieldApp         PatternField = "app"          // 앱 이름 (APP_OPEN/APP_CLOSE)
+	PatternFieldWindowTitle PatternField = "window_title" // 하트비트의 활성 창 제목
+)
+
+// PatternKind 패턴 문법
+type PatternKind string
+
+const (
+	PatternGlob  PatternKind = "glob"  // * (임의 문자열), ? (임의 한 글자), 전체 일치
+	PatternRegex PatternKind = "regex" // RE2 정규식, 부분 일치 (^$로 고정 가능)
+)
+
+// DefaultPatternIntensity 강도를 지정하지 않은 규칙의 강도 (기존 앱 차단과 동일한 최고 강도)
+const DefaultPatternIntensity = 10
+
+// PatternRule 앱 이름/창 제목 차단 규칙 (대소문자 무시)
+// 규칙마다 수행할 액션과 강도를 가짐 ("steam*" → CLOSE_APP 10, "*- YouTube*" → MINIMIZE_ALL 7)
+type PatternRule struct {
+	Field     PatternField
+	Kind      PatternKind
+	Pattern   string
+	Action    ActionType    // CLOSE_APP, MINIMIZE_ALL, BLOCK_URL
+	Intensity int           // 1-10
+	Message   string        // 사용자에게 표시할 메시지 (비어 있으면 기본 메시지)
+	Allow     bool          // 허용 규칙 (상위 정책의 차단을 무시, 액션/강도 없음)
+	Schedule  *RuleSchedule // 적용 시간 (nil이면 항상)
+	Meta      RuleMetadata  // 등록자/시각/사유
+	matcher   *regexp.Regexp
+}
+
+// NewPatternRule 패턴을 컴파일하여 규칙 생성
+// intensity가 0이면 DefaultPatternIntensity
+func NewPatternRule(field PatternField, kind PatternKind, pattern string, action ActionType, intensity int) (PatternRule, error) {
+	rule := PatternRule{
+		Field:     field,
+		Kind:      kind,
+		Pattern:   strings.TrimSpace(pattern),
+		Action:    action,
+		Intensity: intensity,
+	}
+	if err := rule.compile(); err != nil {
+		return PatternRule{}, err
+	}
+	return rule, nil
+}
+
+// NewAllowPatternRule 허용 규칙 생성 (그룹/클라이언트 정책에서 상위 차단을 무시할 때)
+func NewAllowPatternRule(field PatternField, kind PatternKind, pattern string) (PatternRule, error) {
+	rule := PatternRule{
+		Field:   field,
+		Kind:    kind,
+		Pattern: strings.TrimSpace(pattern),
+		Allow:   true,
+	}
+	if err := rule.compile(); err != nil {
+		return PatternRule{}, err
+	}
+	return rule, nil
+}
+
+// WithMessage 메시지 설정
+func (r PatternRule) WithMessage(message string) PatternRule {
+	r.Message = message
+	return r
+}
+
+// compile 규칙 검증 후 정규식 컴파일
+func (r *PatternRule) compile() error {
+	switch r.Field {
+	case PatternFieldApp, PatternFieldWindowTitle:
+	default:
+		return fmt.Errorf("unknown pattern field %q", r.Field)
+	}
+	if !r.Allow {
+		switch r.Action {
+		case ActionCloseApp, ActionMinimizeAll, ActionBlockURL:
+		default:
+			return fmt.Errorf("pattern rule action must be CLOSE_APP, MINIMIZE_ALL or BLOCK_URL, got %q", r.Action)
+		}
+		if r.Intensity == 0 {
+			r.Intensity = DefaultPatternIntensity
+		}
+		if r.Intensity < 1 || r.Intensity > 10 {
+			return fmt.Errorf("pattern rule intensity must be between 1 and 10, got %d", r.Intensity)
+		}
+	}
+	if r.Pattern == "" {
+		return fmt.Errorf("pattern must not be empty")
+	}
+
+	var expr string
+	switch r.Kind {
+	case PatternGlob:
+		expr = "(?i)^" + globToRegexp(r.Pattern) + "$"
+	case PatternRegex:
+		expr = "(?i)" + r.Pattern
+	default:
+		return fmt.Errorf("unknown pattern kind %q", r.Kind)
+	}
+	matcher, err := regexp.Compile(expr)
+	if err != nil {
+		return fmt.Errorf("invalid pattern %q: %w", r.Pattern, err)
+	}
+	r.matcher = matcher
+	return nil
+}
+
+// Key 규칙 식별자 (같은 대상/문법/패턴은 같은 규칙)
+func (r PatternRule) Key() string {
+	return string(r.Field) + ":" + string(r.Kind) + ":" + r.Pattern
+}
+
+// Matches 값이 패턴에 일치하는지 (대소문자 무시)
+func (r PatternRule) Matches(value string) bool {
+	value = strings.TrimSpace(value)
+	return r.matcher != nil && value != "" && r.matcher.MatchString(value)
+}
+
+// globToRegexp glob 패턴을 정규식으로 변환 (*, ? 외의 문자는 그대로 비교)
+func globToRegexp(glob string) string {
+	var b strings.Builder
+	for _, c := range glob {
+		switch c {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,75 @@
+package domain
+
+import "time"
+
+// ReflexThrottle 클라이언트·대상별 즉각 반응 억제 (쿨다운 + 중복 제거 창)
+// 같은 대상의 보고가 DedupWindow 간격 안에 이어지면 같은 사건(계속 머무는 중)으로 보고, 사건 중에는 Cooldown마다 한 번만 허용
+// 보고가 DedupWindow보다 오래 끊겼다가 다시 오면(나갔다가 다시 들어옴) 새 사건으로 즉시 허용
+// 둘 중 하나라도 0이면 억제하지 않음, 동시 접근은 호출자가 직렬화
+type ReflexThrottle struct {
+	Cooldown    time.Duration
+	DedupWindow time.Duration
+	targets     map[string]reflexTarget
+	lastPrune   time.Time
+}
+
+// reflexTarget 대상별 최근 보고/허용 시각
+type reflexTarget struct {
+	lastSeen time.Time
+	lastSent time.Time
+}
+
+// NewReflexThrottle ReflexThrottle 생성자
+func NewReflexThrottle(cooldown, dedupWindow time.Duration) *ReflexThrottle {
+	return &ReflexThrottle{
+		Cooldown:    cooldown,
+		DedupWindow: dedupWindow,
+		targets:     make(map[string]reflexTarget),
+	}
+}
+
+// ReflexTargetKey 억제 기준 키 (클라이언트 + 대상 종류 + 대상)
+func ReflexTargetKey(clientID, field, target string) string {
+	return clientID + "\x00" + field + ":" + target
+}
+
+// Allow at의 보고를 기록하고 반응을 보내도 되는지 반환 (허용하면 전송 시각으로 기록)
+func (t *ReflexThrottle) Allow(key string, at time.Time) bool {
+	if t.Cooldown <= 0 || t.DedupWindow <= 0 {
+		return true
+	}
+	t.prune(at)
+
+	target, exists := t.targets[key]
+	continuing := exists && at.Sub(target.lastSeen) <= t.DedupWindow
+	target.lastSeen = at
+	allowed := !continuing || at.Sub(target.lastSent) >= t.Cooldown
+	if allowed {
+		target.lastSent = at
+	}
+	t.targets[key] = target
+	return allowed
+}
+
+// Forget 대상 기록 삭제 (전송에 실패하면 다음 보고에서 다시 시도)
+func (t *ReflexThrottle) Forget(key string) {
+	delete(t.targets, key)
+}
+
+// Tracked 기록 중인 대상 수
+func (t *ReflexThrottle) Tracked() int {
+	return len(t.targets)
+}
+
+// prune 사건이 끝난(DedupWindow보다 오래 보고가 없는) 대상 정리 (DedupWindow마다 한 번)
+func (t *ReflexThrottle) prune(at time.Time) {
+	if at.Sub(t.lastPrune) < t.DedupWindow {
+		return
+	}
+	t.lastPrune = at
+	for key, target := range t.targets {
+		if at.Sub(target.lastSeen) > t.DedupWindow {
+			delete(t.targets, key)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RuleContext 차단 규칙 평가 기준 (활동 시각은 클라이언트 시간대로 변환된 값)
+type RuleContext struct {
+	ClientID       string
+	At             time.Time // 클라이언트 시간대 기준 활동 시각
+	InStudySession bool      // At이 선언한 학습 세션 안인지
+}
+
+// TimeWindow 요일별 시간대 (자정 기준 경과 시간)
+// End가 Start 이하이면 다음 날 End까지 (22:00-02:00), 둘이 같으면 하루 종일
+type TimeWindow struct {
+	Days  []time.Weekday // 시작 요일 (비어 있으면 매일)
+	Start time.Duration
+	End   time.Duration
+}
+
+// ParseTimeWindow "mon-fri 09:00-18:00", "sat,sun 10:00-12:00", "weekdays 22:00-02:00", "09:00-18:00"(매일)
+func ParseTimeWindow(spec string) (TimeWindow, error) {
+	fields := strings.Fields(spec)
+	var window TimeWindow
+	var clock string
+	switch len(fields) {
+	case 1:
+		clock = fields[0]
+	case 2:
+		days, err := parseWeekdays(fields[0])
+		if err != nil {
+			return TimeWindow{}, err
+		}
+		window.Days = days
+		clock = fields[1]
+	default:
+		return TimeWindow{}, fmt.Errorf("invalid time window %q (expected \"[days] HH:MM-HH:MM\")", spec)
+	}
+
+	start, end, found := strings.Cut(clock, "-")
+	if !found {
+		return TimeWindow{}, fmt.Errorf("invalid time window %q (expected \"[days] HH:MM-HH:MM\")", spec)
+	}
+	var err error
+	if window.Start, err = ParseClock(start); err != nil {
+		return TimeWindow{}, err
+	}
+	if window.End, err = ParseClock(end); err != nil {
+		return TimeWindow{}, err
+	}
+	return window, nil
+}
+
+// parseWeekdays "mon-fri", "sat,sun", "weekdays", "weekends", "daily"
+func parseWeekdays(value string) ([]time.Weekday, error) {
+	switch strings.ToLower(value) {
+	case "daily", "everyday":
+		return nil, nil
+	case "weekdays":
+		return []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}, nil
+	case "weekends":
+		return []time.Weekday{time.Saturday, time.Sunday}, nil
+	}
+
+	var days []time.Weekday
+	for _, part := range strings.Split(value, ",") {
+		from, to, isRange := strings.Cut(part, "-")
+		first, err := ParseWeekday(from)
+		if err != nil {
+			return nil, err
+		}
+		if !isRange {
+			days = append(days, first)
+			continue
+		}
+		last, err := ParseWeekday(to)
+		if err != nil {
+			return nil, err
+		}
+		// fri-mon처럼 주를 넘는 범위도 허용
+		for day := first; ; day = (day + 1) % 7 {
+			days = append(days, day)
+			if day == last {
+				break
+			}
+		}
+	}
+	return days, nil
+}
+
+// includes 요일 포함 여부 (Days가 비어 있으면 매일)
+func (w TimeWindow) includes(day time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, d := range w.Days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+// Contains at(클라이언트 시간대)이 시간대 안인지
+func (w TimeWindow) Contains(at time.Time) bool {
+	offset := at.Sub(StartOfDay(at))
+	switch {
+	case w.Start == w.End:
+		return w.includes(at.Weekday())
+	case w.Start < w.End:
+		return w.includes(at.Weekday()) && offset >= w.Start && offset < w.End
+	default:
+		// 자정을 넘는 시간대: 시작 요일 밤 또는 전날 시작분의 새벽
+		if offset >= w.Start {
+			return w.includes(at.Weekday())
+		}
+		return offset < w.End && w.includes((at.Weekday()+6)%7)
+	}
+}
+
+// String 시간대 표시 (mon,tue 09:00-18:00)
+func (w TimeWindow) String() string {
+	clock := formatClock(w.Start) + "-" + formatClock(w.End)
+	if len(w.Days) == 0 {
+		return clock
+	}
+	names := make([]string, len(w.Days))
+	for i, day := range w.Days {
+		names[i] = strings.ToLower(day.String()[:3])
+	}
+	return strings.Join(names, ",") + " " + clock
+}
+
+// formatClock 자정 기준 경과 시간을 HH:MM으로
+func formatClock(offset time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(offset.Hours()), int(offset.Minutes())%60)
+}
+
+// RuleSchedule 규칙이 적용되는 시간 (nil이면 항상)
+// Windows와 StudySessionsOnly를 함께 쓰면 둘 다 만족할 때만 적용
+type RuleSchedule struct {
+	Windows           []TimeWindow // 하나라도 포함하면 적용 (비어 있으면 시간 무관)
+	StudySessionsOnly bool         // 선언한 학습 세션 중에만 적용
+}
+
+// Active 평가 시점에 규칙이 적용되는지
+func (s *RuleSchedule) Active(ctx RuleContext) bool {
+	if s == nil {
+		return true
+	}
+	if s.StudySessionsOnly && !ctx.InStudySession {
+		return false
+	}
+	if len(s.Windows) == 0 {
+		return true
+	}
+	for _, window := range s.Windows {
+		if window.Contains(ctx.At) {
+			return true
+		}
+	}
+	return false
+}
+
+// String 일정 표시 (비어 있으면 "always")
+func (s *RuleSchedule) String() string {
+	if s == nil {
+		return "always"
+	}
+	parts := make([]string, 0, len(s.Windows)+1)
+	for _, window := range s.Windows {
+		parts = append(parts, window.String())
+	}
+	if s.StudySessionsOnly {
+		parts = append(parts, "study sessions")
+	}
+	if len(parts) == 0 {
+		return "always"
+	}
+	return strings.Join(parts, "; ")
+}
+
+// NewRuleSchedule 시간대 문자열 목록으로 일정 생성 (둘 다 비어 있으면 nil = 항상)
+func NewRuleSchedule(windowSpecs []string, studySessionsOnly bool) (*RuleSchedule, error) {
+	if len(windowSpecs) == 0 && !studySessionsOnly {
+		return nil, nil
+	}
+	schedule := &RuleSchedule{StudySessionsOnly: studySessionsOnly}
+	for _, spec := range windowSpecs {
+		window, err := ParseTimeWindow(spec)
+		if err != nil {
+			return nil, err
+		}
+		schedule.Windows = append(schedule.Windows, window)
+	}
+	return schedule, nil
+}
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// DefaultScoreModelVersion 기본(내장) 점수 모델 버전
+const DefaultScoreModelVersion = "builtin-v1"
+
+// ScoreModel 점수 산정 알고리즘의 가중치/임계값 묶음
+// 코호트별 튜닝을 위해 설정 파일로 교체 가능하며, Version으로 산정 결과를 추적
+type ScoreModel struct {
+	Version string // 모델 버전 (산정 결과에 기록)
+
+	// 1단계: 졸음 감지
+	SleepEyesClosedSec float64 // 눈 감음 지속 시간 임계값 (초)
+	SleepHeadPitch     float64 // 고개 숙임 임계값 (이 값 미만이면 졸음)
+
+	// 유해 사이트
+	DistractedScore int // PLAY 카테고리 접속 시 점수
+
+	// 2단계: 생각 모드 보호
+	ThinkingVisionMin int     // 시선 집중도 하한 (초과해야 함)
+	ThinkingHeadPitch float64 // 고개 각도 하한 (초과해야 함)
+	ThinkingScore     int     // 생각 모드 고정 점수
+
+	// 3단계: 일반 집중
+	VisionWeight   float64 // 시선 가중치
+	OSWeight       float64 // OS 활동 가중치
+	PointsPerInput float64 // 입력 1회당 OS 정규화 점수
+
+	// 4단계: 멍 때리기
+	IdleVisionMax  int // 시선 집중도 상한 (미만이면 멍 때리기)
+	DecayPerSecond int // 초당 감점
+
+	// 타이핑 품질 (일반 집중 단계에서 키보드 엔트로피/Dwell로 보정)
+	TypingMinKeystrokes int     // 판정에 필요한 초당 최소 키 입력 수
+	MashEntropyMax      float64 // 엔트로피 상한 (미만이면 키 난타 - 비생산 입력)
+	SteadyEntropyMin    float64 // 엔트로피 하한 (이상이면 안정적인 타이핑)
+	SteadyDwellMinMs    float64 // 안정적인 타이핑의 평균 키 누름 시간 하한 (ms)
+	SteadyDwellMaxMs    float64 // 안정적인 타이핑의 평균 키 누름 시간 상한 (ms)
+	TypingBonus         int     // 안정적인 타이핑 가점
+	MashPenalty         int     // 키 난타 감점
+}
+
+// DefaultScoreModel 기본 점수 모델 (JIAA Algorithm 초기값)
+func DefaultScoreModel() ScoreModel {
+	return ScoreModel{
+		Version:            DefaultScoreModelVersion,
+		SleepEyesClosedSec: 3.0,
+		SleepHeadPitch:     -20.0,
+		DistractedScore:    10,
+		ThinkingVisionMin:  70,
+		ThinkingHeadPitch:  -10.0,
+		ThinkingScore:      90,
+		VisionWeight:       0.6,
+		OSWeight:           0.4,
+		PointsPerInput:     20.0,
+		IdleVisionMax:      50,
+		DecayPerSecond:     5,
+
+		TypingMinKeystrokes: 3,
+		MashEntropyMax:      1.5,
+		SteadyEntropyMin:    2.5,
+		SteadyDwellMinMs:    40,
+		SteadyDwellMaxMs:    200,
+		TypingBonus:         10,
+		MashPenalty:         10,
+	}
+}
+
+// Validate 모델 값 검증
+func (m ScoreModel) Validate() error {
+	if m.Version == "" {
+		return errors.New("score model: version is required")
+	}
+	if m.SleepEyesClosedSec <= 0 {
+		return fmt.Errorf("score model %s: sleep eyes-closed threshold must be positive, got %v", m.Version, m.SleepEyesClosedSec)
+	}
+	if m.SleepHeadPitch >= m.ThinkingHeadPitch {
+		return fmt.Errorf("score model %s: sleep head pitch (%v) must be below thinking head pitch (%v)",
+			m.Version, m.SleepHeadPitch, m.ThinkingHeadPitch)
+	}
+	if m.VisionWeight < 0 || m.OSWeight < 0 {
+		return fmt.Errorf("score model %s: weights must not be negative", m.Version)
+	}
+	if math.Abs(m.VisionWeight+m.OSWeight-1.0) > 0.001 {
+		return fmt.Errorf("score model %s: vision weight + os weight must be 1.0, got %v", m.Version, m.VisionWeight+m.OSWeight)
+	}
+	if m.PointsPerInput <= 0 {
+		return fmt.Errorf("score model %s: points per input must be positive, got %v", m.Version, m.PointsPerInput)
+	}
+	if m.DecayPerSecond < 0 {
+		return fmt.Errorf("score model %s: decay per second must not be negative, got %d", m.Version, m.DecayPerSecond)
+	}
+	if m.IdleVisionMax > m.ThinkingVisionMin {
+		return fmt.Errorf("score model %s: idle vision max (%d) must not exceed thinking vision min (%d)",
+			m.Version, m.IdleVisionMax, m.ThinkingVisionMin)
+	}
+	if m.TypingMinKeystrokes < 1 {
+		return fmt.Errorf("score model %s: typing min keystrokes must be at least 1, got %d", m.Version, m.TypingMinKeystrokes)
+	}
+	if m.MashEntropyMax < 0 || m.SteadyEntropyMin > MaxKeyboardEntropy || m.MashEntropyMax >= m.SteadyEntropyMin {
+		return fmt.Errorf("score model %s: mash entropy max (%v) must be below steady entropy min (%v) within 0-%v",
+			m.Version, m.MashEntropyMax, m.SteadyEntropyMin, MaxKeyboardEntropy)
+	}
+	if m.SteadyDwellMinMs < 0 || m.SteadyDwellMinMs >= m.SteadyDwellMaxMs {
+		return fmt.Errorf("score model %s: steady dwell range [%v, %v] ms is invalid",
+			m.Version, m.SteadyDwellMinMs, m.SteadyDwellMaxMs)
+	}
+	for name, v := range map[string]int{
+		"distracted score":    m.DistractedScore,
+		"thinking score":      m.ThinkingScore,
+		"thinking vision min": m.ThinkingVisionMin,
+		"idle vision max":     m.IdleVisionMax,
+		"typing bonus":        m.TypingBonus,
+		"mash penalty":        m.MashPenalty,
+	} {
+		if v < 0 || v > 100 {
+			return fmt.Errorf("score model %s: %s must be within 0-100, got %d", m.Version, name, v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Resolution 점수 이력 해상도
+type Resolution string
+
+const (
+	ResolutionSecond Resolution = "1s" // 원본 (하트비트 단위)
+	ResolutionMinute Resolution = "1m" // 1분 다운샘플
+	ResolutionHour   Resolution = "1h" // 1시간 다운샘플
+)
+
+// ParseResolution 문자열을 Resolution으로 변환
+func ParseResolution(s string) (Resolution, error) {
+	switch Resolution(s) {
+	case ResolutionSecond, ResolutionMinute, ResolutionHour:
+		return Resolution(s), nil
+	default:
+		return "", fmt.Errorf("unsupported resolution: %q (use 1s, 1m, 1h)", s)
+	}
+}
+
+// Duration 해상도 버킷 크기
+func (r Resolution) Duration() time.Duration {
+	switch r {
+	case ResolutionMinute:
+		return time.Minute
+	case ResolutionHour:
+		return time.Hour
+	default:
+		return time.Second
+	}
+}
+
+// ScoreSample 점수 이력 한 점 (원본 또는 다운샘플 버킷)
+type ScoreSample struct {
+	Timestamp time.Time // 버킷 시작 시간 (원본은 산정 시간)
+	AvgScore  float64   // 평균 점수
+	MinScore  int       // 최저 점수
+	MaxScore  int       // 최고 점수
+	State     string    // 대표 상태 (버킷 내 최빈 상태)
+	Count     int       // 포함된 원본 샘플 수
+}
+
+// NewScoreSample 스냅샷으로 원본 샘플 생성
+func NewScoreSample(snapshot ScoreSnapshot) ScoreSample {
+	return ScoreSample{
+		Timestamp: snapshot.Timestamp,
+		AvgScore:  float64(snapshot.Score),
+		MinScore:  snapshot.Score,
+		MaxScore:  snapshot.Score,
+		State:     snapshot.State,
+		Count:     1,
+	}
+}
+
+// DownsampleScores 샘플들을 하나의 버킷으로 합침
+// 평균은 원본 샘플 수로 가중, 상태는 원본 샘플 수 기준 최빈값
+func DownsampleScores(bucketStart time.Time, samples []ScoreSample) ScoreSample {
+	if len(samples) == 0 {
+		return ScoreSample{Timestamp: bucketStart}
+	}
+
+	result := ScoreSample{
+		Timestamp: bucketStart,
+		MinScore:  samples[0].MinScore,
+		MaxScore:  samples[0].MaxScore,
+	}
+
+	var weightedSum float64
+	stateCounts := make(map[string]int)
+	for _, sample := range samples {
+		weightedSum += sample.AvgScore * float64(sample.Count)
+		result.Count += sample.Count
+		if sample.MinScore < result.MinScore {
+			result.MinScore = sample.MinScore
+		}
+		if sample.MaxScore > result.MaxScore {
+			result.MaxScore = sample.MaxScore
+		}
+		stateCounts[sample.State] += sample.Count
+		if stateCounts[sample.State] > stateCounts[result.State] {
+			result.State = sample.State
+		}
+	}
+
+	if result.Count > 0 {
+		result.AvgScore = weightedSum / float64(result.Count)
+	}
+	return result
+}
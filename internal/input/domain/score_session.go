@@ -0,0 +1,79 @@
+package domain
+
+import "time"
+
+// HeartbeatInterval 클라이언트 하트비트 전송 주기
+// 하트비트 1회는 직전 1초 동안의 센서 상태를 대표
+const HeartbeatInterval = time.Second
+
+// activityWindowSize OS 활동량 평활화 구간 (하트비트 수)
+const activityWindowSize = 3
+
+// ScoreSession 클라이언트별 점수 산정 세션
+// SyncClient 스트림이 살아 있는 동안 하트비트를 누적하여 점수 계산 입력을 만든다
+type ScoreSession struct {
+	ClientID        string        // 클라이언트 식별자
+	StartedAt       time.Time     // 세션 시작 시간 (첫 하트비트)
+	LastHeartbeatAt time.Time     // 마지막 하트비트 시간
+	HeartbeatCount  int           // 누적 하트비트 수
+	EyesClosedSince time.Time     // 눈을 연속으로 감기 시작한 시간 (뜨고 있으면 zero)
+	ActivityWindow  []int         // 최근 OS 활동량 (최대 activityWindowSize개)
+	Latest          ScoreSnapshot // 직전 산정 결과
+}
+
+// NewScoreSession ScoreSession 생성자
+func NewScoreSession(clientID string, startedAt time.Time) *ScoreSession {
+	return &ScoreSession{
+		ClientID:       clientID,
+		StartedAt:      startedAt,
+		ActivityWindow: make([]int, 0, activityWindowSize),
+	}
+}
+
+// Accumulate 하트비트를 세션에 누적
+func (s *ScoreSession) Accumulate(heartbeat Heartbeat) {
+	s.LastHeartbeatAt = heartbeat.Timestamp
+	s.HeartbeatCount++
+
+	// 눈 감음 연속 구간 추적
+	if heartbeat.IsEyesClosed {
+		if s.EyesClosedSince.IsZero() {
+			s.EyesClosedSince = heartbeat.Timestamp
+		}
+	} else {
+		s.EyesClosedSince = time.Time{}
+	}
+
+	// OS 활동량 슬라이딩 윈도우
+	if len(s.ActivityWindow) == activityWindowSize {
+		s.ActivityWindow = s.ActivityWindow[1:]
+	}
+	s.ActivityWindow = append(s.ActivityWindow, heartbeat.OSActivityCount())
+}
+
+// EyesClosedDuration 눈을 연속으로 감고 있는 시간
+// 첫 하트비트도 직전 1초를 대표하므로 HeartbeatInterval만큼 더함
+func (s *ScoreSession) EyesClosedDuration(now time.Time) time.Duration {
+	if s.EyesClosedSince.IsZero() {
+		return 0
+	}
+	return now.Sub(s.EyesClosedSince) + HeartbeatInterval
+}
+
+// StableOSActivityCount 최근 윈도우의 초당 평균 OS 활동량 (올림)
+// 한 박자 쉬는 타이핑 때문에 입력 없음(생각 모드)으로 튀지 않도록 평활화
+func (s *ScoreSession) StableOSActivityCount() int {
+	if len(s.ActivityWindow) == 0 {
+		return 0
+	}
+	total := 0
+	for _, count := range s.ActivityWindow {
+		total += count
+	}
+	return (total + len(s.ActivityWindow) - 1) / len(s.ActivityWindow)
+}
+
+// CurrentScore 직전 산정 점수 (첫 산정 전이면 0)
+func (s *ScoreSession) CurrentScore() int {
+	return s.Latest.Score
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+// ScoreSnapshot 특정 시점의 클라이언트 점수 상태
+// StreamScore가 Dev 3에게 0.1초마다 전송하는 값
+type ScoreSnapshot struct {
+	ClientID     string         // 클라이언트 식별자
+	Score        int            // 점수 (0-100)
+	State        string         // ScoreService 산정 상태 (THINKING, FOCUSING, SLEEPING 등)
+	Timestamp    time.Time      // 산정 시간
+	ModelVersion string         // 산정에 사용된 ScoreModel 버전
+	Breakdown    ScoreBreakdown // 센서별 기여 내역
+}
+
+// ScoreBreakdown 센서별 점수 기여 내역
+// 모든 산정 구간에서 각 항목의 합(Total)이 최종 점수와 같음
+// 직전 점수에서 출발하는 구간(졸음, 유해 사이트, 생각 모드, 멍 때리기, 현상 유지)은 Carried에 직전 점수를 두고
+// 센서 항목에 증감을 기록 (양수는 점수를 올린 기여, 음수는 점수를 깎은 원인, UI에서 "왜 떨어졌는지" 설명용)
+// 새로 산정하는 일반 집중 구간은 Carried가 0이고 센서 항목이 곧 점수 구성
+type ScoreBreakdown struct {
+	Carried  int // 직전 점수에서 이어진 몫
+	HeadPose int // 머리 자세 (고개 숙임 졸음 시 감점)
+	EyeFocus int // 눈 집중도 (시선 가중 점수, 눈 감음 졸음 시 감점)
+	Audio    int // 오디오
+	Activity int // 활동 (OS 입력 가중 점수, 유해 사이트 감점)
+	Idle     int // 유휴 (멍 때리기 감점)
+	Typing   int // 타이핑 품질 (안정적인 타이핑 가점, 키 난타 감점)
+}
+
+// Total 항목 합 (= 최종 점수)
+func (b ScoreBreakdown) Total() int {
+	return b.Carried + b.HeadPose + b.EyeFocus + b.Audio + b.Activity + b.Idle + b.Typing
+}
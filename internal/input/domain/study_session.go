@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+// MaxStudySessionLength 선언할 수 있는 학습 세션 최대 길이
+const MaxStudySessionLength = 24 * time.Hour
+
+// StudySession 클라이언트가 미리 선언한 학습 세션 (영속 저장 대상)
+// StudySessionsOnly 일정의 차단 규칙은 이 구간에서만 적용
+type StudySession struct {
+	ID       string // 클라이언트 내 식별자 (시작 시각 Unix 초)
+	ClientID string
+	Start    time.Time
+	End      time.Time
+	Label    string // 표시 이름 ("알고리즘 스터디" 등)
+}
+
+// NewStudySession 세션 생성 및 검증
+func NewStudySession(clientID string, start, end time.Time, label string) (StudySession, error) {
+	if clientID == "" {
+		return StudySession{}, errors.New("client id is required")
+	}
+	if !end.After(start) {
+		return StudySession{}, errors.New("study session must end after it starts")
+	}
+	if end.Sub(start) > MaxStudySessionLength {
+		return StudySession{}, errors.New("study session must not exceed 24h")
+	}
+	return StudySession{
+		ID:       strconv.FormatInt(start.Unix(), 10),
+		ClientID: clientID,
+		Start:    start,
+		End:      end,
+		Label:    label,
+	}, nil
+}
+
+// Contains at이 세션 구간 [Start, End) 안인지
+func (s StudySession) Contains(at time.Time) bool {
+	return !at.Before(s.Start) && at.Before(s.End)
+}
+
+// Overlaps 다른 세션과 겹치는지
+func (s StudySession) Overlaps(other StudySession) bool {
+	return s.Start.Before(other.End) && other.Start.Before(s.End)
+}
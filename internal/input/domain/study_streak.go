@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// MaxDailyGoal 하루 목표 상한
+const MaxDailyGoal = 24 * time.Hour
+
+// StudyStreak 클라이언트별 하루 집중 목표와 연속 달성 기록 (영속 저장 대상)
+// 날짜는 클라이언트 시간대 기준 YYYY-MM-DD
+type StudyStreak struct {
+	ClientID      string
+	DailyGoal     time.Duration // 하루 집중 목표
+	Timezone      string        // IANA 시간대 (비어 있으면 서버 기본 시간대)
+	Day           string        // 누적 중인 날짜
+	DayFocus      time.Duration // 당일 누적 집중 시간
+	CurrentStreak int           // LastMetDay로 끝나는 연속 달성 일수
+	BestStreak    int           // 최장 연속 달성 일수
+	LastMetDay    string        // 마지막으로 목표를 달성한 날짜
+	WarnedDay     string        // 마지막으로 목표 미달 경고를 보낸 날짜
+}
+
+// NewStudyStreak 새 기록 생성
+func NewStudyStreak(clientID string, dailyGoal time.Duration) *StudyStreak {
+	return &StudyStreak{ClientID: clientID, DailyGoal: dailyGoal}
+}
+
+// ValidateDailyGoal 하루 목표 검증
+func ValidateDailyGoal(goal time.Duration) error {
+	if goal <= 0 {
+		return errors.New("daily goal must be positive")
+	}
+	if goal > MaxDailyGoal {
+		return errors.New("daily goal must not exceed 24h")
+	}
+	return nil
+}
+
+// Location 기록의 시간대 (잘못된 값이면 fallback)
+func (s *StudyStreak) Location(fallback *time.Location) *time.Location {
+	if s.Timezone == "" {
+		return fallback
+	}
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return fallback
+	}
+	return loc
+}
+
+// AddFocus 당일 집중 시간 누적 (날짜가 바뀌면 새로 시작)
+// 이번 누적으로 오늘 목표를 처음 달성했으면 true
+func (s *StudyStreak) AddFocus(day string, focus time.Duration) bool {
+	s.rollover(day)
+	s.DayFocus += focus
+	if s.DailyGoal <= 0 || s.LastMetDay == day || s.DayFocus < s.DailyGoal {
+		return false
+	}
+
+	// 어제도 달성했으면 연속, 아니면 새로 시작
+	if s.LastMetDay == PreviousDay(day) {
+		s.CurrentStreak++
+	} else {
+		s.CurrentStreak = 1
+	}
+	if s.CurrentStreak > s.BestStreak {
+		s.BestStreak = s.CurrentStreak
+	}
+	s.LastMetDay = day
+	return true
+}
+
+// rollover 날짜가 바뀌면 당일 누적 초기화
+func (s *StudyStreak) rollover(day string) {
+	if s.Day != day {
+		s.Day = day
+		s.DayFocus = 0
+	}
+}
+
+// ActiveStreak 오늘 기준 유효한 연속 일수 (어제나 오늘 달성하지 못했으면 끊긴 것)
+func (s *StudyStreak) ActiveStreak(day string) int {
+	if s.LastMetDay == day || s.LastMetDay == PreviousDay(day) {
+		return s.CurrentStreak
+	}
+	return 0
+}
+
+// Status 기준 시간(now, 클라이언트 시간대)의 연속 달성 현황
+// warnBefore: 자정까지 이 시간 이하로 남았는데 목표 미달이면 위험
+func (s *StudyStreak) Status(now time.Time, warnBefore time.Duration) StreakStatus {
+	day := now.Format(DayLayout)
+	todayFocus := time.Duration(0)
+	if s.Day == day {
+		todayFocus = s.DayFocus
+	}
+
+	status := StreakStatus{
+		ClientID:      s.ClientID,
+		DailyGoal:     s.DailyGoal,
+		Timezone:      s.Timezone,
+		TodayFocus:    todayFocus,
+		GoalMetToday:  s.LastMetDay == day,
+		CurrentStreak: s.ActiveStreak(day),
+		BestStreak:    s.BestStreak,
+	}
+	if !status.GoalMetToday && s.DailyGoal > 0 {
+		status.Remaining = s.DailyGoal - todayFocus
+		untilMidnight := StartOfDay(now).AddDate(0, 0, 1).Sub(now)
+		status.AtRisk = untilMidnight <= warnBefore
+	}
+	return status
+}
+
+// StreakStatus 하루 목표 연속 달성 현황 (HTTP, GamificationResponse)
+type StreakStatus struct {
+	ClientID      string
+	DailyGoal     time.Duration // 하루 집중 목표
+	Timezone      string        // 날짜 기준 시간대
+	TodayFocus    time.Duration // 오늘 누적 집중 시간
+	Remaining     time.Duration // 오늘 목표까지 남은 시간
+	GoalMetToday  bool          // 오늘 목표 달성 여부
+	CurrentStreak int           // 현재 연속 달성 일수
+	BestStreak    int           // 최장 연속 달성 일수
+	AtRisk        bool          // 자정이 가까운데 목표 미달
+}
+
+// DayLayout 일 단위 기록의 날짜 형식
+const DayLayout = "2006-01-02"
+
+// PreviousDay 전날 (YYYY-MM-DD)
+func PreviousDay(day string) string {
+	t, err := time.Parse(DayLayout, day)
+	if err != nil {
+		return ""
+	}
+	return t.AddDate(0, 0, -1).Format(DayLayout)
+}
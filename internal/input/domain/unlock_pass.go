@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+// UnlockPass 차단 규칙 하나를 클라이언트 하나에게 잠시 풀어 주는 이용권 (영속 저장 대상)
+// 인터넷 강의처럼 차단된 사이트가 꼭 필요할 때 발급하며, ExpiresAt이 지나면 자동으로 효력이 없어짐
+type UnlockPass struct {
+	ID        string // 클라이언트 내 식별자 (발급 시각 Unix 나노초)
+	ClientID  string
+	Field     string // 풀어 주는 규칙의 대상: url, app, window_title
+	RuleKey   string // URL 규칙은 URLRule.String(), 앱/창 제목 규칙은 PatternRule.Key()
+	GrantedBy string
+	Reason    string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+	RevokedAt time.Time // 조기 회수 시각 (zero면 회수 안 됨)
+}
+
+// NewUnlockPass 이용권 생성 및 검증
+func NewUnlockPass(clientID, field, ruleKey string, issuedAt time.Time, duration time.Duration) (UnlockPass, error) {
+	if clientID == "" {
+		return UnlockPass{}, errors.New("client id is required")
+	}
+	switch field {
+	case RuleFieldURL, string(PatternFieldApp), string(PatternFieldWindowTitle):
+	default:
+		return UnlockPass{}, errors.New("unlock pass field must be url, app or window_title")
+	}
+	if ruleKey == "" {
+		return UnlockPass{}, errors.New("rule is required")
+	}
+	if duration <= 0 {
+		return UnlockPass{}, errors.New("unlock pass duration must be positive")
+	}
+	return UnlockPass{
+		ID:        strconv.FormatInt(issuedAt.UnixNano(), 10),
+		ClientID:  clientID,
+		Field:     field,
+		RuleKey:   ruleKey,
+		IssuedAt:  issuedAt,
+		ExpiresAt: issuedAt.Add(duration),
+	}, nil
+}
+
+// Duration 발급한 이용 시간
+func (p UnlockPass) Duration() time.Duration {
+	return p.ExpiresAt.Sub(p.IssuedAt)
+}
+
+// Active at에 효력이 있는지 (발급 ~ 만료 사이, 회수되지 않음)
+func (p UnlockPass) Active(at time.Time) bool {
+	if !p.RevokedAt.IsZero() && !at.Before(p.RevokedAt) {
+		return false
+	}
+	return !at.Before(p.IssuedAt) && at.Before(p.ExpiresAt)
+}
+
+// CoversURL URL 규칙 이용권이 rawURL을 풀어 주는지 (규칙이 차단하는 범위와 같음)
+func (p UnlockPass) CoversURL(rawURL string) bool {
+	if p.Field != RuleFieldURL {
+		return false
+	}
+	rule, err := ParseURLRule(p.RuleKey)
+	return err == nil && rule.Matches(rawURL)
+}
+
+// CoversRule 앱/창 제목 규칙 이용권이 rule을 풀어 주는지
+func (p UnlockPass) CoversRule(rule PatternRule) bool {
+	return p.Field == string(rule.Field) && p.RuleKey == rule.Key()
+}
+
+// HasBlockingRule 정책 체인에 이용권 대상 차단 규칙이 있는지 (허용 규칙은 제외)
+func HasBlockingRule(chain []ScopedPolicy, field, ruleKey string) bool {
+	for _, scoped := range chain {
+		if scoped.Policy == nil {
+			continue
+		}
+		if field == RuleFieldURL {
+			for _, rule := range scoped.Policy.URLRules {
+				if !rule.Allow && rule.String() == ruleKey {
+					return true
+				}
+			}
+			continue
+		}
+		for _, rule := range scoped.Policy.PatternRules {
+			if !rule.Allow && string(rule.Field) == field && rule.Key() == ruleKey {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// UnlockAuditAction 이용권 감사 기록 종류
+type UnlockAuditAction string
+
+const (
+	UnlockAuditGrant  UnlockAuditAction = "GRANT"  // 발급
+	UnlockAuditDeny   UnlockAuditAction = "DENY"   // 하루 한도 초과로 발급 거절
+	UnlockAuditUse    UnlockAuditAction = "USE"    // 이용권으로 차단을 건너뜀
+	UnlockAuditRevoke UnlockAuditAction = "REVOKE" // 조기 회수
+)
+
+// UnlockAuditEntry 이용권 감사 기록 (영속 저장 대상)
+type UnlockAuditEntry struct {
+	At       time.Time
+	ClientID string
+	Action   UnlockAuditAction
+	PassID   string // DENY는 비어 있음
+	RuleKey  string
+	Actor    string // 발급/회수한 사람 (USE는 비어 있음)
+	Target   string // USE: 실제로 접근한 URL/앱/창 제목
+	Detail   string // 사유, 거절 이유 등
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+// URLRule URL 차단 규칙 (도메인 + 선택적 경로 접두사)
+// "youtube.com"은 youtube.com과 모든 하위 도메인(m.youtube.com 등)을,
+// "youtube.com/shorts"는 그중 /shorts 경로만 차단
+type URLRule struct {
+	Domain     string        // 소문자, www. 제거
+	PathPrefix string        // "/shorts" (비어 있으면 도메인 전체)
+	Allow      bool          // 허용 규칙 (상위 정책의 차단을 무시)
+	Schedule   *RuleSchedule // 적용 시간 (nil이면 항상)
+	Meta       RuleMetadata  // 등록자/시각/사유
+}
+
+// ParseURLRule 규칙 문자열 파싱 ("youtube.com", "https://www.youtube.com/shorts/" 등)
+// com, co.kr처럼 공개 접미사 자체는 사실상 모든 사이트를 막으므로 거부
+func ParseURLRule(pattern string) (URLRule, error) {
+	host, path, ok := SplitURL(pattern)
+	if !ok || host == "" {
+		return URLRule{}, fmt.Errorf("invalid url rule %q", pattern)
+	}
+	if net.ParseIP(host) == nil {
+		if _, err := publicsuffix.EffectiveTLDPlusOne(host); err != nil {
+			return URLRule{}, fmt.Errorf("url rule %q must name a registrable domain", pattern)
+		}
+	}
+
+	rule := URLRule{Domain: host}
+	if path = strings.TrimRight(path, "/"); path != "" {
+		rule.PathPrefix = strings.ToLower(path)
+	}
+	return rule, nil
+}
+
+// String 규칙 문자열 (youtube.com/shorts)
+func (r URLRule) String() string {
+	return r.Domain + r.PathPrefix
+}
+
+// MatchesHost 호스트가 규칙 도메인이거나 그 하위 도메인인지 (라벨 경계 기준)
+// notyoutube.com, youtube.com.evil.net은 youtube.com에 일치하지 않음
+func (r URLRule) MatchesHost(host string) bool {
+	return host == r.Domain || strings.HasSuffix(host, "."+r.Domain)
+}
+
+// MatchesPath 경로가 규칙 경로 접두사에 해당하는지 (경로 구간 경계 기준, 대소문자 무시)
+// /shorts는 /shorts, /shorts/abc에 일치하고 /shortsfoo에는 일치하지 않음
+func (r URLRule) MatchesPath(path string) bool {
+	if r.PathPrefix == "" {
+		return true
+	}
+	path = strings.ToLower(path)
+	return path == r.PathPrefix || strings.HasPrefix(path, r.PathPrefix+"/")
+}
+
+// Matches URL이 규칙에 해당하는지 (쿼리와 프래그먼트는 보지 않음)
+func (r URLRule) Matches(rawURL string) bool {
+	host, path, ok := SplitURL(rawURL)
+	return ok && r.MatchesHost(host) && r.MatchesPath(path)
+}
+
+// SplitURL URL을 호스트(소문자, www. 와 포트 제거)와 경로로 분리 (스킴이 없어도 처리)
+func SplitURL(rawURL string) (host, path string, ok bool) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return "", "", false
+	}
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "http://" + rawURL
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", false
+	}
+	host = strings.TrimSuffix(strings.ToLower(parsed.Hostname()), ".")
+	return strings.TrimPrefix(host, "www."), parsed.Path, true
+}
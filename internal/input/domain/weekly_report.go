@@ -0,0 +1,243 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ParseWeekday 요일 이름 파싱 (sunday, Sun 등 대소문자 무시)
+func ParseWeekday(value string) (time.Weekday, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if value == name || value == name[:3] {
+			return day, nil
+		}
+	}
+	return time.Sunday, fmt.Errorf("invalid weekday %q", value)
+}
+
+// ParseClock HH:MM 시각을 자정 기준 경과 시간으로 파싱
+func ParseClock(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q (HH:MM)", value)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// DailyEventLog 클라이언트의 하루 활동 이벤트 집계 (영속 저장 대상)
+type DailyEventLog struct {
+	ClientID string
+	Day      string                    // YYYY-MM-DD
+	Counts   map[ProgressEventType]int // 이벤트 유형별 횟수
+	Targets  map[string]int            // 차단 대상별 시도 횟수 (BLOCK_URL, CLOSE_APP, MINIMIZE_ALL)
+}
+
+// NewDailyEventLog 빈 집계 생성
+func NewDailyEventLog(clientID, day string) *DailyEventLog {
+	return &DailyEventLog{
+		ClientID: clientID,
+		Day:      day,
+		Counts:   make(map[ProgressEventType]int),
+		Targets:  make(map[string]int),
+	}
+}
+
+// Add 이벤트 한 건 누적
+func (l *DailyEventLog) Add(event ProgressEvent) {
+	l.Counts[event.Type]++
+	if event.Target != "" && event.Type.IsBlock() {
+		l.Targets[event.Target]++
+	}
+}
+
+// DistractionStat 차단 대상별 시도 횟수
+type DistractionStat struct {
+	Target   string
+	Attempts int
+}
+
+// WeeklyReport 주간 학습 리포트
+type WeeklyReport struct {
+	ClientID            string
+	WeekStart           time.Time         // 월요일 00:00
+	Days                []DailyStudyStats // 월~일 하루 기록 (기록 없는 날은 0)
+	PreviousWeek        DailyStudyStats   // 지난주 합계 (추이 비교용)
+	Distractions        []DistractionStat // 차단 시도가 많은 대상
+	TopUsage            []UsageStat       // 체류 시간이 긴 대상
+	BlockedURLs         int               // URL 차단 횟수
+	ClosedApps          int               // 앱 종료 횟수
+	MinimizedWindows    int               // 창 최소화 횟수
+	Emergencies         int               // 응급 상황 발생 횟수
+	EmergenciesResolved int               // 응급 상황 해결 횟수
+}
+
+// NewWeeklyReport 주간 기록으로 리포트 구성 (topN: 방해 요소/체류 대상 표시 개수)
+func NewWeeklyReport(clientID string, weekStart time.Time, days, previousWeek []DailyStudyStats, logs []DailyEventLog, usage []UsageStat, topN int) WeeklyReport {
+	report := WeeklyReport{
+		ClientID:     clientID,
+		WeekStart:    weekStart,
+		Days:         make([]DailyStudyStats, 7),
+		PreviousWeek: DailyStudyStats{ClientID: clientID},
+	}
+
+	index := make(map[string]int, 7)
+	for i := range report.Days {
+		day := weekStart.AddDate(0, 0, i).Format(DayLayout)
+		report.Days[i] = DailyStudyStats{ClientID: clientID, Day: day}
+		index[day] = i
+	}
+	for _, stats := range days {
+		if i, ok := index[stats.Day]; ok {
+			report.Days[i].Add(stats)
+		}
+	}
+	for _, stats := range previousWeek {
+		report.PreviousWeek.Add(stats)
+	}
+
+	targets := make(map[string]int)
+	for _, eventLog := range logs {
+		if _, ok := index[eventLog.Day]; !ok {
+			continue
+		}
+		report.BlockedURLs += eventLog.Counts[ProgressEventBlockURL]
+		report.ClosedApps += eventLog.Counts[ProgressEventCloseApp]
+		report.MinimizedWindows += eventLog.Counts[ProgressEventMinimizeAll]
+		report.Emergencies += eventLog.Counts[ProgressEventEmergency]
+		report.EmergenciesResolved += eventLog.Counts[ProgressEventEmergencyResolved]
+		for target, count := range eventLog.Targets {
+			targets[target] += count
+		}
+	}
+	for target, attempts := range targets {
+		report.Distractions = append(report.Distractions, DistractionStat{Target: target, Attempts: attempts})
+	}
+	sort.Slice(report.Distractions, func(i, j int) bool {
+		if report.Distractions[i].Attempts != report.Distractions[j].Attempts {
+			return report.Distractions[i].Attempts > report.Distractions[j].Attempts
+		}
+		return report.Distractions[i].Target < report.Distractions[j].Target
+	})
+	if topN > 0 && len(report.Distractions) > topN {
+		report.Distractions = report.Distractions[:topN]
+	}
+
+	report.TopUsage = usage
+	if topN > 0 && len(report.TopUsage) > topN {
+		report.TopUsage = report.TopUsage[:topN]
+	}
+	return report
+}
+
+// Totals 이번 주 합계
+func (r WeeklyReport) Totals() DailyStudyStats {
+	totals := DailyStudyStats{ClientID: r.ClientID}
+	for _, day := range r.Days {
+		totals.Add(day)
+	}
+	return totals
+}
+
+// BlockedAttempts 차단 시도 횟수 (URL + 앱 + 창 최소화)
+func (r WeeklyReport) BlockedAttempts() int {
+	return r.BlockedURLs + r.ClosedApps + r.MinimizedWindows
+}
+
+// weekdayLabels 월요일부터의 요일 표시
+var weekdayLabels = []string{"월", "화", "수", "목", "금", "토", "일"}
+
+// Markdown 리포트 본문 (SendAIResult / 다운로드용)
+func (r WeeklyReport) Markdown() string {
+	var b strings.Builder
+	totals := r.Totals()
+	weekEnd := r.WeekStart.AddDate(0, 0, 6)
+
+	b.WriteString("# 📊 주간 학습 리포트\n\n")
+	fmt.Fprintf(&b, "**기간:** %s ~ %s\n\n", r.WeekStart.Format(DayLayout), weekEnd.Format(DayLayout))
+
+	b.WriteString("## ⏱️ 총 집중 시간\n\n")
+	focusSeconds := int64(totals.FocusedTime / time.Second)
+	fmt.Fprintf(&b, "**%s** (지난주 대비 %s)\n\n", FormatDwell(focusSeconds),
+		formatDwellDelta(focusSeconds-int64(r.PreviousWeek.FocusedTime/time.Second)))
+
+	b.WriteString("## 📈 점수 추이\n\n")
+	b.WriteString("| 요일 | 날짜 | 집중 시간 | 평균 점수 |\n")
+	b.WriteString("|------|------|-----------|-----------|\n")
+	for i, day := range r.Days {
+		score := "-"
+		if day.ScoreCount > 0 {
+			score = fmt.Sprintf("%.1f", day.AverageScore())
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", weekdayLabels[i], day.Day,
+			FormatDwell(int64(day.FocusedTime/time.Second)), score)
+	}
+	b.WriteString("\n")
+	switch {
+	case totals.ScoreCount == 0:
+		b.WriteString("이번 주 점수 기록이 없습니다.\n\n")
+	case r.PreviousWeek.ScoreCount == 0:
+		fmt.Fprintf(&b, "주간 평균 점수 **%.1f점**\n\n", totals.AverageScore())
+	default:
+		delta := totals.AverageScore() - r.PreviousWeek.AverageScore()
+		fmt.Fprintf(&b, "주간 평균 점수 **%.1f점** (지난주 %.1f점, %s)\n\n",
+			totals.AverageScore(), r.PreviousWeek.AverageScore(), formatScoreDelta(delta))
+	}
+
+	b.WriteString("## 🚫 차단 시도\n\n")
+	fmt.Fprintf(&b, "총 **%d회** (URL 차단 %d회, 앱 종료 %d회", r.BlockedAttempts(), r.BlockedURLs, r.ClosedApps)
+	if r.MinimizedWindows > 0 {
+		fmt.Fprintf(&b, ", 창 최소화 %d회", r.MinimizedWindows)
+	}
+	b.WriteString(")\n\n")
+	if len(r.Distractions) > 0 {
+		b.WriteString("### 주요 방해 요소\n\n")
+		for i, distraction := range r.Distractions {
+			fmt.Fprintf(&b, "%d. %s — %d회\n", i+1, distraction.Target, distraction.Attempts)
+		}
+		b.WriteString("\n")
+	}
+
+	if len(r.TopUsage) > 0 {
+		b.WriteString("## ⏳ 가장 오래 머문 곳\n\n")
+		for i, stat := range r.TopUsage {
+			fmt.Fprintf(&b, "%d. %s — %s\n", i+1, stat.Target.Name, FormatDwell(stat.Seconds))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## 🚨 응급 상황\n\n")
+	if r.Emergencies == 0 {
+		b.WriteString("이번 주 응급 상황은 없었습니다.\n")
+	} else {
+		fmt.Fprintf(&b, "발생 **%d회**, 해결 %d회\n", r.Emergencies, r.EmergenciesResolved)
+	}
+	return b.String()
+}
+
+// formatDwellDelta 집중 시간 증감 표시 (+1시간 20분, -30분)
+func formatDwellDelta(seconds int64) string {
+	switch {
+	case seconds > 0:
+		return "+" + FormatDwell(seconds)
+	case seconds < 0:
+		return "-" + FormatDwell(-seconds)
+	default:
+		return "변화 없음"
+	}
+}
+
+// formatScoreDelta 평균 점수 증감 표시 (▲3.3, ▼1.0)
+func formatScoreDelta(delta float64) string {
+	switch {
+	case delta >= 0.05:
+		return fmt.Sprintf("▲%.1f", delta)
+	case delta <= -0.05:
+		return fmt.Sprintf("▼%.1f", -delta)
+	default:
+		return "변화 없음"
+	}
+}
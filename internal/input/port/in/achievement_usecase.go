@@ -0,0 +1,19 @@
+package in
+
+import "jiaa-server-core/internal/input/domain"
+
+// AchievementUseCase 업적 조회를 위한 Driving Port
+type AchievementUseCase interface {
+	// GetAchievements 규칙 파일에 정의된 업적별 달성 현황 조회
+	GetAchievements(clientID string) ([]domain.Achievement, error)
+}
+
+// AchievementRuleUseCase 업적 규칙 교체를 위한 Driving Port
+// 규칙 파일 감시자가 호출하여 재시작 없이 업적을 추가/변경
+type AchievementRuleUseCase interface {
+	// UpdateAchievementRules 규칙을 검증 후 교체 (검증 실패 시 기존 규칙 유지)
+	UpdateAchievementRules(ruleSet domain.AchievementRuleSet) error
+
+	// CurrentAchievementRules 현재 적용 중인 규칙 조회
+	CurrentAchievementRules() domain.AchievementRuleSet
+}
@@ -0,0 +1,28 @@
+package in
+
+import (
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// ActivityUsageUseCase 도메인/앱 체류 시간 집계를 위한 Driving Port
+// ReflexService가 릴레이 전에 모든 활동을 전달
+type ActivityUsageUseCase interface {
+	// RecordActivity 활동 기록 (직전 대상의 체류 시간을 누적하고 새 대상으로 전환)
+	RecordActivity(activity domain.ClientActivity)
+
+	// EndActivity 현재 대상의 체류 종료 (차단된 URL/앱은 체류로 치지 않음)
+	EndActivity(clientID string, at time.Time)
+
+	// GetUsage 기간 [from, to) 안의 대상별 누적 사용 시간 (내림차순)
+	GetUsage(clientID string, from, to time.Time) ([]domain.UsageStat, error)
+}
+
+// FactBombUseCase 팩트 폭격 조회를 위한 Driving Port
+type FactBombUseCase interface {
+	// GetFactBomb 누적 사용 시간 기반 팩트 폭격 생성
+	// targetURL이 있으면 해당 도메인, 없으면 triggerEvent(예: "youtube_detected") 키워드, 둘 다 없으면 최다 사용 대상
+	// 근거가 될 만한 누적 시간이 없으면 exists=false
+	GetFactBomb(clientID, triggerEvent, targetURL string) (factBomb domain.FactBomb, exists bool, err error)
+}
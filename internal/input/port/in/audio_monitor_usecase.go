@@ -0,0 +1,17 @@
+package in
+
+import "jiaa-server-core/internal/input/domain"
+
+// AudioMonitorUseCase 오디오 음량 감시를 위한 Driving Port
+// TranscribeAudio 스트림이 청크마다 호출
+type AudioMonitorUseCase interface {
+	// ProcessAudioChunk 청크 음량을 누적하고, 임계값 이상이 지속되면 EMERGENCY 발생
+	// 이번 청크로 EMERGENCY가 발생했으면 true
+	ProcessAudioChunk(chunk domain.AudioChunk) bool
+
+	// LatestTranscript 클라이언트의 최신 STT 결과
+	LatestTranscript(clientID string) string
+
+	// Forget 클라이언트 음량 상태 제거 (연결 종료 시, EMERGENCY 쿨다운은 만료까지 유지)
+	Forget(clientID string)
+}
@@ -0,0 +1,40 @@
+package in
+
+import "jiaa-server-core/internal/input/domain"
+
+// BlacklistAdminUseCase 차단 규칙 관리를 위한 Driving Port
+// 변경은 즉시 조회에 반영되고 저장소에 남아 재시작 후에도 유지
+type BlacklistAdminUseCase interface {
+	// ListPolicies 모든 정책 (global → group → client)
+	ListPolicies() []domain.ScopedPolicy
+
+	// GetPolicy 대상의 정책 (없으면 빈 정책)
+	GetPolicy(target domain.PolicyTarget) (*domain.BlacklistPolicy, error)
+
+	// AddURLRule URL 규칙 추가 (같은 도메인/경로의 규칙은 교체), 등록 시각 기록
+	AddURLRule(target domain.PolicyTarget, rule domain.URLRule) (domain.URLRule, error)
+
+	// RemoveURLRule URL 규칙 제거 (pattern: "youtube.com/shorts")
+	RemoveURLRule(target domain.PolicyTarget, pattern string) error
+
+	// AddPatternRule 앱 이름/창 제목 규칙 추가 (같은 Key의 규칙은 교체), 등록 시각 기록
+	AddPatternRule(target domain.PolicyTarget, rule domain.PatternRule) (domain.PatternRule, error)
+
+	// RemovePatternRule 앱 이름/창 제목 규칙 제거 (key: PatternRule.Key)
+	RemovePatternRule(target domain.PolicyTarget, key string) error
+
+	// ReplacePolicy 대상의 규칙 전체 교체 (일괄 편집, 시험 모드는 유지)
+	ReplacePolicy(target domain.PolicyTarget, policy *domain.BlacklistPolicy) error
+
+	// SetLockdown 그룹/클라이언트를 허용 목록 전용 모드로 전환
+	SetLockdown(target domain.PolicyTarget, lockdown domain.Lockdown) (domain.Lockdown, error)
+
+	// ClearLockdown 시험 모드 해제 (평소 정책으로 복귀)
+	ClearLockdown(target domain.PolicyTarget) error
+}
+
+// BlacklistSyncUseCase 외부 차단 목록 동기화를 위한 Driving Port
+type BlacklistSyncUseCase interface {
+	// SyncRules source가 내려준 전체 목록으로 전역 정책의 해당 출처 규칙을 원자적으로 교체 (목록에 없는 규칙은 제거)
+	SyncRules(source string, urlRules []domain.URLRule, patternRules []domain.PatternRule) (domain.RuleSyncDiff, error)
+}
@@ -0,0 +1,10 @@
+package in
+
+import "jiaa-server-core/internal/input/domain"
+
+// GamificationUseCase 게이미피케이션 조회를 위한 Driving Port
+// ScoringService.GetGamificationInfo에서 사용
+type GamificationUseCase interface {
+	// GetGamificationInfo 클라이언트의 레벨/경험치/누적 학습 시간 조회
+	GetGamificationInfo(clientID string) (domain.GamificationStatus, error)
+}
@@ -0,0 +1,19 @@
+package in
+
+import "jiaa-server-core/internal/input/domain"
+
+// LeaderboardUseCase 그룹 리더보드 조회를 위한 Driving Port
+// HTTP(/groups/:id/leaderboard)와 ScoringService.GetLeaderboard에서 사용
+type LeaderboardUseCase interface {
+	// GetLeaderboard 그룹 리더보드 조회 (비공개 클라이언트 제외, limit <= 0이면 기본 개수)
+	GetLeaderboard(groupID string, period domain.LeaderboardPeriod, metric domain.LeaderboardMetric, limit int) (domain.Leaderboard, error)
+}
+
+// LeaderboardMemberUseCase 스터디 그룹 소속/공개 설정을 위한 Driving Port
+type LeaderboardMemberUseCase interface {
+	// SetMembership 그룹 소속, 표시 이름, 비공개 여부 설정
+	SetMembership(member domain.LeaderboardMember) (domain.LeaderboardMember, error)
+
+	// GetMembership 소속 조회 (설정한 적이 없으면 그룹 없음)
+	GetMembership(clientID string) (domain.LeaderboardMember, error)
+}
@@ -0,0 +1,10 @@
+package in
+
+import "jiaa-server-core/internal/input/domain"
+
+// ProgressEventUseCase 학습 진행 이벤트 수신을 위한 Driving Port
+// ReflexService(차단), EmergencyService(응급) 등이 발생한 이벤트를 전달
+type ProgressEventUseCase interface {
+	// RecordEvent 이벤트 기록
+	RecordEvent(event domain.ProgressEvent)
+}
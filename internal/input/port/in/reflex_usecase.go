@@ -1,6 +1,10 @@
 package in
 
-import "jiaa-server-core/internal/input/domain"
+import (
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+)
 
 // ReflexUseCase Reflex 반응 처리를 위한 Driving Port
 // 속도가 생명인 즉각 반응 처리 (Blacklist URL → 즉시 차단)
@@ -8,5 +12,16 @@ type ReflexUseCase interface {
 	// ProcessActivity 클라이언트 활동을 처리하고 필요시 즉각 반응
 	// Blacklist URL인 경우 즉시 SabotageAction 반환
 	// 일반 트래픽은 Kafka로 릴레이 후 nil 반환
+	// 같은 대상의 반복 보고는 쿨다운 동안 차단을 억제하고 일반 트래픽처럼 릴레이
 	ProcessActivity(activity domain.ClientActivity) (*domain.SabotageAction, error)
+
+	// ProcessHeartbeat 하트비트의 활성 창 제목을 창 제목 규칙으로 검사
+	// 일치하면 규칙의 액션(CLOSE_APP, MINIMIZE_ALL, BLOCK_URL)으로 즉시 SabotageAction 반환
+	ProcessHeartbeat(heartbeat domain.Heartbeat) (*domain.SabotageAction, error)
+}
+
+// BlacklistPreviewUseCase 차단 규칙 미리보기를 위한 Driving Port
+type BlacklistPreviewUseCase interface {
+	// PreviewRules 클라이언트에 적용되는 규칙과 at 시각의 적용 여부
+	PreviewRules(clientID string, at time.Time) []domain.RulePreview
 }
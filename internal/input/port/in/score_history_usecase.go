@@ -0,0 +1,15 @@
+package in
+
+import (
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// ScoreHistoryUseCase 점수 이력 조회를 위한 Driving Port
+// 세션 그래프, 잘못된 판정 사후 디버깅용
+type ScoreHistoryUseCase interface {
+	// GetScoreHistory 기간 내 점수 이력 조회
+	// resolution이 비어 있으면 기간 길이에 맞춰 자동 선택
+	GetScoreHistory(clientID string, from, to time.Time, resolution domain.Resolution) ([]domain.ScoreSample, domain.Resolution, error)
+}
@@ -0,0 +1,13 @@
+package in
+
+import "jiaa-server-core/internal/input/domain"
+
+// ScoreModelUseCase 점수 모델 교체를 위한 Driving Port
+// 설정 파일 감시자가 호출하여 재시작 없이 가중치/임계값을 교체
+type ScoreModelUseCase interface {
+	// UpdateScoreModel 모델을 검증 후 교체 (검증 실패 시 기존 모델 유지)
+	UpdateScoreModel(model domain.ScoreModel) error
+
+	// CurrentScoreModel 현재 적용 중인 모델 조회
+	CurrentScoreModel() domain.ScoreModel
+}
@@ -0,0 +1,16 @@
+package in
+
+import "jiaa-server-core/internal/input/domain"
+
+// ScoreUseCase 실시간 점수 산정을 위한 Driving Port
+// SyncClient 하트비트를 세션에 누적하여 점수를 갱신하고, StreamScore가 최신 점수를 조회
+type ScoreUseCase interface {
+	// ProcessHeartbeat 하트비트로 점수를 계산하고 최신 점수를 갱신
+	ProcessHeartbeat(heartbeat domain.Heartbeat) domain.ScoreSnapshot
+
+	// GetLatestScore 클라이언트의 최신 점수 조회
+	GetLatestScore(clientID string) (domain.ScoreSnapshot, bool)
+
+	// EndSession 클라이언트 점수 세션 종료 (SyncClient 스트림 해제 시)
+	EndSession(clientID string)
+}
@@ -0,0 +1,13 @@
+package in
+
+import "jiaa-server-core/internal/input/domain"
+
+// StateDeciderUseCase 점수 → 상태 명령 판정을 위한 Driving Port
+// Dev 6 없이 로컬에서 ScoreService 결과로 StateCommand를 만들 때 사용
+type StateDeciderUseCase interface {
+	// Decide 점수 스냅샷을 반영하고, 상태 전이가 확정되면 StateCommand 반환 (없으면 nil)
+	Decide(snapshot domain.ScoreSnapshot) *domain.StateCommand
+
+	// Forget 클라이언트 판정 상태 제거 (세션 종료 시)
+	Forget(clientID string)
+}
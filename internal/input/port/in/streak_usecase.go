@@ -0,0 +1,24 @@
+package in
+
+import (
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// StreakUseCase 하루 집중 목표와 연속 달성 현황을 위한 Driving Port
+// HTTP(/clients/:id/goal, /clients/:id/streak)와 GetGamificationInfo에서 사용
+type StreakUseCase interface {
+	// SetDailyGoal 하루 집중 목표와 날짜 기준 시간대 설정 (timezone이 비어 있으면 기존 값 유지)
+	SetDailyGoal(clientID string, goal time.Duration, timezone string) (domain.StreakStatus, error)
+
+	// GetStreak 현재 연속 달성 현황 조회
+	GetStreak(clientID string) (domain.StreakStatus, error)
+}
+
+// ClientTimezoneUseCase 클라이언트 시간대 조회를 위한 Driving Port
+// 하루 목표와 함께 설정한 시간대 (없으면 서버 기본 시간대)
+type ClientTimezoneUseCase interface {
+	// ClientLocation 클라이언트 시간대
+	ClientLocation(clientID string) *time.Location
+}
@@ -0,0 +1,23 @@
+package in
+
+import (
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// StudySessionUseCase 학습 세션 선언을 위한 Driving Port
+// HTTP(/clients/:id/study-sessions)와 ReflexService(세션 한정 차단 규칙)에서 사용
+type StudySessionUseCase interface {
+	// DeclareSession 학습 세션 선언 (기존 세션과 겹치면 에러)
+	DeclareSession(clientID string, start, end time.Time, label string) (domain.StudySession, error)
+
+	// CancelSession 세션 취소
+	CancelSession(clientID, sessionID string) error
+
+	// ListSessions 기간 [from, to)와 겹치는 세션 조회
+	ListSessions(clientID string, from, to time.Time) ([]domain.StudySession, error)
+
+	// InStudySession at이 선언한 세션 안인지
+	InStudySession(clientID string, at time.Time) bool
+}
@@ -0,0 +1,14 @@
+package in
+
+import (
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// StudyStatsUseCase 하루 단위 집중 시간/점수 조회를 위한 Driving Port
+// 주간 리포트 등 기간 집계에서 사용
+type StudyStatsUseCase interface {
+	// GetDailyStats 기간 [from, to) 의 하루 기록 목록 (기록 없는 날은 생략)
+	GetDailyStats(clientID string, from, to time.Time) ([]domain.DailyStudyStats, error)
+}
@@ -0,0 +1,33 @@
+package in
+
+import (
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// UnlockPassUseCase 차단 해제 이용권 관리를 위한 Driving Port
+// 발급/회수/거절은 모두 감사 기록에 남음
+type UnlockPassUseCase interface {
+	// GrantPass 클라이언트에게 차단 규칙 하나의 이용권 발급 (duration이 0이면 기본 시간, 하루 한도 초과 시 에러)
+	GrantPass(clientID, field, ruleKey string, duration time.Duration, grantedBy, reason string) (domain.UnlockPass, error)
+
+	// RevokePass 이용권 조기 회수
+	RevokePass(clientID, passID, revokedBy string) error
+
+	// ListPasses 발급 시각이 [from, to)인 이용권 조회
+	ListPasses(clientID string, from, to time.Time) ([]domain.UnlockPass, error)
+
+	// ListAudit 기간 [from, to)의 감사 기록 조회
+	ListAudit(clientID string, from, to time.Time) ([]domain.UnlockAuditEntry, error)
+}
+
+// UnlockCheckUseCase 차단 직전 이용권 확인을 위한 Driving Port (ReflexService에서 사용)
+// 효력 있는 이용권이 있으면 사용 기록을 남기고 true
+type UnlockCheckUseCase interface {
+	// UseURLPass rawURL을 풀어 주는 이용권 사용
+	UseURLPass(clientID, rawURL string, at time.Time) bool
+
+	// UsePatternPass 앱/창 제목 규칙을 풀어 주는 이용권 사용 (value: 실제 앱 이름/창 제목)
+	UsePatternPass(clientID string, rule domain.PatternRule, value string, at time.Time) bool
+}
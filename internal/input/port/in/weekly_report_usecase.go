@@ -0,0 +1,14 @@
+package in
+
+import (
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// WeeklyReportUseCase 주간 학습 리포트 조회를 위한 Driving Port
+// HTTP(/clients/:id/reports/weekly)에서 사용
+type WeeklyReportUseCase interface {
+	// GetWeeklyReport weekOf가 속한 주(월요일 시작)의 리포트 생성
+	GetWeeklyReport(clientID string, weekOf time.Time) (domain.WeeklyReport, error)
+}
@@ -0,0 +1,12 @@
+package out
+
+import "jiaa-server-core/internal/input/domain"
+
+// AchievementStorePort 클라이언트별 업적 진행도 영속 저장을 위한 Driven Port
+type AchievementStorePort interface {
+	// LoadAchievements 업적 진행도 조회 (저장된 값이 없으면 exists=false)
+	LoadAchievements(clientID string) (progress *domain.AchievementProgress, exists bool, err error)
+
+	// SaveAchievements 업적 진행도 저장 (덮어쓰기)
+	SaveAchievements(progress *domain.AchievementProgress) error
+}
@@ -0,0 +1,16 @@
+package out
+
+import (
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// ActivityUsagePort 클라이언트별 도메인/앱 사용 시간(일 단위) 저장/조회를 위한 Driven Port
+type ActivityUsagePort interface {
+	// AddUsage 대상의 사용 시간을 해당 날짜(day, 그날 00:00)에 누적
+	AddUsage(clientID string, target domain.UsageTarget, day time.Time, duration time.Duration) error
+
+	// QueryUsage 기간 [from, to) 안의 날짜별 누적을 대상별로 합산 (사용 시간 내림차순)
+	QueryUsage(clientID string, from, to time.Time) ([]domain.UsageStat, error)
+}
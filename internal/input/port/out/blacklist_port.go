@@ -1,11 +1,45 @@
 package out
 
+import "jiaa-server-core/internal/input/domain"
+
 // BlacklistPort URL 블랙리스트 조회를 위한 Driven Port
 // 속도가 생명인 즉각 차단을 위해 사용
+// 조회는 클라이언트와 활동 시각 기준 (global → group → client 정책 상속, 허용 규칙, 규칙 일정 반영)
 type BlacklistPort interface {
-	// IsBlacklisted 주어진 URL이 블랙리스트에 있는지 확인
-	IsBlacklisted(url string) bool
+	// IsBlacklisted 주어진 URL이 클라이언트에게 차단 대상인지 확인
+	IsBlacklisted(ctx domain.RuleContext, url string) bool
+
+	// MatchApp 앱 이름에 일치하는 패턴 규칙 조회 (대소문자 무시)
+	MatchApp(ctx domain.RuleContext, appName string) (domain.PatternRule, bool)
+
+	// MatchWindowTitle 활성 창 제목에 일치하는 패턴 규칙 조회 (대소문자 무시)
+	MatchWindowTitle(ctx domain.RuleContext, title string) (domain.PatternRule, bool)
+
+	// PolicyChain 클라이언트에 적용되는 정책 (client → group → global 순, 복사본)
+	PolicyChain(clientID string) []domain.ScopedPolicy
+
+	// InLockdown 클라이언트에 시험 모드(허용 목록 전용)가 적용 중인지 (정책을 복사하지 않음)
+	InLockdown(clientID string) bool
+}
+
+// BlacklistRulePort 차단 규칙 관리를 위한 Driven Port (관리 API용, BlacklistPort 구현체가 함께 구현)
+type BlacklistRulePort interface {
+	// Policies 모든 정책 (복사본)
+	Policies() []domain.ScopedPolicy
+
+	// Policy 대상의 정책 (복사본, 없으면 빈 정책)
+	Policy(target domain.PolicyTarget) *domain.BlacklistPolicy
+
+	// ReplacePolicy 대상 정책 전체 교체 (즉시 조회에 반영)
+	ReplacePolicy(target domain.PolicyTarget, policy *domain.BlacklistPolicy) error
+}
+
+// BlacklistStorePort 차단 정책 영속화를 위한 Driven Port
+type BlacklistStorePort interface {
+	// LoadPolicies 저장된 모든 정책
+	// initialized는 정책을 한 번이라도 저장했는지 (규칙을 모두 지운 저장소와 첫 실행을 구분)
+	LoadPolicies() (policies []domain.ScopedPolicy, initialized bool, err error)
 
-	// IsAppBlacklisted 주어진 앱이 블랙리스트에 있는지 확인
-	IsAppBlacklisted(appName string) bool
+	// SavePolicy 대상 정책 저장 (빈 정책이면 삭제), 저장소를 초기화됨으로 표시
+	SavePolicy(target domain.PolicyTarget, policy *domain.BlacklistPolicy) error
 }
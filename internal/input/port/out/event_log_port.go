@@ -0,0 +1,18 @@
+package out
+
+import "jiaa-server-core/internal/input/domain"
+
+// EventLogPort 클라이언트별 하루 활동 이벤트 집계 저장을 위한 Driven Port
+type EventLogPort interface {
+	// AddEvent 이벤트 한 건을 해당 날짜(YYYY-MM-DD) 집계에 누적
+	AddEvent(event domain.ProgressEvent, day string) error
+
+	// QueryEventLogs 기간 [fromDay, toDay] 의 하루 집계 목록
+	QueryEventLogs(clientID, fromDay, toDay string) ([]domain.DailyEventLog, error)
+
+	// GetReportWeek 마지막으로 주간 리포트를 전달한 주 (월요일 YYYY-MM-DD, 없으면 빈 문자열)
+	GetReportWeek(clientID string) (string, error)
+
+	// SaveReportWeek 주간 리포트를 전달한 주 기록
+	SaveReportWeek(clientID, week string) error
+}
@@ -0,0 +1,12 @@
+package out
+
+import "jiaa-server-core/internal/input/domain"
+
+// GamificationStorePort 클라이언트별 게이미피케이션 진행도 영속 저장을 위한 Driven Port
+type GamificationStorePort interface {
+	// LoadProgress 진행도 조회 (저장된 값이 없으면 exists=false)
+	LoadProgress(clientID string) (progress *domain.GamificationProgress, exists bool, err error)
+
+	// SaveProgress 진행도 저장 (덮어쓰기)
+	SaveProgress(progress *domain.GamificationProgress) error
+}
@@ -0,0 +1,27 @@
+package out
+
+import "jiaa-server-core/internal/input/domain"
+
+// LeaderboardMemberPort 스터디 그룹 소속/공개 설정 저장을 위한 Driven Port
+type LeaderboardMemberPort interface {
+	// LoadMember 소속 조회 (저장된 값이 없으면 exists=false)
+	LoadMember(clientID string) (member domain.LeaderboardMember, exists bool, err error)
+
+	// SaveMember 소속 저장 (덮어쓰기)
+	SaveMember(member domain.LeaderboardMember) error
+
+	// ListGroupMembers 그룹에 속한 클라이언트 목록 (비공개 포함)
+	ListGroupMembers(groupID string) ([]domain.LeaderboardMember, error)
+}
+
+// DailyStatsPort 클라이언트별 하루 집중 시간/점수 합계 저장을 위한 Driven Port
+type DailyStatsPort interface {
+	// LoadDailyStats 하루 기록 조회 (저장된 값이 없으면 exists=false)
+	LoadDailyStats(clientID, day string) (stats domain.DailyStudyStats, exists bool, err error)
+
+	// SaveDailyStats 하루 기록 저장 (덮어쓰기)
+	SaveDailyStats(stats domain.DailyStudyStats) error
+
+	// QueryDailyStats 기간 [fromDay, toDay] 의 하루 기록 목록 (YYYY-MM-DD)
+	QueryDailyStats(clientID, fromDay, toDay string) ([]domain.DailyStudyStats, error)
+}
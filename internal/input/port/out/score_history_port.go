@@ -0,0 +1,16 @@
+package out
+
+import (
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// ScoreHistoryPort 점수 이력(시계열) 저장/조회를 위한 Driven Port
+type ScoreHistoryPort interface {
+	// AppendScore 점수 스냅샷을 원본(1s) 샘플로 기록 (다운샘플은 저장소가 처리)
+	AppendScore(snapshot domain.ScoreSnapshot) error
+
+	// QueryScores 기간 내 샘플 조회 [from, to)
+	QueryScores(clientID string, from, to time.Time, resolution domain.Resolution) ([]domain.ScoreSample, error)
+}
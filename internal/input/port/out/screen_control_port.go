@@ -9,4 +9,13 @@ type ScreenControlPort interface {
 
 	// SendAIResult AI 결과(Markdown) 전송 (Solution Router → Dev 3)
 	SendAIResult(clientID string, markdown string) error
+
+	// UpdateScoreGauge 점수 게이지 갱신 (Score Gauge → Dev 3)
+	UpdateScoreGauge(snapshot domain.ScoreSnapshot) error
+
+	// ShowMessage 알림 메시지 표시 (레벨 업, 업적 등)
+	ShowMessage(clientID string, message string) error
+
+	// NotifyAchievement 업적 달성 알림 (ACHIEVEMENT_UNLOCKED)
+	NotifyAchievement(clientID string, achievement domain.Achievement) error
 }
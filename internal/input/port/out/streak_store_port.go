@@ -0,0 +1,12 @@
+package out
+
+import "jiaa-server-core/internal/input/domain"
+
+// StreakStorePort 클라이언트별 하루 목표/연속 달성 기록 영속 저장을 위한 Driven Port
+type StreakStorePort interface {
+	// LoadStreak 기록 조회 (저장된 값이 없으면 exists=false)
+	LoadStreak(clientID string) (streak *domain.StudyStreak, exists bool, err error)
+
+	// SaveStreak 기록 저장 (덮어쓰기)
+	SaveStreak(streak *domain.StudyStreak) error
+}
@@ -0,0 +1,19 @@
+package out
+
+import (
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// StudySessionPort 선언한 학습 세션 저장을 위한 Driven Port
+type StudySessionPort interface {
+	// SaveSession 세션 저장 (같은 ID는 덮어씀)
+	SaveSession(session domain.StudySession) error
+
+	// DeleteSession 세션 삭제 (없으면 deleted=false)
+	DeleteSession(clientID, sessionID string) (deleted bool, err error)
+
+	// ListSessions 기간 [from, to)와 겹치는 세션 조회 (시작 시각순)
+	ListSessions(clientID string, from, to time.Time) ([]domain.StudySession, error)
+}
@@ -0,0 +1,28 @@
+package out
+
+import (
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// UnlockPassPort 차단 해제 이용권 저장을 위한 Driven Port
+type UnlockPassPort interface {
+	// SavePass 이용권 저장 (같은 ID는 덮어씀)
+	SavePass(pass domain.UnlockPass) error
+
+	// LoadPass 이용권 조회
+	LoadPass(clientID, passID string) (domain.UnlockPass, bool, error)
+
+	// ListPasses 발급 시각이 [from, to)인 이용권 조회 (발급 시각순)
+	ListPasses(clientID string, from, to time.Time) ([]domain.UnlockPass, error)
+}
+
+// UnlockAuditPort 이용권 감사 기록을 위한 Driven Port (추가 전용)
+type UnlockAuditPort interface {
+	// AppendAudit 감사 기록 추가
+	AppendAudit(entry domain.UnlockAuditEntry) error
+
+	// ListAudit 기간 [from, to)의 감사 기록 조회 (시각순)
+	ListAudit(clientID string, from, to time.Time) ([]domain.UnlockAuditEntry, error)
+}
@@ -0,0 +1,301 @@
+package service
+
+import (
+	"log"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+	portout "jiaa-server-core/internal/input/port/out"
+)
+
+// AchievementConfig 업적 평가 파라미터
+type AchievementConfig struct {
+	MaxTickGap    time.Duration  // 이보다 긴 하트비트 간격은 끊김으로 보고 한 틱만 인정
+	FlushInterval time.Duration  // 진행도 저장 주기
+	Location      *time.Location // 클라이언트 시간대를 모를 때 일 단위 규칙(daily_streak)의 날짜 기준
+}
+
+// DefaultAchievementConfig 기본 업적 평가 파라미터
+func DefaultAchievementConfig() AchievementConfig {
+	return AchievementConfig{
+		MaxTickGap:    3 * domain.HeartbeatInterval,
+		FlushInterval: 10 * time.Second,
+		Location:      time.Local,
+	}
+}
+
+// AchievementService 선언형 업적 규칙 평가 서비스
+// 규칙 파일(AchievementRuleSet)에 정의된 업적을 점수 스트림(ScoreListener)과
+// 활동 이벤트(ProgressEventUseCase)로 평가하여 달성 시간을 기록하고 클라이언트에 알림
+type AchievementService struct {
+	config     AchievementConfig
+	store      portout.AchievementStorePort
+	screenPort portout.ScreenControlPort
+	timezones  portin.ClientTimezoneUseCase // daily_streak 날짜 경계 (선택, 없으면 config.Location)
+	ruleSet    domain.AchievementRuleSet
+	clients    *clientRecords[*domain.AchievementProgress] // ruleSet도 같은 락으로 보호
+}
+
+// NewAchievementService AchievementService 생성자 (DI)
+// 규칙은 UpdateAchievementRules로 설정 (설정 전에는 업적 없음)
+func NewAchievementService(config AchievementConfig, store portout.AchievementStorePort, screenPort portout.ScreenControlPort) *AchievementService {
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = DefaultAchievementConfig().FlushInterval
+	}
+	if config.Location == nil {
+		config.Location = time.Local
+	}
+	return &AchievementService{
+		config:     config,
+		store:      store,
+		screenPort: screenPort,
+		clients: newClientRecords("ACHIEVEMENT", store.SaveAchievements,
+			(*domain.AchievementProgress).Clone,
+			func(progress *domain.AchievementProgress) string { return progress.ClientID }),
+	}
+}
+
+// SetTimezones 클라이언트 시간대 설정 (daily_streak 날짜 경계)
+func (s *AchievementService) SetTimezones(timezones portin.ClientTimezoneUseCase) {
+	s.timezones = timezones
+}
+
+// UpdateAchievementRules 규칙 검증 후 교체
+// 진행 상태는 규칙 ID로 보존되므로 기존 업적의 누적은 유지됨
+func (s *AchievementService) UpdateAchievementRules(ruleSet domain.AchievementRuleSet) error {
+	if err := ruleSet.Validate(); err != nil {
+		return err
+	}
+
+	s.clients.Lock()
+	s.ruleSet = ruleSet
+	s.clients.Unlock()
+
+	log.Printf("[ACHIEVEMENT] Rules updated: version=%s, rules=%d", ruleSet.Version, len(ruleSet.Rules))
+	return nil
+}
+
+// CurrentAchievementRules 현재 적용 중인 규칙 조회
+func (s *AchievementService) CurrentAchievementRules() domain.AchievementRuleSet {
+	s.clients.Lock()
+	defer s.clients.Unlock()
+	return s.ruleSet
+}
+
+// OnScore 산정 결과 수신 → focus_duration, daily_streak 규칙 평가
+func (s *AchievementService) OnScore(snapshot domain.ScoreSnapshot) {
+	day := snapshot.Timestamp.In(s.location(snapshot.ClientID)).Format(domain.DayLayout)
+
+	s.clients.Lock()
+	entry, err := s.entry(snapshot.ClientID)
+	if err != nil {
+		s.clients.Unlock()
+		log.Printf("[ACHIEVEMENT] Failed to load progress for %s: %v", snapshot.ClientID, err)
+		return
+	}
+
+	elapsed := entry.tick(snapshot.Timestamp, s.config.MaxTickGap)
+	progress := entry.value
+	var unlocked []domain.AchievementRule
+	for _, rule := range s.ruleSet.Rules {
+		if progress.IsUnlocked(rule.ID) {
+			continue
+		}
+		state := progress.Rule(rule.ID)
+
+		switch rule.Type {
+		case domain.AchievementFocusDuration:
+			if !rule.CountsState(snapshot.State) {
+				continue
+			}
+			state.Accumulated += elapsed
+			if state.Accumulated >= rule.Duration {
+				unlocked = append(unlocked, rule)
+			}
+
+		case domain.AchievementDailyStreak:
+			if state.Day != day {
+				state.Day = day
+				state.Accumulated = 0
+			}
+			if !rule.CountsState(snapshot.State) {
+				continue
+			}
+			state.Accumulated += elapsed
+			if state.Accumulated < rule.DailyMinimum || state.StreakDay == day {
+				continue
+			}
+			// 오늘 최소 시간 달성: 어제도 달성했으면 연속, 아니면 새로 시작
+			if state.StreakDay == domain.PreviousDay(day) {
+				state.Count++
+			} else {
+				state.Count = 1
+			}
+			state.StreakDay = day
+			if state.Count >= rule.Days {
+				unlocked = append(unlocked, rule)
+			}
+		}
+	}
+	s.clients.markDirty(entry)
+	s.unlock(entry, unlocked, snapshot.Timestamp)
+	s.clients.Unlock()
+
+	s.saveUnlocked(snapshot.ClientID, unlocked)
+	s.notify(snapshot.ClientID, unlocked, snapshot.Timestamp)
+}
+
+// RecordEvent 활동 이벤트 수신 → event_count 규칙 평가, focus_duration 누적 초기화
+func (s *AchievementService) RecordEvent(event domain.ProgressEvent) {
+	s.clients.Lock()
+	entry, err := s.entry(event.ClientID)
+	if err != nil {
+		s.clients.Unlock()
+		log.Printf("[ACHIEVEMENT] Failed to load progress for %s: %v", event.ClientID, err)
+		return
+	}
+
+	progress := entry.value
+	var unlocked []domain.AchievementRule
+	changed := false
+	for _, rule := range s.ruleSet.Rules {
+		if progress.IsUnlocked(rule.ID) {
+			continue
+		}
+
+		switch rule.Type {
+		case domain.AchievementFocusDuration:
+			if !rule.ResetsOn(event.Type) {
+				continue
+			}
+			// 이미 0이면 저장할 변경 없음
+			if state, exists := progress.Rules[rule.ID]; exists && state.Accumulated != 0 {
+				state.Accumulated = 0
+				changed = true
+			}
+
+		case domain.AchievementEventCount:
+			if rule.Event != event.Type {
+				continue
+			}
+			state := progress.Rule(rule.ID)
+			state.Count++
+			changed = true
+			if state.Count >= rule.Count {
+				unlocked = append(unlocked, rule)
+			}
+		}
+	}
+	// 카운터나 누적 시간이 바뀐 경우에만 저장 대상으로 표시
+	if changed {
+		s.clients.markDirty(entry)
+	}
+	s.unlock(entry, unlocked, event.Timestamp)
+	s.clients.Unlock()
+
+	s.saveUnlocked(event.ClientID, unlocked)
+	s.notify(event.ClientID, unlocked, event.Timestamp)
+}
+
+// GetAchievements 규칙별 달성 현황 조회
+// 세션이 없는 클라이언트는 저장소에서 읽음
+func (s *AchievementService) GetAchievements(clientID string) ([]domain.Achievement, error) {
+	s.clients.Lock()
+	defer s.clients.Unlock()
+
+	if entry, exists := s.clients.get(clientID); exists {
+		return entry.value.Achievements(s.ruleSet.Rules), nil
+	}
+	if progress, pending := s.clients.peek(clientID); pending {
+		return progress.Achievements(s.ruleSet.Rules), nil
+	}
+
+	progress, exists, err := s.store.LoadAchievements(clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		progress = domain.NewAchievementProgress(clientID)
+	}
+	return progress.Achievements(s.ruleSet.Rules), nil
+}
+
+// Forget 세션 종료 시 진행도를 저장하고 메모리에서 제거
+func (s *AchievementService) Forget(clientID string) {
+	s.clients.Forget(clientID)
+}
+
+// Start 주기적 저장 시작
+func (s *AchievementService) Start() {
+	s.clients.Start(s.config.FlushInterval)
+	log.Printf("[ACHIEVEMENT] Started (flush interval: %s)", s.config.FlushInterval)
+}
+
+// Stop 주기적 저장 중지 후 남은 변경 저장
+func (s *AchievementService) Stop() {
+	s.clients.Stop()
+}
+
+// Flush 저장되지 않은 진행도와 저장 실패한 진행도를 모두 저장
+func (s *AchievementService) Flush() {
+	s.clients.Flush()
+}
+
+// unlock 달성 기록 (호출자가 락 보유)
+func (s *AchievementService) unlock(entry *clientEntry[*domain.AchievementProgress], rules []domain.AchievementRule, at time.Time) {
+	for _, rule := range rules {
+		entry.value.Unlock(rule.ID, at)
+	}
+}
+
+// saveUnlocked 달성이 있으면 주기 저장을 기다리지 않고 바로 저장 (락 밖에서 호출)
+// 달성은 드물고 중요하므로 즉시 기록하되, 실패하면 dirty로 남아 다음 주기에 다시 저장
+func (s *AchievementService) saveUnlocked(clientID string, rules []domain.AchievementRule) {
+	if len(rules) == 0 {
+		return
+	}
+	s.clients.SaveNow(clientID)
+}
+
+// location 클라이언트의 daily_streak 날짜 기준 시간대
+func (s *AchievementService) location(clientID string) *time.Location {
+	if s.timezones != nil {
+		return s.timezones.ClientLocation(clientID)
+	}
+	return s.config.Location
+}
+
+// notify 달성 알림 전송 (StreamManager → ACHIEVEMENT_UNLOCKED)
+func (s *AchievementService) notify(clientID string, rules []domain.AchievementRule, at time.Time) {
+	for _, rule := range rules {
+		log.Printf("[ACHIEVEMENT] 🏆 Unlocked! Client: %s, Achievement: %s", clientID, rule.ID)
+		achievement := domain.Achievement{
+			ID:          rule.ID,
+			Name:        rule.Name,
+			Description: rule.Description,
+			Unlocked:    true,
+			UnlockedAt:  at,
+		}
+		if err := s.screenPort.NotifyAchievement(clientID, achievement); err != nil {
+			log.Printf("[ACHIEVEMENT] Failed to notify achievement: %v", err)
+		}
+	}
+}
+
+// entry 클라이언트 메모리 진행도 조회 (없으면 저장소에서 읽어 생성, 호출자가 락 보유)
+func (s *AchievementService) entry(clientID string) (*clientEntry[*domain.AchievementProgress], error) {
+	if entry, exists := s.clients.get(clientID); exists {
+		return entry, nil
+	}
+
+	progress, exists, err := s.store.LoadAchievements(clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		progress = domain.NewAchievementProgress(clientID)
+	}
+
+	return s.clients.put(clientID, progress), nil
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+	portout "jiaa-server-core/internal/input/port/out"
+)
+
+// ActivityUsageConfig 체류 시간 집계 파라미터
+type ActivityUsageConfig struct {
+	MaxDwell       time.Duration  // 활동 사이 간격이 이보다 길면 이만큼만 인정 (자리 비움 대비)
+	MinFactSeconds int64          // 팩트 폭격 근거로 쓸 최소 누적 시간 (초)
+	Location       *time.Location // 일/주 경계 시간대
+}
+
+// DefaultActivityUsageConfig 기본 집계 파라미터
+// 동영상 시청처럼 입력 없이 머무는 경우를 고려해 최대 30분까지 인정
+func DefaultActivityUsageConfig() ActivityUsageConfig {
+	return ActivityUsageConfig{
+		MaxDwell:       30 * time.Minute,
+		MinFactSeconds: 60,
+		Location:       time.Local,
+	}
+}
+
+// factBombPeriods 팩트 폭격 후보 기간 (같은 시간이면 앞쪽 우선)
+var factBombPeriods = []domain.UsagePeriod{domain.PeriodLastWeek, domain.PeriodThisWeek}
+
+// ActivityUsageService 도메인/앱 체류 시간 집계 및 팩트 폭격 서비스
+// ReflexService를 지나는 URL_VISIT/APP_OPEN 활동 사이의 간격을 직전 대상의 체류 시간으로 보고
+// 날짜별로 누적하여 "지난주 youtube.com에서 4시간 12분" 같은 팩트 폭격을 생성
+type ActivityUsageService struct {
+	config    ActivityUsageConfig
+	usagePort portout.ActivityUsagePort
+	current   map[string]*usageSession // 클라이언트별 현재 체류 대상
+	mu        sync.Mutex
+	now       func() time.Time
+}
+
+// usageSession 현재 체류 중인 대상
+type usageSession struct {
+	target domain.UsageTarget
+	since  time.Time // 마지막으로 누적한 시간
+}
+
+// usageCredit 저장소에 누적할 날짜별 체류 시간
+type usageCredit struct {
+	target   domain.UsageTarget
+	day      time.Time
+	duration time.Duration
+}
+
+// NewActivityUsageService ActivityUsageService 생성자 (DI)
+func NewActivityUsageService(config ActivityUsageConfig, usagePort portout.ActivityUsagePort) *ActivityUsageService {
+	if config.Location == nil {
+		config.Location = time.Local
+	}
+	return &ActivityUsageService{
+		config:    config,
+		usagePort: usagePort,
+		current:   make(map[string]*usageSession),
+		now:       time.Now,
+	}
+}
+
+// RecordActivity 활동 기록
+// 직전 대상에 경과 시간을 누적한 뒤, URL_VISIT/APP_OPEN이면 새 대상으로 전환
+// IDLE_START나 현재 앱의 APP_CLOSE는 체류 종료
+func (s *ActivityUsageService) RecordActivity(activity domain.ClientActivity) {
+	s.mu.Lock()
+	credits := s.advance(activity.ClientID, activity.Timestamp)
+
+	session := s.current[activity.ClientID]
+	if target, ok := domain.UsageTargetOf(activity); ok {
+		s.current[activity.ClientID] = &usageSession{target: target, since: activity.Timestamp}
+	} else if session != nil {
+		switch activity.ActivityType {
+		case domain.ActivityIdleStart:
+			delete(s.current, activity.ClientID)
+		case domain.ActivityAppClose:
+			if session.target.Kind == domain.UsageApp && session.target.Name == strings.ToLower(activity.AppName) {
+				delete(s.current, activity.ClientID)
+			}
+		}
+	}
+	s.mu.Unlock()
+
+	s.save(activity.ClientID, credits)
+}
+
+// EndActivity 현재 대상의 체류 종료
+func (s *ActivityUsageService) EndActivity(clientID string, at time.Time) {
+	s.mu.Lock()
+	credits := s.advance(clientID, at)
+	delete(s.current, clientID)
+	s.mu.Unlock()
+
+	s.save(clientID, credits)
+}
+
+// GetUsage 기간 내 대상별 누적 사용 시간
+func (s *ActivityUsageService) GetUsage(clientID string, from, to time.Time) ([]domain.UsageStat, error) {
+	return s.usagePort.QueryUsage(clientID, from, to)
+}
+
+// GetFactBomb 지난주/이번 주 중 누적 시간이 더 긴 기간으로 팩트 폭격 생성
+func (s *ActivityUsageService) GetFactBomb(clientID, triggerEvent, targetURL string) (domain.FactBomb, bool, error) {
+	match := factBombMatcher(triggerEvent, targetURL)
+	now := s.now().In(s.config.Location)
+
+	var best domain.UsageStat
+	var bestPeriod domain.UsagePeriod
+	for _, period := range factBombPeriods {
+		from, to := period.Range(now)
+		stats, err := s.usagePort.QueryUsage(clientID, from, to)
+		if err != nil {
+			return domain.FactBomb{}, false, err
+		}
+		// 내림차순이므로 처음 일치하는 대상이 그 기간의 최다 사용
+		for _, stat := range stats {
+			if !match(stat.Target) {
+				continue
+			}
+			if stat.Seconds > best.Seconds {
+				best = stat
+				bestPeriod = period
+			}
+			break
+		}
+	}
+
+	if best.Seconds < s.config.MinFactSeconds || best.Seconds == 0 {
+		return domain.FactBomb{}, false, nil
+	}
+	return domain.NewFactBomb(clientID, best, bestPeriod), true, nil
+}
+
+// advance 현재 대상에 at까지의 경과 시간을 날짜별로 나누어 계산 (호출자가 락 보유)
+// 순서가 뒤바뀐 활동(at이 과거)은 누적하지 않음
+func (s *ActivityUsageService) advance(clientID string, at time.Time) []usageCredit {
+	session, exists := s.current[clientID]
+	if !exists || !at.After(session.since) {
+		return nil
+	}
+
+	start := session.since.In(s.config.Location)
+	end := at.In(s.config.Location)
+	if end.Sub(start) > s.config.MaxDwell {
+		end = start.Add(s.config.MaxDwell)
+	}
+	session.since = at
+
+	// 자정을 넘긴 체류는 날짜별로 분할
+	var credits []usageCredit
+	for start.Before(end) {
+		day := domain.StartOfDay(start)
+		next := day.AddDate(0, 0, 1)
+		if next.After(end) {
+			next = end
+		}
+		credits = append(credits, usageCredit{target: session.target, day: day, duration: next.Sub(start)})
+		start = next
+	}
+	return credits
+}
+
+// save 누적 시간 저장 (실패 시 로그만 남김)
+func (s *ActivityUsageService) save(clientID string, credits []usageCredit) {
+	for _, credit := range credits {
+		if err := s.usagePort.AddUsage(clientID, credit.target, credit.day, credit.duration); err != nil {
+			log.Printf("[ACTIVITY_USAGE] Failed to add usage for %s: %v", clientID, err)
+		}
+	}
+}
+
+// factBombMatcher 팩트 폭격 대상 선택 조건
+// targetURL → 같은 도메인, triggerEvent("youtube_detected") → 이름에 "youtube" 포함, 둘 다 없으면 전체
+func factBombMatcher(triggerEvent, targetURL string) func(domain.UsageTarget) bool {
+	if host := domain.HostOf(targetURL); host != "" {
+		return func(target domain.UsageTarget) bool {
+			return target.Kind == domain.UsageDomain && target.Name == host
+		}
+	}
+
+	keyword := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(triggerEvent)), "_detected")
+	if keyword != "" {
+		return func(target domain.UsageTarget) bool {
+			return strings.Contains(target.Name, keyword)
+		}
+	}
+
+	return func(domain.UsageTarget) bool { return true }
+}
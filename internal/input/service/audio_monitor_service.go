@@ -0,0 +1,164 @@
+package service
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+)
+
+// AudioEmergencyConfig 오디오 EMERGENCY 감지 파라미터
+type AudioEmergencyConfig struct {
+	ThresholdDBFS float64       // 이 음량(dBFS) 이상이면 큰 소리
+	Sustain       time.Duration // 큰 소리가 이 시간 이상 이어지면 EMERGENCY
+	SampleRate    int           // 청크에 샘플 레이트가 없을 때 기본값
+	Cooldown      time.Duration // EMERGENCY 발생 후 재발생 금지 시간
+}
+
+// DefaultAudioEmergencyConfig 기본 감지 파라미터
+// proto의 "Audio > 90dB"는 마이크 기준 약 -10 dBFS에 해당 (환경마다 보정 필요)
+func DefaultAudioEmergencyConfig() AudioEmergencyConfig {
+	return AudioEmergencyConfig{
+		ThresholdDBFS: -10,
+		Sustain:       time.Second,
+		SampleRate:    16000,
+		Cooldown:      30 * time.Second,
+	}
+}
+
+// AudioMonitorService 오디오 음량 기반 EMERGENCY 감지 서비스
+// TranscribeAudio PCM 청크의 RMS 음량(dBFS)을 계산해 임계값 이상이 지속되면
+// 최신 STT 결과를 실어 EMERGENCY StateCommand를 StateReceiverUseCase로 전달
+// (Kafka/Dev 6 없이 Core에서 직접 응급 흐름 시작)
+type AudioMonitorService struct {
+	config       AudioEmergencyConfig
+	stateUseCase portin.StateReceiverUseCase
+	clients      map[string]*audioLevelState
+	mu           sync.Mutex
+	now          func() time.Time
+}
+
+// audioLevelState 클라이언트별 음량 누적 상태
+type audioLevelState struct {
+	loudFor       time.Duration // 큰 소리 연속 누적 시간
+	peakDBFS      float64       // 연속 구간 최대 음량
+	transcript    string        // 최신 STT 결과
+	lastTriggered time.Time     // 마지막 EMERGENCY 발생 시간
+	idle          bool          // 연결 종료 후 쿨다운 만료를 기다리는 상태
+}
+
+// NewAudioMonitorService AudioMonitorService 생성자 (DI)
+func NewAudioMonitorService(config AudioEmergencyConfig, stateUseCase portin.StateReceiverUseCase) *AudioMonitorService {
+	return &AudioMonitorService{
+		config:       config,
+		stateUseCase: stateUseCase,
+		clients:      make(map[string]*audioLevelState),
+		now:          time.Now,
+	}
+}
+
+// ProcessAudioChunk 청크 음량을 누적하고, 임계값 이상이 지속되면 EMERGENCY 발생
+func (s *AudioMonitorService) ProcessAudioChunk(chunk domain.AudioChunk) bool {
+	cmd := s.evaluate(chunk)
+	if cmd == nil {
+		return false
+	}
+
+	log.Printf("[AUDIO_MONITOR] 🚨 Sustained loud audio, Client: %s → EMERGENCY", chunk.ClientID)
+
+	// Dev 5 분석 대기 동안 오디오 스트림 수신이 막히지 않도록 비동기 처리
+	go func() {
+		if err := s.stateUseCase.HandleStateChange(*cmd); err != nil {
+			log.Printf("[AUDIO_MONITOR] Failed to handle emergency: %v", err)
+		}
+	}()
+	return true
+}
+
+// LatestTranscript 클라이언트의 최신 STT 결과
+func (s *AudioMonitorService) LatestTranscript(clientID string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if state, exists := s.clients[clientID]; exists {
+		return state.transcript
+	}
+	return ""
+}
+
+// Forget 클라이언트 음량 상태 제거 (연결 종료 시)
+// 재연결로 쿨다운을 우회하지 못하도록 마지막 EMERGENCY 시간은 쿨다운이 끝날 때까지 유지
+func (s *AudioMonitorService) Forget(clientID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if state, exists := s.clients[clientID]; exists {
+		state.loudFor = 0
+		state.peakDBFS = domain.MinLoudnessDBFS
+		state.transcript = ""
+		state.idle = true
+	}
+
+	// 쿨다운이 끝난 연결 종료 클라이언트 정리
+	now := s.now()
+	for id, state := range s.clients {
+		if state.idle && now.Sub(state.lastTriggered) >= s.config.Cooldown {
+			delete(s.clients, id)
+		}
+	}
+}
+
+// evaluate 음량 상태 갱신 후 EMERGENCY 조건을 만족하면 StateCommand 생성
+func (s *AudioMonitorService) evaluate(chunk domain.AudioChunk) *domain.StateCommand {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, exists := s.clients[chunk.ClientID]
+	if !exists {
+		state = &audioLevelState{peakDBFS: domain.MinLoudnessDBFS}
+		s.clients[chunk.ClientID] = state
+	}
+	state.idle = false
+	if chunk.Transcript != "" {
+		state.transcript = chunk.Transcript
+	}
+
+	sampleRate := chunk.SampleRate
+	if sampleRate <= 0 {
+		sampleRate = s.config.SampleRate
+	}
+
+	// 조용한 청크가 오면 연속 구간 초기화
+	loudness := chunk.LoudnessDBFS()
+	if loudness < s.config.ThresholdDBFS {
+		state.loudFor = 0
+		state.peakDBFS = domain.MinLoudnessDBFS
+		return nil
+	}
+
+	state.loudFor += chunk.Duration(sampleRate)
+	if loudness > state.peakDBFS {
+		state.peakDBFS = loudness
+	}
+	if state.loudFor < s.config.Sustain {
+		return nil
+	}
+	if !state.lastTriggered.IsZero() && chunk.Timestamp.Sub(state.lastTriggered) < s.config.Cooldown {
+		return nil
+	}
+
+	payload := domain.EmergencyPayload{
+		ScreamText:   state.transcript,
+		LoudnessDBFS: state.peakDBFS,
+	}
+	state.lastTriggered = chunk.Timestamp
+	state.loudFor = 0
+	state.peakDBFS = domain.MinLoudnessDBFS
+
+	cmd := domain.NewStateCommand(chunk.ClientID, domain.StateEmergency).
+		WithPayload(payload.Encode()).
+		WithPriority(10)
+	cmd.Timestamp = chunk.Timestamp
+	return cmd
+}
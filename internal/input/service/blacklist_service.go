@@ -0,0 +1,300 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+	portout "jiaa-server-core/internal/input/port/out"
+)
+
+var (
+	// ErrBlacklistRuleNotFound 제거할 규칙 없음
+	ErrBlacklistRuleNotFound = errors.New("blacklist rule not found")
+	// ErrLockdownScope 시험 모드는 그룹/클라이언트에만 설정 가능
+	ErrLockdownScope = errors.New("lockdown can only be set for a group or client")
+	// ErrLockdownNotActive 끌 시험 모드 없음
+	ErrLockdownNotActive = errors.New("lockdown is not active")
+)
+
+// BlacklistService 차단 규칙 관리 서비스
+// 규칙은 BlacklistRulePort(즉시 조회용 메모리 정책)와 BlacklistStorePort(영속 저장소)에 함께 반영
+// 저장에 실패한 변경은 조회에도 반영하지 않음
+type BlacklistService struct {
+	rules portout.BlacklistRulePort
+	store portout.BlacklistStorePort
+	mu    sync.Mutex // 읽고-고치고-쓰는 변경 직렬화
+	now   func() time.Time
+}
+
+// NewBlacklistService BlacklistService 생성자 (DI)
+func NewBlacklistService(rules portout.BlacklistRulePort, store portout.BlacklistStorePort) *BlacklistService {
+	return &BlacklistService{
+		rules: rules,
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Load 저장된 정책으로 조회 정책 교체
+// 한 번도 저장한 적 없는 저장소면 (첫 실행) 현재 정책(기본 블랙리스트)을 저장
+// 관리자가 규칙을 모두 지운 저장소는 빈 정책 그대로 불러옴
+func (s *BlacklistService) Load() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, initialized, err := s.store.LoadPolicies()
+	if err != nil {
+		return err
+	}
+	if !initialized {
+		for _, scoped := range s.rules.Policies() {
+			if err := s.store.SavePolicy(scoped.Target, scoped.Policy); err != nil {
+				return err
+			}
+		}
+		log.Printf("[BLACKLIST] Seeded store with current policies")
+		return nil
+	}
+
+	for _, scoped := range s.rules.Policies() {
+		if err := s.rules.ReplacePolicy(scoped.Target, &domain.BlacklistPolicy{}); err != nil {
+			return err
+		}
+	}
+	for _, scoped := range stored {
+		if err := s.rules.ReplacePolicy(scoped.Target, scoped.Policy); err != nil {
+			return err
+		}
+	}
+	log.Printf("[BLACKLIST] Loaded %d policies from store", len(stored))
+	return nil
+}
+
+// ListPolicies 모든 정책
+func (s *BlacklistService) ListPolicies() []domain.ScopedPolicy {
+	return s.rules.Policies()
+}
+
+// GetPolicy 대상의 정책
+func (s *BlacklistService) GetPolicy(target domain.PolicyTarget) (*domain.BlacklistPolicy, error) {
+	if err := target.Validate(); err != nil {
+		return nil, err
+	}
+	return s.rules.Policy(target), nil
+}
+
+// AddURLRule URL 규칙 추가
+func (s *BlacklistService) AddURLRule(target domain.PolicyTarget, rule domain.URLRule) (domain.URLRule, error) {
+	rule.Meta = s.stamp(rule.Meta)
+	err := s.update(target, func(policy *domain.BlacklistPolicy) error {
+		rule = keepURLSource(policy, rule)
+		policy.AddURLRule(rule)
+		return nil
+	})
+	if err != nil {
+		return domain.URLRule{}, err
+	}
+	log.Printf("[BLACKLIST] %s added url rule %s to %s (allow=%v, reason=%q)",
+		rule.Meta.CreatedBy, rule, target, rule.Allow, rule.Meta.Reason)
+	return rule, nil
+}
+
+// RemoveURLRule URL 규칙 제거
+func (s *BlacklistService) RemoveURLRule(target domain.PolicyTarget, pattern string) error {
+	rule, err := domain.ParseURLRule(pattern)
+	if err != nil {
+		return err
+	}
+	err = s.update(target, func(policy *domain.BlacklistPolicy) error {
+		if !policy.RemoveURLRule(rule) {
+			return ErrBlacklistRuleNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[BLACKLIST] Removed url rule %s from %s", rule, target)
+	return nil
+}
+
+// AddPatternRule 앱 이름/창 제목 규칙 추가
+func (s *BlacklistService) AddPatternRule(target domain.PolicyTarget, rule domain.PatternRule) (domain.PatternRule, error) {
+	rule.Meta = s.stamp(rule.Meta)
+	err := s.update(target, func(policy *domain.BlacklistPolicy) error {
+		rule = keepPatternSource(policy, rule)
+		policy.AddPatternRule(rule)
+		return nil
+	})
+	if err != nil {
+		return domain.PatternRule{}, err
+	}
+	log.Printf("[BLACKLIST] %s added %s rule to %s (allow=%v, reason=%q)",
+		rule.Meta.CreatedBy, rule.Key(), target, rule.Allow, rule.Meta.Reason)
+	return rule, nil
+}
+
+// RemovePatternRule 앱 이름/창 제목 규칙 제거
+func (s *BlacklistService) RemovePatternRule(target domain.PolicyTarget, key string) error {
+	err := s.update(target, func(policy *domain.BlacklistPolicy) error {
+		if !policy.RemovePatternRule(key) {
+			return ErrBlacklistRuleNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[BLACKLIST] Removed %s rule from %s", key, target)
+	return nil
+}
+
+// ReplacePolicy 대상의 규칙 전체 교체 (등록 시각이 없는 규칙은 지금으로 기록, 시험 모드는 유지)
+func (s *BlacklistService) ReplacePolicy(target domain.PolicyTarget, policy *domain.BlacklistPolicy) error {
+	replacement := &domain.BlacklistPolicy{}
+	for _, rule := range policy.URLRules {
+		rule.Meta = s.stamp(rule.Meta)
+		replacement.AddURLRule(rule)
+	}
+	for _, rule := range policy.PatternRules {
+		rule.Meta = s.stamp(rule.Meta)
+		replacement.AddPatternRule(rule)
+	}
+
+	err := s.update(target, func(current *domain.BlacklistPolicy) error {
+		// 조회 → 수정 → 교체 왕복에도 동기화 규칙은 동기화 대상으로 남김
+		for i, rule := range replacement.URLRules {
+			replacement.URLRules[i] = keepURLSource(current, rule)
+		}
+		for i, rule := range replacement.PatternRules {
+			replacement.PatternRules[i] = keepPatternSource(current, rule)
+		}
+		// 시험 모드는 규칙 일괄 교체와 별개로 유지
+		replacement.Lockdown = current.Lockdown
+		*current = *replacement
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[BLACKLIST] Replaced %s with %d url rules and %d pattern rules",
+		target, len(replacement.URLRules), len(replacement.PatternRules))
+	return nil
+}
+
+// SetLockdown 그룹/클라이언트를 허용 목록 전용 모드로 전환 (이미 켜져 있으면 허용 목록 교체)
+func (s *BlacklistService) SetLockdown(target domain.PolicyTarget, lockdown domain.Lockdown) (domain.Lockdown, error) {
+	if target.Scope == domain.PolicyGlobal {
+		return domain.Lockdown{}, ErrLockdownScope
+	}
+	lockdown.Meta = s.stamp(lockdown.Meta)
+	err := s.update(target, func(policy *domain.BlacklistPolicy) error {
+		policy.Lockdown = &lockdown
+		return nil
+	})
+	if err != nil {
+		return domain.Lockdown{}, err
+	}
+	log.Printf("[BLACKLIST] %s enabled lockdown for %s (%d urls, %d apps allowed, reason=%q)",
+		lockdown.Meta.CreatedBy, target, len(lockdown.AllowedURLs), len(lockdown.AllowedApps), lockdown.Meta.Reason)
+	return lockdown, nil
+}
+
+// ClearLockdown 시험 모드 해제 (평소 정책으로 복귀)
+func (s *BlacklistService) ClearLockdown(target domain.PolicyTarget) error {
+	err := s.update(target, func(policy *domain.BlacklistPolicy) error {
+		if policy.Lockdown == nil {
+			return ErrLockdownNotActive
+		}
+		policy.Lockdown = nil
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[BLACKLIST] Lockdown cleared for %s", target)
+	return nil
+}
+
+// SyncRules 외부 목록(source)으로 전역 정책의 동기화 규칙을 한 번에 교체
+// 목록에서 빠진 규칙은 제거하고, 직접 관리하는 규칙은 건드리지 않음
+// 바뀐 것이 없으면 저장하지 않음
+func (s *BlacklistService) SyncRules(source string, urlRules []domain.URLRule, patternRules []domain.PatternRule) (domain.RuleSyncDiff, error) {
+	for i := range urlRules {
+		urlRules[i].Meta = s.stamp(urlRules[i].Meta)
+	}
+	for i := range patternRules {
+		patternRules[i].Meta = s.stamp(patternRules[i].Meta)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy := s.rules.Policy(domain.GlobalPolicy)
+	diff := policy.SyncRules(source, urlRules, patternRules)
+	if !diff.Changed() {
+		return diff, nil
+	}
+	if err := s.store.SavePolicy(domain.GlobalPolicy, policy); err != nil {
+		return domain.RuleSyncDiff{}, err
+	}
+	if err := s.rules.ReplacePolicy(domain.GlobalPolicy, policy); err != nil {
+		return domain.RuleSyncDiff{}, err
+	}
+	log.Printf("[BLACKLIST] Synced from %s: +%d ~%d -%d (unchanged %d, skipped %d)",
+		source, diff.Added, diff.Updated, diff.Removed, diff.Unchanged, diff.Skipped)
+	return diff, nil
+}
+
+// update 대상 정책 복사본을 고친 뒤 저장소 → 조회 정책 순으로 반영
+func (s *BlacklistService) update(target domain.PolicyTarget, modify func(policy *domain.BlacklistPolicy) error) error {
+	if err := target.Validate(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy := s.rules.Policy(target)
+	if err := modify(policy); err != nil {
+		return err
+	}
+	if err := s.store.SavePolicy(target, policy); err != nil {
+		return err
+	}
+	return s.rules.ReplacePolicy(target, policy)
+}
+
+// stamp 등록 시각이 없으면 지금으로 기록
+func (s *BlacklistService) stamp(meta domain.RuleMetadata) domain.RuleMetadata {
+	if meta.CreatedAt.IsZero() {
+		meta.CreatedAt = s.now()
+	}
+	return meta
+}
+
+// keepURLSource 같은 규칙이 이미 있으면 그 동기화 출처를 유지
+// (출처가 바뀌면 동기화가 더 이상 그 규칙을 갱신/제거하지 않음)
+func keepURLSource(policy *domain.BlacklistPolicy, rule domain.URLRule) domain.URLRule {
+	for _, existing := range policy.URLRules {
+		if existing.String() == rule.String() {
+			rule.Meta.Source = existing.Meta.Source
+			break
+		}
+	}
+	return rule
+}
+
+// keepPatternSource 같은 Key의 규칙이 이미 있으면 그 동기화 출처를 유지
+func keepPatternSource(policy *domain.BlacklistPolicy, rule domain.PatternRule) domain.PatternRule {
+	for _, existing := range policy.PatternRules {
+		if existing.Key() == rule.Key() {
+			rule.Meta.Source = existing.Meta.Source
+			break
+		}
+	}
+	return rule
+}
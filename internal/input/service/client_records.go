@@ -0,0 +1,286 @@
+package service
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// clientRecords 클라이언트별 메모리 기록과 저장 관리
+// (게이미피케이션, 업적, 연속 달성, 리더보드의 점수 스트림 누적 공통)
+// 변경은 메모리에 모아 두었다가 Flush(주기), Forget(세션 종료), SaveNow(즉시)로 저장
+//   - 저장은 saveMu로 직렬화되어 오래된 스냅샷이 최신 저장을 덮어쓰지 않음
+//   - 변경 순번(version)이 저장한 스냅샷과 같을 때만 dirty를 해제 (저장 중 변경은 다음 저장으로)
+//   - 저장에 실패한 채 메모리에서 빠진 기록은 재시도 목록에 남겨 다음 Flush에서 다시 저장
+//
+// 기록 읽기/변경은 호출자가 Lock을 보유한 상태에서 get/put/markDirty로 수행
+type clientRecords[V any] struct {
+	sync.Mutex
+	tag      string                      // 로그 접두어 (예: GAMIFICATION)
+	save     func(V) error               // 저장소 기록
+	clone    func(V) V                   // 저장용 스냅샷 복사
+	key      func(V) string              // 기록 식별자 (재시도 목록 키)
+	entries  map[string]*clientEntry[V]  // 클라이언트별 메모리 기록
+	retries  map[string]pendingRecord[V] // 저장 실패한 기록 (메모리에서 빠져도 유지)
+	seq      uint64                      // 변경 순번 (전체 공통, 단조 증가)
+	saveMu   sync.Mutex                  // 저장 직렬화
+	stopChan chan struct{}
+}
+
+// clientEntry 클라이언트별 메모리 기록
+type clientEntry[V any] struct {
+	value    V
+	lastTick time.Time // 직전 산정 시간 (경과 시간 계산용)
+	version  uint64    // 마지막 변경 순번
+	dirty    bool      // 저장되지 않은 변경 여부
+}
+
+// pendingRecord 저장할 기록 스냅샷
+type pendingRecord[V any] struct {
+	clientID string
+	key      string
+	value    V
+	version  uint64
+}
+
+// newClientRecords clientRecords 생성자
+func newClientRecords[V any](tag string, save func(V) error, clone func(V) V, key func(V) string) *clientRecords[V] {
+	return &clientRecords[V]{
+		tag:      tag,
+		save:     save,
+		clone:    clone,
+		key:      key,
+		entries:  make(map[string]*clientEntry[V]),
+		retries:  make(map[string]pendingRecord[V]),
+		stopChan: make(chan struct{}),
+	}
+}
+
+// isFocusedState 집중 시간으로 누적하는 상태 (FOCUSING, THINKING)
+func isFocusedState(state string) bool {
+	return state == ScoreStateFocusing || state == ScoreStateThinking
+}
+
+// tick 직전 산정 이후 경과 시간을 계산하고 산정 시간 갱신
+func (e *clientEntry[V]) tick(at time.Time, maxGap time.Duration) time.Duration {
+	elapsed := scoreTickDuration(e.lastTick, at, maxGap)
+	e.lastTick = at
+	return elapsed
+}
+
+// get 메모리 기록 조회 (호출자가 락 보유)
+func (r *clientRecords[V]) get(clientID string) (*clientEntry[V], bool) {
+	entry, exists := r.entries[clientID]
+	return entry, exists
+}
+
+// put 저장소에서 읽은 기록을 메모리에 올림 (호출자가 락 보유)
+// 같은 기록이 재시도 목록에 있으면 저장되지 않은 그 값을 대신 사용
+func (r *clientRecords[V]) put(clientID string, loaded V) *clientEntry[V] {
+	entry := &clientEntry[V]{value: loaded}
+	if retry, exists := r.retries[r.key(loaded)]; exists {
+		delete(r.retries, retry.key)
+		entry.value = r.clone(retry.value)
+		r.markDirty(entry)
+	}
+	r.entries[clientID] = entry
+	return entry
+}
+
+// replace 메모리 기록을 저장소에서 읽은 새 기록으로 교체 (호출자가 락 보유)
+// 날짜별 기록처럼 한 클라이언트의 기록 식별자가 바뀔 때 사용하며,
+// 교체된 기록이 저장되지 않았으면 재시도 목록으로 옮기고 그 키를 반환 (호출자는 락 해제 후 SavePending)
+func (r *clientRecords[V]) replace(clientID string, entry *clientEntry[V], loaded V) (retiredKey string, retired bool) {
+	if entry.dirty {
+		record := r.snapshot(clientID, entry)
+		r.retries[record.key] = record
+		retiredKey, retired = record.key, true
+	}
+
+	entry.value = loaded
+	entry.dirty = false
+	r.seq++
+	entry.version = r.seq
+	if retry, exists := r.retries[r.key(loaded)]; exists {
+		delete(r.retries, retry.key)
+		entry.value = r.clone(retry.value)
+		r.markDirty(entry)
+	}
+	return retiredKey, retired
+}
+
+// pending 재시도 목록에 남은 클라이언트 기록 (호출자가 락 보유)
+func (r *clientRecords[V]) pending(clientID string) []V {
+	var values []V
+	for _, retry := range r.retries {
+		if retry.clientID == clientID {
+			values = append(values, r.clone(retry.value))
+		}
+	}
+	return values
+}
+
+// peek 메모리에 올리지 않고 읽을 때 재시도 목록의 최신 값 확인 (호출자가 락 보유)
+func (r *clientRecords[V]) peek(key string) (V, bool) {
+	retry, exists := r.retries[key]
+	if !exists {
+		var zero V
+		return zero, false
+	}
+	return r.clone(retry.value), true
+}
+
+// markDirty 변경 순번을 올리고 저장 대상으로 표시 (호출자가 락 보유)
+func (r *clientRecords[V]) markDirty(entry *clientEntry[V]) {
+	r.seq++
+	entry.version = r.seq
+	entry.dirty = true
+}
+
+// Start 주기적 저장 시작
+func (r *clientRecords[V]) Start(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.stopChan:
+				return
+			case <-ticker.C:
+				r.Flush()
+			}
+		}
+	}()
+}
+
+// Stop 주기적 저장 중지 후 남은 변경 저장
+func (r *clientRecords[V]) Stop() {
+	close(r.stopChan)
+	r.Flush()
+}
+
+// Flush 저장되지 않은 기록과 재시도 목록을 모두 저장
+func (r *clientRecords[V]) Flush() {
+	r.saveMu.Lock()
+	defer r.saveMu.Unlock()
+
+	r.Lock()
+	pending := make([]pendingRecord[V], 0, len(r.entries)+len(r.retries))
+	for clientID, entry := range r.entries {
+		if entry.dirty {
+			pending = append(pending, r.snapshot(clientID, entry))
+		}
+	}
+	for _, retry := range r.retries {
+		pending = append(pending, retry)
+	}
+	r.Unlock()
+
+	for _, record := range pending {
+		r.write(record)
+	}
+}
+
+// Forget 세션 종료 시 기록을 저장하고 메모리에서 제거
+// 저장 중 재접속해도 최신 기록을 읽도록 스냅샷을 먼저 재시도 목록에 올린 뒤 저장
+func (r *clientRecords[V]) Forget(clientID string) {
+	r.saveMu.Lock()
+	defer r.saveMu.Unlock()
+
+	r.Lock()
+	entry, exists := r.entries[clientID]
+	delete(r.entries, clientID)
+	if !exists || !entry.dirty {
+		r.Unlock()
+		return
+	}
+	record := r.snapshot(clientID, entry)
+	r.retries[record.key] = record
+	r.Unlock()
+
+	r.write(record)
+}
+
+// SaveNow 클라이언트 기록을 주기 저장을 기다리지 않고 바로 저장
+func (r *clientRecords[V]) SaveNow(clientID string) error {
+	r.saveMu.Lock()
+	defer r.saveMu.Unlock()
+
+	r.Lock()
+	entry, exists := r.entries[clientID]
+	if !exists || !entry.dirty {
+		r.Unlock()
+		return nil
+	}
+	record := r.snapshot(clientID, entry)
+	r.Unlock()
+
+	return r.write(record)
+}
+
+// SaveDetached 메모리에 올리지 않은 기록을 바로 저장 (실패 시 재시도 목록에 남김)
+func (r *clientRecords[V]) SaveDetached(clientID string, value V) error {
+	r.saveMu.Lock()
+	defer r.saveMu.Unlock()
+
+	r.Lock()
+	r.seq++
+	value = r.clone(value)
+	record := pendingRecord[V]{clientID: clientID, key: r.key(value), value: value, version: r.seq}
+	r.Unlock()
+
+	return r.write(record)
+}
+
+// SavePending 재시도 목록의 기록 하나를 바로 저장 (replace로 옮긴 기록)
+func (r *clientRecords[V]) SavePending(key string) error {
+	r.saveMu.Lock()
+	defer r.saveMu.Unlock()
+
+	r.Lock()
+	record, exists := r.retries[key]
+	r.Unlock()
+	if !exists {
+		return nil
+	}
+	return r.write(record)
+}
+
+// snapshot 저장용 스냅샷 생성 (호출자가 락 보유)
+func (r *clientRecords[V]) snapshot(clientID string, entry *clientEntry[V]) pendingRecord[V] {
+	value := r.clone(entry.value)
+	return pendingRecord[V]{clientID: clientID, key: r.key(value), value: value, version: entry.version}
+}
+
+// write 스냅샷 저장 후 결과 반영 (호출자가 saveMu 보유)
+// 성공: 메모리 기록이 그 사이 바뀌지 않았으면 dirty 해제, 같은 순번의 재시도 항목 제거
+// 실패: 같은 기록이 메모리에 있으면 다시 dirty로 표시, 없으면 재시도 목록에 남김
+func (r *clientRecords[V]) write(record pendingRecord[V]) error {
+	err := r.save(record.value)
+
+	r.Lock()
+	defer r.Unlock()
+
+	entry, exists := r.entries[record.clientID]
+	sameRecord := exists && r.key(entry.value) == record.key
+	if err != nil {
+		log.Printf("[%s] Failed to save %s: %v", r.tag, record.key, err)
+		if sameRecord {
+			// 메모리 기록이 가장 최신이므로 다시 저장 대상으로 표시
+			entry.dirty = true
+			return err
+		}
+		if retry, queued := r.retries[record.key]; !queued || retry.version < record.version {
+			r.retries[record.key] = record
+		}
+		return err
+	}
+
+	if sameRecord && entry.version == record.version {
+		entry.dirty = false
+	}
+	if retry, queued := r.retries[record.key]; queued && retry.version <= record.version {
+		delete(r.retries, record.key)
+	}
+	return nil
+}
@@ -49,10 +49,13 @@ func (s *CommandRouterService) HandleStateChange(cmd domain.StateCommand) error
 	if cmd.IsEmergency() {
 		log.Printf("[COMMAND_ROUTER] 🚨 EMERGENCY detected! Delegating to EmergencyService...")
 		if s.emergencyHandler != nil {
-			// Payload에서 errorLog, screamText 추출
-			errorLog := string(cmd.Payload)
-			screamText := "Help!" // TODO: 실제로는 Payload 파싱 필요
-			return s.emergencyHandler.HandleEmergency(cmd.ClientID, errorLog, screamText)
+			// Payload에서 errorLog, screamText 추출 (Dev 6 원문 또는 Core 오디오 감지 JSON)
+			payload := domain.ParseEmergencyPayload(cmd.Payload)
+			screamText := payload.ScreamText
+			if screamText == "" {
+				screamText = "Help!"
+			}
+			return s.emergencyHandler.HandleEmergency(cmd.ClientID, payload.ErrorLog, screamText)
 		}
 		log.Printf("[COMMAND_ROUTER] ⚠️ EmergencyHandler not set, falling through to normal handling")
 	}
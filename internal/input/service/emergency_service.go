@@ -2,7 +2,10 @@ package service
 
 import (
 	"log"
+	"time"
 
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
 	"jiaa-server-core/internal/input/port/out"
 )
 
@@ -14,6 +17,7 @@ import (
 type EmergencyService struct {
 	intelligencePort out.IntelligencePort
 	screenPort       out.ScreenControlPort
+	recorders        []portin.ProgressEventUseCase // 응급 이벤트 수신자 (업적 등)
 }
 
 // NewEmergencyService EmergencyService 생성자 (DI)
@@ -27,10 +31,16 @@ func NewEmergencyService(
 	}
 }
 
+// AddEventRecorder 응급 이벤트(EMERGENCY, EMERGENCY_RESOLVED) 수신자 등록
+func (s *EmergencyService) AddEventRecorder(recorder portin.ProgressEventUseCase) {
+	s.recorders = append(s.recorders, recorder)
+}
+
 // HandleEmergency Emergency 상황 처리
 func (s *EmergencyService) HandleEmergency(clientID string, errorLog string, screamText string) error {
 	log.Printf("[EMERGENCY] 🚨 Emergency triggered! Client: %s", clientID)
 	log.Printf("[EMERGENCY] ErrorLog length: %d, ScreamText: %s", len(errorLog), screamText)
+	s.recordEvent(clientID, domain.ProgressEventEmergency)
 
 	// 1. Dev 5 (Intelligence Worker)에게 즉시 로그 분석 요청
 	log.Printf("[EMERGENCY] Requesting AI analysis from Dev 5...")
@@ -51,9 +61,18 @@ func (s *EmergencyService) HandleEmergency(clientID string, errorLog string, scr
 	}
 
 	log.Printf("[EMERGENCY] ✅ Emergency handled successfully for client: %s", clientID)
+	s.recordEvent(clientID, domain.ProgressEventEmergencyResolved)
 	return nil
 }
 
+// recordEvent 응급 이벤트를 수신자들에게 전달
+func (s *EmergencyService) recordEvent(clientID string, eventType domain.ProgressEventType) {
+	event := domain.NewProgressEvent(clientID, eventType, time.Now())
+	for _, recorder := range s.recorders {
+		recorder.RecordEvent(event)
+	}
+}
+
 // generateFallbackEmergencyMessage AI 분석 실패 시 기본 응급 메시지 생성
 func generateFallbackEmergencyMessage(errorLog string, screamText string) string {
 	return `# 🚨 응급 상황 감지
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+	portout "jiaa-server-core/internal/input/port/out"
+)
+
+// GamificationConfig 게이미피케이션 파라미터
+type GamificationConfig struct {
+	Curve               domain.LevelCurve // 레벨 곡선
+	FocusedXPPerSecond  int64             // FOCUSING 1초당 경험치
+	ThinkingXPPerSecond int64             // THINKING 1초당 경험치
+	MaxTickGap          time.Duration     // 이보다 긴 하트비트 간격은 끊김으로 보고 한 틱만 인정
+	FlushInterval       time.Duration     // 진행도 저장 주기
+}
+
+// DefaultGamificationConfig 기본 게이미피케이션 파라미터
+func DefaultGamificationConfig() GamificationConfig {
+	return GamificationConfig{
+		Curve:               domain.DefaultLevelCurve(),
+		FocusedXPPerSecond:  1,
+		ThinkingXPPerSecond: 2, // 깊은 몰입은 가산
+		MaxTickGap:          3 * domain.HeartbeatInterval,
+		FlushInterval:       10 * time.Second,
+	}
+}
+
+// GamificationService 게이미피케이션 서비스
+// ScoreBoardService의 산정 결과(ScoreListener)로 집중/생각 시간을 누적해 경험치를 부여하고,
+// 레벨이 오르면 클라이언트에 SHOW_MESSAGE로 알림
+// 진행도는 메모리에 모아 두었다가 주기적으로(그리고 세션 종료 시) 저장소에 기록
+type GamificationService struct {
+	config       GamificationConfig
+	store        portout.GamificationStorePort
+	screenPort   portout.ScreenControlPort
+	achievements portin.AchievementUseCase // 업적 현황 (선택)
+	streaks      portin.StreakUseCase      // 연속 달성 현황 (선택)
+	clients      *clientRecords[*domain.GamificationProgress]
+}
+
+// NewGamificationService GamificationService 생성자 (DI)
+func NewGamificationService(config GamificationConfig, store portout.GamificationStorePort, screenPort portout.ScreenControlPort) *GamificationService {
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = DefaultGamificationConfig().FlushInterval
+	}
+	return &GamificationService{
+		config:     config,
+		store:      store,
+		screenPort: screenPort,
+		clients: newClientRecords("GAMIFICATION", store.SaveProgress,
+			func(progress *domain.GamificationProgress) *domain.GamificationProgress {
+				clone := *progress
+				return &clone
+			},
+			func(progress *domain.GamificationProgress) string { return progress.ClientID }),
+	}
+}
+
+// SetAchievements 업적 현황 조회 설정 (GetGamificationInfo 응답에 포함)
+func (s *GamificationService) SetAchievements(achievements portin.AchievementUseCase) {
+	s.achievements = achievements
+}
+
+// SetStreaks 연속 달성 현황 조회 설정 (GetGamificationInfo 응답에 포함)
+func (s *GamificationService) SetStreaks(streaks portin.StreakUseCase) {
+	s.streaks = streaks
+}
+
+// OnScore 산정 결과 수신 → 집중/생각 시간과 경험치 누적
+func (s *GamificationService) OnScore(snapshot domain.ScoreSnapshot) {
+	s.clients.Lock()
+	entry, err := s.entry(snapshot.ClientID)
+	if err != nil {
+		s.clients.Unlock()
+		log.Printf("[GAMIFICATION] Failed to load progress for %s: %v", snapshot.ClientID, err)
+		return
+	}
+
+	elapsed := entry.tick(snapshot.Timestamp, s.config.MaxTickGap)
+	progress := entry.value
+	progress.RecordScore(snapshot.Score)
+
+	var xp int64
+	switch snapshot.State {
+	case ScoreStateFocusing:
+		xp = wholeSecondsGained(progress.FocusedTime, elapsed) * s.config.FocusedXPPerSecond
+		progress.FocusedTime += elapsed
+	case ScoreStateThinking:
+		xp = wholeSecondsGained(progress.ThinkingTime, elapsed) * s.config.ThinkingXPPerSecond
+		progress.ThinkingTime += elapsed
+	}
+
+	levelBefore, _, _ := s.config.Curve.LevelFor(progress.TotalXP)
+	progress.TotalXP += xp
+	levelAfter, _, _ := s.config.Curve.LevelFor(progress.TotalXP)
+
+	progress.UpdatedAt = snapshot.Timestamp
+	s.clients.markDirty(entry)
+	s.clients.Unlock()
+
+	if levelAfter > levelBefore {
+		log.Printf("[GAMIFICATION] 🎉 Level up! Client: %s, Lv.%d → Lv.%d", snapshot.ClientID, levelBefore, levelAfter)
+		message := fmt.Sprintf("🎉 레벨 업! Lv.%d 달성", levelAfter)
+		if err := s.screenPort.ShowMessage(snapshot.ClientID, message); err != nil {
+			log.Printf("[GAMIFICATION] Failed to notify level up: %v", err)
+		}
+	}
+}
+
+// Forget 세션 종료 시 진행도를 저장하고 메모리에서 제거
+// 저장에 실패하면 재시도 목록에 남아 다음 Flush에서 다시 저장
+func (s *GamificationService) Forget(clientID string) {
+	s.clients.Forget(clientID)
+}
+
+// GetGamificationInfo 클라이언트의 게이미피케이션 상태 조회
+// 세션이 없는 클라이언트는 저장소에서 읽음 (저장된 값이 없으면 Lv.1)
+func (s *GamificationService) GetGamificationInfo(clientID string) (domain.GamificationStatus, error) {
+	status, err := s.status(clientID)
+	if err != nil {
+		return domain.GamificationStatus{}, err
+	}
+
+	if s.achievements != nil {
+		achievements, err := s.achievements.GetAchievements(clientID)
+		if err != nil {
+			return domain.GamificationStatus{}, err
+		}
+		status.Achievements = achievements
+	}
+
+	if s.streaks != nil {
+		streak, err := s.streaks.GetStreak(clientID)
+		if err != nil {
+			return domain.GamificationStatus{}, err
+		}
+		status.Streak = &streak
+	}
+	return status, nil
+}
+
+// status 레벨/경험치 상태 계산
+func (s *GamificationService) status(clientID string) (domain.GamificationStatus, error) {
+	s.clients.Lock()
+	defer s.clients.Unlock()
+
+	if entry, exists := s.clients.get(clientID); exists {
+		return entry.value.Status(s.config.Curve), nil
+	}
+	if progress, pending := s.clients.peek(clientID); pending {
+		return progress.Status(s.config.Curve), nil
+	}
+
+	progress, exists, err := s.store.LoadProgress(clientID)
+	if err != nil {
+		return domain.GamificationStatus{}, err
+	}
+	if !exists {
+		progress = domain.NewGamificationProgress(clientID)
+	}
+	return progress.Status(s.config.Curve), nil
+}
+
+// Start 주기적 저장 시작
+func (s *GamificationService) Start() {
+	s.clients.Start(s.config.FlushInterval)
+	log.Printf("[GAMIFICATION] Started (flush interval: %s)", s.config.FlushInterval)
+}
+
+// Stop 주기적 저장 중지 후 남은 변경 저장
+func (s *GamificationService) Stop() {
+	s.clients.Stop()
+}
+
+// Flush 저장되지 않은 진행도와 저장 실패한 진행도를 모두 저장
+func (s *GamificationService) Flush() {
+	s.clients.Flush()
+}
+
+// entry 클라이언트 메모리 진행도 조회 (없으면 저장소에서 읽어 생성, 호출자가 락 보유)
+func (s *GamificationService) entry(clientID string) (*clientEntry[*domain.GamificationProgress], error) {
+	if entry, exists := s.clients.get(clientID); exists {
+		return entry, nil
+	}
+
+	progress, exists, err := s.store.LoadProgress(clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		progress = domain.NewGamificationProgress(clientID)
+	}
+
+	return s.clients.put(clientID, progress), nil
+}
+
+// scoreTickDuration 직전 산정 이후 경과 시간
+// 첫 틱이나 끊김(maxGap 초과)은 하트비트 한 주기로 계산
+func scoreTickDuration(last, now time.Time, maxGap time.Duration) time.Duration {
+	if last.IsZero() {
+		return domain.HeartbeatInterval
+	}
+	elapsed := now.Sub(last)
+	if elapsed <= 0 {
+		return 0
+	}
+	if maxGap > 0 && elapsed > maxGap {
+		return domain.HeartbeatInterval
+	}
+	return elapsed
+}
+
+// wholeSecondsGained 누적 시간에 elapsed를 더했을 때 새로 채워지는 정수 초
+// (하트비트 간격이 1초가 아니어도 경험치가 누락/중복되지 않음)
+func wholeSecondsGained(total, elapsed time.Duration) int64 {
+	return int64((total+elapsed)/time.Second) - int64(total/time.Second)
+}
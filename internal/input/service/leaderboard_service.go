@@ -0,0 +1,317 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+	portout "jiaa-server-core/internal/input/port/out"
+)
+
+// LeaderboardConfig 리더보드 파라미터
+type LeaderboardConfig struct {
+	MaxTickGap    time.Duration  // 이보다 긴 하트비트 간격은 끊김으로 보고 한 틱만 인정
+	FlushInterval time.Duration  // 하루 기록 저장 주기
+	Location      *time.Location // 일/주 경계 시간대 (그룹 공통)
+	DefaultLimit  int            // limit 생략 시 순위 개수
+	MaxLimit      int            // limit 상한
+}
+
+// DefaultLeaderboardConfig 기본 리더보드 파라미터
+func DefaultLeaderboardConfig() LeaderboardConfig {
+	return LeaderboardConfig{
+		MaxTickGap:    3 * domain.HeartbeatInterval,
+		FlushInterval: 10 * time.Second,
+		Location:      time.Local,
+		DefaultLimit:  10,
+		MaxLimit:      100,
+	}
+}
+
+// ErrAllTimeUnavailable 누적 리더보드의 근거(게이미피케이션)가 설정되지 않음
+var ErrAllTimeUnavailable = errors.New("all-time leaderboard is not available")
+
+// LeaderboardService 스터디 그룹 리더보드 서비스
+// 일간/주간 순위는 점수 스트림(ScoreListener)으로 쌓은 하루 기록(DailyStudyStats)으로,
+// 누적 순위는 게이미피케이션 진행도로 계산
+// 그룹에 속하지 않았거나 비공개(opt-out)를 선택한 클라이언트는 어느 리더보드에도 나오지 않음
+type LeaderboardService struct {
+	config       LeaderboardConfig
+	members      portout.LeaderboardMemberPort
+	stats        portout.DailyStatsPort
+	gamification portin.GamificationUseCase              // 누적 순위 근거 (선택)
+	onMembership []func(member domain.LeaderboardMember) // 멤버십 변경 알림 (그룹 정책 캐시 등)
+	clients      *clientRecords[domain.DailyStudyStats]  // 클라이언트별 당일 기록
+	now          func() time.Time
+}
+
+// NewLeaderboardService LeaderboardService 생성자 (DI)
+func NewLeaderboardService(config LeaderboardConfig, members portout.LeaderboardMemberPort, stats portout.DailyStatsPort) *LeaderboardService {
+	defaults := DefaultLeaderboardConfig()
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = defaults.FlushInterval
+	}
+	if config.DefaultLimit <= 0 {
+		config.DefaultLimit = defaults.DefaultLimit
+	}
+	if config.MaxLimit <= 0 {
+		config.MaxLimit = defaults.MaxLimit
+	}
+	if config.Location == nil {
+		config.Location = time.Local
+	}
+	return &LeaderboardService{
+		config:  config,
+		members: members,
+		stats:   stats,
+		clients: newClientRecords("LEADERBOARD", stats.SaveDailyStats,
+			func(day domain.DailyStudyStats) domain.DailyStudyStats { return day },
+			func(day domain.DailyStudyStats) string { return day.ClientID + "/" + day.Day }),
+		now: time.Now,
+	}
+}
+
+// SetGamification 누적(all_time) 순위 근거 설정
+func (s *LeaderboardService) SetGamification(gamification portin.GamificationUseCase) {
+	s.gamification = gamification
+}
+
+// OnMembershipChange 멤버십 저장 후 호출할 콜백 등록 (서비스 시작 전에 등록)
+func (s *LeaderboardService) OnMembershipChange(listener func(member domain.LeaderboardMember)) {
+	s.onMembership = append(s.onMembership, listener)
+}
+
+// OnScore 산정 결과 수신 → 하루 기록에 집중 시간과 점수 누적
+func (s *LeaderboardService) OnScore(snapshot domain.ScoreSnapshot) {
+	day := snapshot.Timestamp.In(s.config.Location).Format(domain.DayLayout)
+
+	s.clients.Lock()
+	entry, previous, rolled, err := s.entry(snapshot.ClientID, day)
+	if err != nil {
+		s.clients.Unlock()
+		log.Printf("[LEADERBOARD] Failed to load daily stats for %s: %v", snapshot.ClientID, err)
+		return
+	}
+
+	elapsed := entry.tick(snapshot.Timestamp, s.config.MaxTickGap)
+	if isFocusedState(snapshot.State) {
+		entry.value.FocusedTime += elapsed
+	}
+	entry.value.ScoreSum += int64(snapshot.Score)
+	entry.value.ScoreCount++
+	s.clients.markDirty(entry)
+	s.clients.Unlock()
+
+	// 날짜가 바뀌면 전날 기록은 바로 저장
+	if rolled {
+		s.clients.SavePending(previous)
+	}
+}
+
+// GetLeaderboard 그룹 리더보드 조회
+func (s *LeaderboardService) GetLeaderboard(groupID string, period domain.LeaderboardPeriod, metric domain.LeaderboardMetric, limit int) (domain.Leaderboard, error) {
+	if groupID == "" {
+		return domain.Leaderboard{}, errors.New("group_id is required")
+	}
+	if period == domain.LeaderboardAllTime && s.gamification == nil {
+		return domain.Leaderboard{}, ErrAllTimeUnavailable
+	}
+	if limit <= 0 {
+		limit = s.config.DefaultLimit
+	}
+	if limit > s.config.MaxLimit {
+		limit = s.config.MaxLimit
+	}
+
+	members, err := s.members.ListGroupMembers(groupID)
+	if err != nil {
+		return domain.Leaderboard{}, err
+	}
+
+	board := domain.Leaderboard{GroupID: groupID, Period: period, Metric: metric}
+	if period != domain.LeaderboardAllTime {
+		board.From, board.To = s.periodRange(period)
+	}
+
+	entries := make([]domain.LeaderboardEntry, 0, len(members))
+	for _, member := range members {
+		if !member.Visible(groupID) {
+			continue
+		}
+		entry, active, err := s.memberEntry(member, period, board.From, board.To)
+		if err != nil {
+			return domain.Leaderboard{}, err
+		}
+		if active {
+			entries = append(entries, entry)
+		}
+	}
+
+	board.Entries = domain.RankLeaderboard(entries, metric, limit)
+	return board, nil
+}
+
+// GetDailyStats 기간 [from, to) 의 하루 기록 목록 (저장되지 않은 메모리 기록 반영)
+func (s *LeaderboardService) GetDailyStats(clientID string, from, to time.Time) ([]domain.DailyStudyStats, error) {
+	fromDay := from.In(s.config.Location).Format(domain.DayLayout)
+	toDay := to.In(s.config.Location).AddDate(0, 0, -1).Format(domain.DayLayout)
+
+	days, err := s.stats.QueryDailyStats(clientID, fromDay, toDay)
+	if err != nil {
+		return nil, err
+	}
+
+	s.clients.Lock()
+	unsaved := s.clients.pending(clientID)
+	if entry, exists := s.clients.get(clientID); exists {
+		unsaved = append(unsaved, entry.value)
+	}
+	s.clients.Unlock()
+
+	// 메모리 기록과 저장 실패한 기록이 저장된 기록보다 최신
+	for _, current := range unsaved {
+		if current.Day < fromDay || current.Day > toDay {
+			continue
+		}
+		days = mergeDailyStats(days, current)
+	}
+	return days, nil
+}
+
+// mergeDailyStats 같은 날짜의 기록을 교체하거나 날짜 순서에 맞춰 추가
+func mergeDailyStats(days []domain.DailyStudyStats, current domain.DailyStudyStats) []domain.DailyStudyStats {
+	for i, day := range days {
+		if day.Day == current.Day {
+			days[i] = current
+			return days
+		}
+		if day.Day > current.Day {
+			return append(days[:i], append([]domain.DailyStudyStats{current}, days[i:]...)...)
+		}
+	}
+	return append(days, current)
+}
+
+// SetMembership 그룹 소속/공개 설정 저장
+func (s *LeaderboardService) SetMembership(member domain.LeaderboardMember) (domain.LeaderboardMember, error) {
+	member.GroupID = strings.TrimSpace(member.GroupID)
+	member.DisplayName = strings.TrimSpace(member.DisplayName)
+	if err := member.Validate(); err != nil {
+		return domain.LeaderboardMember{}, err
+	}
+
+	member.UpdatedAt = s.now()
+	if err := s.members.SaveMember(member); err != nil {
+		return domain.LeaderboardMember{}, err
+	}
+	for _, listener := range s.onMembership {
+		listener(member)
+	}
+	log.Printf("[LEADERBOARD] Membership updated: client=%s, group=%s, opt_out=%v", member.ClientID, member.GroupID, member.OptOut)
+	return member, nil
+}
+
+// GetMembership 그룹 소속 조회
+func (s *LeaderboardService) GetMembership(clientID string) (domain.LeaderboardMember, error) {
+	member, exists, err := s.members.LoadMember(clientID)
+	if err != nil {
+		return domain.LeaderboardMember{}, err
+	}
+	if !exists {
+		return domain.LeaderboardMember{ClientID: clientID}, nil
+	}
+	return member, nil
+}
+
+// Forget 세션 종료 시 하루 기록을 저장하고 메모리에서 제거
+func (s *LeaderboardService) Forget(clientID string) {
+	s.clients.Forget(clientID)
+}
+
+// Start 주기적 저장 시작
+func (s *LeaderboardService) Start() {
+	s.clients.Start(s.config.FlushInterval)
+	log.Printf("[LEADERBOARD] Started (flush interval: %s)", s.config.FlushInterval)
+}
+
+// Stop 주기적 저장 중지 후 남은 변경 저장
+func (s *LeaderboardService) Stop() {
+	s.clients.Stop()
+}
+
+// Flush 저장되지 않은 하루 기록과 저장 실패한 기록을 모두 저장
+func (s *LeaderboardService) Flush() {
+	s.clients.Flush()
+}
+
+// memberEntry 클라이언트의 기간 집계 (기록이 없으면 active=false)
+func (s *LeaderboardService) memberEntry(member domain.LeaderboardMember, period domain.LeaderboardPeriod, from, to time.Time) (domain.LeaderboardEntry, bool, error) {
+	entry := domain.LeaderboardEntry{ClientID: member.ClientID, DisplayName: member.Name()}
+
+	if period == domain.LeaderboardAllTime {
+		status, err := s.gamification.GetGamificationInfo(member.ClientID)
+		if err != nil {
+			return entry, false, err
+		}
+		entry.FocusedSeconds = status.TotalStudySeconds
+		entry.AverageScore = status.AverageScore
+		return entry, status.TotalStudySeconds > 0 || status.AverageScore > 0, nil
+	}
+
+	totals, err := s.periodStats(member.ClientID, from, to)
+	if err != nil {
+		return entry, false, err
+	}
+	entry.FocusedSeconds = int64(totals.FocusedTime / time.Second)
+	entry.AverageScore = totals.AverageScore()
+	return entry, totals.ScoreCount > 0, nil
+}
+
+// periodStats 기간 [from, to) 의 하루 기록 합계
+func (s *LeaderboardService) periodStats(clientID string, from, to time.Time) (domain.DailyStudyStats, error) {
+	days, err := s.GetDailyStats(clientID, from, to)
+	if err != nil {
+		return domain.DailyStudyStats{}, err
+	}
+
+	totals := domain.DailyStudyStats{ClientID: clientID}
+	for _, day := range days {
+		totals.Add(day)
+	}
+	return totals, nil
+}
+
+// periodRange 리더보드 기간 범위 (리더보드 시간대 기준)
+func (s *LeaderboardService) periodRange(period domain.LeaderboardPeriod) (from, to time.Time) {
+	now := s.now().In(s.config.Location)
+	if period == domain.LeaderboardWeekly {
+		return domain.PeriodThisWeek.Range(now)
+	}
+	return domain.PeriodToday.Range(now)
+}
+
+// entry 클라이언트의 해당 날짜 메모리 기록 조회 (호출자가 락 보유)
+// 날짜가 바뀌었으면 저장되지 않은 전날 기록을 재시도 목록으로 옮기고 그 키를 반환
+func (s *LeaderboardService) entry(clientID, day string) (entry *clientEntry[domain.DailyStudyStats], previous string, rolled bool, err error) {
+	entry, exists := s.clients.get(clientID)
+	if exists && entry.value.Day == day {
+		return entry, "", false, nil
+	}
+
+	stats, found, err := s.stats.LoadDailyStats(clientID, day)
+	if err != nil {
+		return nil, "", false, err
+	}
+	if !found {
+		stats = domain.DailyStudyStats{ClientID: clientID, Day: day}
+	}
+
+	if exists {
+		previous, rolled = s.clients.replace(clientID, entry, stats)
+		return entry, previous, rolled, nil
+	}
+	return s.clients.put(clientID, stats), "", false, nil
+}
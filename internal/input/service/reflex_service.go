@@ -2,18 +2,55 @@ package service
 
 import (
 	"log"
+	"strings"
+	"sync"
+	"time"
 
 	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
 	"jiaa-server-core/internal/input/port/out"
 )
 
+// ReflexThrottleConfig 반복 차단 억제 파라미터 (클라이언트·대상별)
+type ReflexThrottleConfig struct {
+	Cooldown    time.Duration // 같은 대상에 계속 머무는 동안 차단 명령 재전송 간격
+	DedupWindow time.Duration // 이 간격 안에 이어지는 같은 대상 보고는 같은 사건 (하트비트 1초 주기보다 길게)
+}
+
+// DefaultReflexThrottleConfig 기본 억제 파라미터
+func DefaultReflexThrottleConfig() ReflexThrottleConfig {
+	return ReflexThrottleConfig{
+		Cooldown:    30 * time.Second,
+		DedupWindow: 5 * time.Second,
+	}
+}
+
+// ReflexMetrics 즉각 반응 전송/억제 집계
+type ReflexMetrics struct {
+	Sent               int64                       `json:"sent"`
+	Suppressed         int64                       `json:"suppressed"`
+	SuppressedByAction map[domain.ActionType]int64 `json:"suppressed_by_action"`
+	TrackedTargets     int                         `json:"tracked_targets"`
+}
+
 // ReflexService 속도가 생명인 즉각 반응 처리 서비스
 // URL=Blacklist → 즉시 Sabotage 명령 (점수 계산 기다릴 시간 없음)
 // 일반 트래픽 → Kafka로 Dev 6에 릴레이
+// 같은 대상의 반복 차단은 억제하고(ReflexThrottle), 억제한 활동은 일반 트래픽처럼 릴레이
 type ReflexService struct {
 	blacklistPort out.BlacklistPort
 	commandPort   out.CommandPort
 	dataRelayPort out.DataRelayPort
+	recorders     []portin.ProgressEventUseCase // 차단 이벤트 수신자 (업적 등)
+	activityUsage portin.ActivityUsageUseCase   // 체류 시간 집계 (선택)
+	factBomb      portin.FactBombUseCase        // BLOCK_URL 메시지에 붙일 팩트 폭격 (선택)
+	timezones     portin.ClientTimezoneUseCase  // 규칙 일정의 클라이언트 시간대 (선택, 없으면 서버 시간대)
+	studySessions portin.StudySessionUseCase    // 세션 한정 규칙의 학습 세션 (선택)
+	unlockPasses  portin.UnlockCheckUseCase     // 차단 직전 확인할 해제 이용권 (선택)
+	throttle      *domain.ReflexThrottle        // 반복 차단 억제 (선택, 없으면 매번 전송)
+	metrics       ReflexMetrics
+	mu            sync.Mutex // throttle, metrics 보호
+	now           func() time.Time
 }
 
 // NewReflexService ReflexService 생성자 (DI)
@@ -26,46 +63,110 @@ func NewReflexService(
 		blacklistPort: blacklistPort,
 		commandPort:   commandPort,
 		dataRelayPort: dataRelayPort,
+		metrics:       ReflexMetrics{SuppressedByAction: make(map[domain.ActionType]int64)},
+		now:           time.Now,
+	}
+}
+
+// AddEventRecorder 차단 이벤트(BLOCK_URL, CLOSE_APP, MINIMIZE_ALL) 수신자 등록
+func (s *ReflexService) AddEventRecorder(recorder portin.ProgressEventUseCase) {
+	s.recorders = append(s.recorders, recorder)
+}
+
+// SetActivityUsage 체류 시간 집계 설정 (모든 활동을 릴레이 전에 기록)
+func (s *ReflexService) SetActivityUsage(activityUsage portin.ActivityUsageUseCase) {
+	s.activityUsage = activityUsage
+}
+
+// SetFactBomb BLOCK_URL 메시지에 팩트 폭격 첨부 설정
+func (s *ReflexService) SetFactBomb(factBomb portin.FactBombUseCase) {
+	s.factBomb = factBomb
+}
+
+// SetTimezones 규칙 일정(요일/시간대)을 평가할 클라이언트 시간대 설정
+func (s *ReflexService) SetTimezones(timezones portin.ClientTimezoneUseCase) {
+	s.timezones = timezones
+}
+
+// SetStudySessions 세션 한정 규칙을 위한 학습 세션 설정
+func (s *ReflexService) SetStudySessions(studySessions portin.StudySessionUseCase) {
+	s.studySessions = studySessions
+}
+
+// SetUnlockPasses 차단 해제 이용권 설정 (효력 있는 이용권이 있으면 차단하지 않고 일반 트래픽으로 처리)
+func (s *ReflexService) SetUnlockPasses(unlockPasses portin.UnlockCheckUseCase) {
+	s.unlockPasses = unlockPasses
+}
+
+// SetThrottle 클라이언트·대상별 반복 차단 억제 설정
+func (s *ReflexService) SetThrottle(config ReflexThrottleConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.throttle = domain.NewReflexThrottle(config.Cooldown, config.DedupWindow)
+}
+
+// Metrics 즉각 반응 전송/억제 집계 (복사본)
+func (s *ReflexService) Metrics() ReflexMetrics {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	metrics := s.metrics
+	metrics.SuppressedByAction = make(map[domain.ActionType]int64, len(s.metrics.SuppressedByAction))
+	for action, count := range s.metrics.SuppressedByAction {
+		metrics.SuppressedByAction[action] = count
+	}
+	if s.throttle != nil {
+		metrics.TrackedTargets = s.throttle.Tracked()
 	}
+	return metrics
 }
 
 // ProcessActivity 클라이언트 활동을 처리하고 필요시 즉각 반응
 // Blacklist URL/App인 경우 즉시 SabotageAction 반환
 // 일반 트래픽은 Kafka로 릴레이 후 nil 반환
 func (s *ReflexService) ProcessActivity(activity domain.ClientActivity) (*domain.SabotageAction, error) {
+	// 0. 체류 시간 집계 (직전 대상의 체류 시간 누적)
+	if s.activityUsage != nil {
+		s.activityUsage.RecordActivity(activity)
+	}
+
+	// 규칙 일정은 활동 시각 기준으로 평가
+	ctx := s.ruleContext(activity.ClientID, activity.Timestamp)
+
 	// 1. URL 블랙리스트 체크 (즉각 차단)
-	if activity.IsURLActivity() && s.blacklistPort.IsBlacklisted(activity.URL) {
-		log.Printf("[REFLEX] Blacklisted URL detected: %s, Client: %s", activity.URL, activity.ClientID)
+	if activity.IsURLActivity() && s.blacklistPort.IsBlacklisted(ctx, activity.URL) && !s.unlockedURL(ctx, activity.URL) {
+		s.endActivity(activity)
+		key := domain.ReflexTargetKey(activity.ClientID, domain.RuleFieldURL, domain.HostOf(activity.URL))
+		if s.allow(key, domain.ActionBlockURL, ctx.At) {
+			log.Printf("[REFLEX] Blacklisted URL detected: %s, Client: %s", activity.URL, activity.ClientID)
+			action := domain.NewSabotageAction(activity.ClientID, domain.ActionBlockURL).
+				WithTargetURL(activity.URL).
+				WithIntensity(10). // 최고 강도
+				WithMessage(s.blockURLMessage(activity))
 
-		action := domain.NewSabotageAction(activity.ClientID, domain.ActionBlockURL).
-			WithTargetURL(activity.URL).
-			WithIntensity(10). // 최고 강도
-			WithMessage("차단된 URL에 접근하였습니다.")
+			// 즉시 차단 명령 전송
+			if err := s.send(key, *action); err != nil {
+				return nil, err
+			}
 
-		// 즉시 차단 명령 전송
-		if err := s.commandPort.SendSabotage(*action); err != nil {
-			log.Printf("[REFLEX] Failed to send sabotage command: %v", err)
-			return nil, err
+			s.recordEvent(activity.ClientID, domain.ProgressEventBlockURL, domain.HostOf(activity.URL), activity.Timestamp)
+			return action, nil
 		}
-
-		return action, nil
+		activity = suppressedActivity(activity, domain.ActionBlockURL)
 	}
 
-	// 2. App 블랙리스트 체크 (즉각 차단)
-	if activity.IsAppActivity() && s.blacklistPort.IsAppBlacklisted(activity.AppName) {
-		log.Printf("[REFLEX] Blacklisted App detected: %s, Client: %s", activity.AppName, activity.ClientID)
-
-		action := domain.NewSabotageAction(activity.ClientID, domain.ActionCloseApp).
-			WithTargetApp(activity.AppName).
-			WithIntensity(10).
-			WithMessage("차단된 앱을 실행하였습니다.")
-
-		if err := s.commandPort.SendSabotage(*action); err != nil {
-			log.Printf("[REFLEX] Failed to send sabotage command: %v", err)
-			return nil, err
+	// 2. App 규칙 체크 (즉각 차단, 규칙별 액션/강도)
+	// 시험 모드는 앱 실행만 검사 (종료 보고에 CLOSE_APP을 보내거나 차단으로 기록하지 않음)
+	if activity.IsAppOpen() || (activity.IsAppActivity() && !s.inLockdown(activity.ClientID)) {
+		if rule, matched := s.blacklistPort.MatchApp(ctx, activity.AppName); matched && !s.unlockedRule(ctx, rule, activity.AppName) {
+			s.endActivity(activity)
+			key := domain.ReflexTargetKey(activity.ClientID, string(rule.Field), strings.ToLower(activity.AppName))
+			if s.allow(key, rule.Action, ctx.At) {
+				log.Printf("[REFLEX] Blacklisted App detected: %s (rule: %s), Client: %s", activity.AppName, rule.Key(), activity.ClientID)
+				return s.applyPatternRule(key, activity.ClientID, rule, activity.AppName, activity.Timestamp, "차단된 앱을 실행하였습니다.")
+			}
+			activity = suppressedActivity(activity, rule.Action)
 		}
-
-		return action, nil
 	}
 
 	// 3. 일반 트래픽 → Dev 6으로 릴레이 (분석용)
@@ -79,3 +180,182 @@ func (s *ReflexService) ProcessActivity(activity domain.ClientActivity) (*domain
 
 	return nil, nil
 }
+
+// ProcessHeartbeat 하트비트의 활성 창 제목을 창 제목 규칙으로 검사
+// 일치하면 규칙의 액션으로 즉시 SabotageAction 반환, 아니면 nil (하트비트 입력 활동은 호출자가 평소처럼 릴레이)
+// 억제한 반복은 nil을 반환하고, 억제 표시를 단 WINDOW_TITLE 활동을 분석기로 릴레이
+func (s *ReflexService) ProcessHeartbeat(heartbeat domain.Heartbeat) (*domain.SabotageAction, error) {
+	if heartbeat.ActiveWindowTitle == "" {
+		return nil, nil
+	}
+	ctx := s.ruleContext(heartbeat.ClientID, heartbeat.Timestamp)
+	rule, matched := s.blacklistPort.MatchWindowTitle(ctx, heartbeat.ActiveWindowTitle)
+	if !matched || s.unlockedRule(ctx, rule, heartbeat.ActiveWindowTitle) {
+		return nil, nil
+	}
+
+	key := domain.ReflexTargetKey(heartbeat.ClientID, string(rule.Field), rule.Key())
+	if !s.allow(key, rule.Action, ctx.At) {
+		activity := domain.NewClientActivity(heartbeat.ClientID, domain.ActivityWindowTitle).WithTimestamp(ctx.At)
+		activity.AddMetadata("window_title", heartbeat.ActiveWindowTitle)
+		if err := s.dataRelayPort.RelayToAnalyzer(suppressedActivity(*activity, rule.Action)); err != nil {
+			log.Printf("[REFLEX] Failed to relay suppressed window to analyzer: %v", err)
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	log.Printf("[REFLEX] Blacklisted window detected: %q (rule: %s), Client: %s",
+		heartbeat.ActiveWindowTitle, rule.Key(), heartbeat.ClientID)
+	return s.applyPatternRule(key, heartbeat.ClientID, rule, heartbeat.ActiveWindowTitle, heartbeat.Timestamp, "차단된 창이 열려 있습니다.")
+}
+
+// PreviewRules 클라이언트에 적용되는 규칙과 at 시각(일정, 학습 세션 반영)의 적용 여부
+func (s *ReflexService) PreviewRules(clientID string, at time.Time) []domain.RulePreview {
+	return domain.PreviewRules(s.blacklistPort.PolicyChain(clientID), s.ruleContext(clientID, at))
+}
+
+// ruleContext 규칙 평가 기준 (시각이 없으면 현재, 클라이언트 시간대로 변환)
+func (s *ReflexService) ruleContext(clientID string, at time.Time) domain.RuleContext {
+	if at.IsZero() {
+		at = s.now()
+	}
+	location := time.Local
+	if s.timezones != nil {
+		location = s.timezones.ClientLocation(clientID)
+	}
+
+	ctx := domain.RuleContext{ClientID: clientID, At: at.In(location)}
+	if s.studySessions != nil {
+		ctx.InStudySession = s.studySessions.InStudySession(clientID, at)
+	}
+	return ctx
+}
+
+// unlockedURL 효력 있는 이용권이 URL 차단을 풀어 주는지
+func (s *ReflexService) unlockedURL(ctx domain.RuleContext, rawURL string) bool {
+	if !s.unlockPassesApply(ctx.ClientID) || !s.unlockPasses.UseURLPass(ctx.ClientID, rawURL, ctx.At) {
+		return false
+	}
+	log.Printf("[REFLEX] Unlock pass in effect, not blocking URL: %s, Client: %s", rawURL, ctx.ClientID)
+	return true
+}
+
+// unlockedRule 효력 있는 이용권이 앱/창 제목 규칙을 풀어 주는지
+func (s *ReflexService) unlockedRule(ctx domain.RuleContext, rule domain.PatternRule, value string) bool {
+	if !s.unlockPassesApply(ctx.ClientID) || !s.unlockPasses.UsePatternPass(ctx.ClientID, rule, value, ctx.At) {
+		return false
+	}
+	log.Printf("[REFLEX] Unlock pass in effect, not applying rule %s: %q, Client: %s", rule.Key(), value, ctx.ClientID)
+	return true
+}
+
+// unlockPassesApply 이용권을 확인할지 (시험 모드(허용 목록 전용) 중에는 이용권도 무시)
+func (s *ReflexService) unlockPassesApply(clientID string) bool {
+	return s.unlockPasses != nil && !s.inLockdown(clientID)
+}
+
+// inLockdown 클라이언트에 시험 모드가 적용 중인지
+func (s *ReflexService) inLockdown(clientID string) bool {
+	return s.blacklistPort.InLockdown(clientID)
+}
+
+// allow 반복 억제 확인 (억제하면 집계)
+func (s *ReflexService) allow(key string, action domain.ActionType, at time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.throttle == nil || s.throttle.Allow(key, at) {
+		return true
+	}
+	s.metrics.Suppressed++
+	s.metrics.SuppressedByAction[action]++
+	return false
+}
+
+// send 차단 명령 전송 (실패하면 억제 기록을 지워 다음 보고에서 재시도)
+func (s *ReflexService) send(key string, action domain.SabotageAction) error {
+	err := s.commandPort.SendSabotage(action)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		log.Printf("[REFLEX] Failed to send sabotage command: %v", err)
+		if s.throttle != nil {
+			s.throttle.Forget(key)
+		}
+		return err
+	}
+	s.metrics.Sent++
+	return nil
+}
+
+// suppressedActivity 억제한 활동을 릴레이할 복사본 (분석기가 알 수 있도록 억제한 액션 표시)
+func suppressedActivity(activity domain.ClientActivity, action domain.ActionType) domain.ClientActivity {
+	metadata := make(map[string]string, len(activity.Metadata)+1)
+	for k, v := range activity.Metadata {
+		metadata[k] = v
+	}
+	metadata["reflex_suppressed"] = string(action)
+	activity.Metadata = metadata
+	return activity
+}
+
+// applyPatternRule 앱 이름/창 제목 규칙의 액션 전송 후 차단 이벤트 기록
+// 앱 규칙은 종료 대상 앱을 지정하고, 창 제목 규칙은 클라이언트가 활성 창을 대상으로 처리
+func (s *ReflexService) applyPatternRule(key, clientID string, rule domain.PatternRule, value string, at time.Time, defaultMessage string) (*domain.SabotageAction, error) {
+	message := rule.Message
+	if message == "" {
+		message = defaultMessage
+	}
+	action := domain.NewSabotageAction(clientID, rule.Action).
+		WithIntensity(rule.Intensity).
+		WithMessage(message)
+
+	// 차단 대상: 앱은 앱 이름, 창 제목은 제목마다 달라지므로 규칙 패턴으로 묶음
+	target := rule.Pattern
+	if rule.Field == domain.PatternFieldApp {
+		action.WithTargetApp(value)
+		target = strings.ToLower(value)
+	}
+
+	if err := s.send(key, *action); err != nil {
+		return nil, err
+	}
+
+	s.recordEvent(clientID, domain.ProgressEventType(rule.Action), target, at)
+	return action, nil
+}
+
+// blockURLMessage BLOCK_URL 메시지 (누적 체류 시간이 있으면 팩트 폭격 첨부)
+func (s *ReflexService) blockURLMessage(activity domain.ClientActivity) string {
+	message := "차단된 URL에 접근하였습니다."
+	if s.factBomb == nil {
+		return message
+	}
+
+	factBomb, exists, err := s.factBomb.GetFactBomb(activity.ClientID, "", activity.URL)
+	if err != nil {
+		log.Printf("[REFLEX] Failed to get fact bomb: %v", err)
+		return message
+	}
+	if !exists {
+		return message
+	}
+	return message + " " + factBomb.Message
+}
+
+// endActivity 차단된 URL/앱은 체류로 치지 않음
+func (s *ReflexService) endActivity(activity domain.ClientActivity) {
+	if s.activityUsage != nil {
+		s.activityUsage.EndActivity(activity.ClientID, activity.Timestamp)
+	}
+}
+
+// recordEvent 차단 이벤트를 수신자들에게 전달
+func (s *ReflexService) recordEvent(clientID string, eventType domain.ProgressEventType, target string, at time.Time) {
+	event := domain.NewProgressEvent(clientID, eventType, at).WithTarget(target)
+	for _, recorder := range s.recorders {
+		recorder.RecordEvent(event)
+	}
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"log"
+	"sync"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+	portout "jiaa-server-core/internal/input/port/out"
+)
+
+// ScoreListener 점수 산정 결과 구독자
+// 산정 1틱마다 OnScore, 세션 종료 시 Forget 호출 (세션 락 밖에서 호출됨)
+type ScoreListener interface {
+	OnScore(snapshot domain.ScoreSnapshot)
+	Forget(clientID string)
+}
+
+// ScoreBoardService 클라이언트별 실시간 점수 세션 관리 서비스
+// SyncClient 하트비트 → 세션 누적 → ScoreService 점수 산정 → 최신 점수 보관
+// 하트비트 1회가 산정 1틱이며, StreamScore(Dev 3 오버레이)는 여기서 최신 점수를 읽어감
+type ScoreBoardService struct {
+	scoreService *ScoreService
+	stateDecider portin.StateDeciderUseCase // 로컬 상태 판정 (선택)
+	historyPort  portout.ScoreHistoryPort   // 점수 이력 기록 (선택)
+	listeners    []ScoreListener
+	sessions     map[string]*domain.ScoreSession
+	mu           sync.RWMutex
+}
+
+// NewScoreBoardService ScoreBoardService 생성자 (DI)
+func NewScoreBoardService(scoreService *ScoreService) *ScoreBoardService {
+	return &ScoreBoardService{
+		scoreService: scoreService,
+		sessions:     make(map[string]*domain.ScoreSession),
+	}
+}
+
+// SetStateDecider 로컬 상태 판정기 설정 (Local Decider 모드에서만 사용)
+func (s *ScoreBoardService) SetStateDecider(decider portin.StateDeciderUseCase) {
+	s.stateDecider = decider
+}
+
+// SetScoreHistory 점수 이력 저장소 설정
+func (s *ScoreBoardService) SetScoreHistory(historyPort portout.ScoreHistoryPort) {
+	s.historyPort = historyPort
+}
+
+// AddScoreListener 점수 산정 결과 구독자 추가 (서버 시작 전에 등록)
+func (s *ScoreBoardService) AddScoreListener(listener ScoreListener) {
+	s.listeners = append(s.listeners, listener)
+}
+
+// ProcessHeartbeat 하트비트를 세션에 누적하고 점수를 산정
+// 이력 저장소가 설정되어 있으면 산정 결과를 기록하고,
+// Local Decider가 설정되어 있으면 산정 결과로 상태 전이도 판정
+func (s *ScoreBoardService) ProcessHeartbeat(heartbeat domain.Heartbeat) domain.ScoreSnapshot {
+	snapshot := s.updateSession(heartbeat)
+
+	if s.historyPort != nil {
+		if err := s.historyPort.AppendScore(snapshot); err != nil {
+			log.Printf("[SCORE_BOARD] Failed to record score history for %s: %v", snapshot.ClientID, err)
+		}
+	}
+
+	// 상태 명령 전송(gRPC)은 세션 락 밖에서 수행
+	if s.stateDecider != nil {
+		s.stateDecider.Decide(snapshot)
+	}
+
+	for _, listener := range s.listeners {
+		listener.OnScore(snapshot)
+	}
+
+	return snapshot
+}
+
+// updateSession 세션 누적 및 점수 산정
+func (s *ScoreBoardService) updateSession(heartbeat domain.Heartbeat) domain.ScoreSnapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, exists := s.sessions[heartbeat.ClientID]
+	if !exists {
+		session = domain.NewScoreSession(heartbeat.ClientID, heartbeat.Timestamp)
+		s.sessions[heartbeat.ClientID] = session
+		log.Printf("[SCORE_BOARD] Session started for client: %s", heartbeat.ClientID)
+	}
+
+	session.Accumulate(heartbeat)
+
+	result := s.scoreService.CalculateScore(toCalculateInput(session, heartbeat))
+
+	session.Latest = domain.ScoreSnapshot{
+		ClientID:     heartbeat.ClientID,
+		Score:        result.FinalScore,
+		State:        result.State,
+		Timestamp:    heartbeat.Timestamp,
+		ModelVersion: result.ModelVersion,
+		Breakdown:    result.Breakdown,
+	}
+
+	return session.Latest
+}
+
+// GetLatestScore 클라이언트의 최신 점수 조회
+func (s *ScoreBoardService) GetLatestScore(clientID string) (domain.ScoreSnapshot, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	session, exists := s.sessions[clientID]
+	if !exists {
+		return domain.ScoreSnapshot{}, false
+	}
+	return session.Latest, true
+}
+
+// EndSession 클라이언트 세션 종료 (스트림 해제 시 호출)
+func (s *ScoreBoardService) EndSession(clientID string) {
+	s.mu.Lock()
+	if session, exists := s.sessions[clientID]; exists {
+		delete(s.sessions, clientID)
+		log.Printf("[SCORE_BOARD] Session ended for client: %s (heartbeats: %d, last score: %d)",
+			clientID, session.HeartbeatCount, session.CurrentScore())
+	}
+	s.mu.Unlock()
+
+	if s.stateDecider != nil {
+		s.stateDecider.Forget(clientID)
+	}
+	for _, listener := range s.listeners {
+		listener.Forget(clientID)
+	}
+}
+
+// toCalculateInput 세션 누적 상태로 점수 계산 입력 생성
+func toCalculateInput(session *domain.ScoreSession, heartbeat domain.Heartbeat) CalculateInput {
+	return CalculateInput{
+		EyesClosedDurationSec: session.EyesClosedDuration(heartbeat.Timestamp).Seconds(),
+		OSActivityCount:       session.StableOSActivityCount(),
+		RawOSActivityCount:    heartbeat.OSActivityCount(),
+		VisionScore:           heartbeat.VisionScore(),
+		CurrentScore:          session.CurrentScore(),
+		KeystrokeCount:        heartbeat.KeystrokeCount,
+		KeyboardEntropy:       heartbeat.KeyboardEntropy,
+		AvgDwellTimeMs:        heartbeat.AvgDwellTime,
+	}
+}
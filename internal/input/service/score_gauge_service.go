@@ -0,0 +1,101 @@
+package service
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+	portout "jiaa-server-core/internal/input/port/out"
+)
+
+// DefaultScoreGaugeInterval 기본 게이지 갱신 주기
+const DefaultScoreGaugeInterval = time.Second
+
+// ScoreGaugeService 클라이언트 점수 게이지 푸시 서비스
+// 점수/상태가 바뀐 클라이언트만 모아 두었다가 설정된 주기마다 최신 값 하나를 전송
+// (주기 안에 여러 번 바뀌어도 마지막 값만 전송되어 클라이언트를 과도하게 깨우지 않음)
+type ScoreGaugeService struct {
+	screenPort portout.ScreenControlPort
+	interval   time.Duration
+	pending    map[string]domain.ScoreSnapshot // 전송 대기 (클라이언트별 최신 값)
+	lastSent   map[string]domain.ScoreSnapshot // 마지막으로 전송한 값
+	mu         sync.Mutex
+	stopChan   chan struct{}
+}
+
+// NewScoreGaugeService ScoreGaugeService 생성자 (DI)
+func NewScoreGaugeService(screenPort portout.ScreenControlPort, interval time.Duration) *ScoreGaugeService {
+	if interval <= 0 {
+		interval = DefaultScoreGaugeInterval
+	}
+	return &ScoreGaugeService{
+		screenPort: screenPort,
+		interval:   interval,
+		pending:    make(map[string]domain.ScoreSnapshot),
+		lastSent:   make(map[string]domain.ScoreSnapshot),
+		stopChan:   make(chan struct{}),
+	}
+}
+
+// OnScore 산정 결과 수신 (점수나 상태가 바뀐 경우에만 전송 대기)
+func (s *ScoreGaugeService) OnScore(snapshot domain.ScoreSnapshot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	last, sent := s.lastSent[snapshot.ClientID]
+	if sent && last.Score == snapshot.Score && last.State == snapshot.State {
+		delete(s.pending, snapshot.ClientID)
+		return
+	}
+	s.pending[snapshot.ClientID] = snapshot
+}
+
+// Forget 세션 종료 시 클라이언트 게이지 상태 제거
+func (s *ScoreGaugeService) Forget(clientID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.pending, clientID)
+	delete(s.lastSent, clientID)
+}
+
+// Start 주기적 전송 시작
+func (s *ScoreGaugeService) Start() {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.stopChan:
+				return
+			case <-ticker.C:
+				s.Flush()
+			}
+		}
+	}()
+	log.Printf("[SCORE_GAUGE] Started (interval: %s)", s.interval)
+}
+
+// Stop 주기적 전송 중지
+func (s *ScoreGaugeService) Stop() {
+	close(s.stopChan)
+}
+
+// Flush 전송 대기 중인 게이지 값을 모두 전송
+// lastSent는 실제로 전송된 값만 기록 (미연결 등으로 실패하면 재시도하지 않고,
+// 다음 산정 결과가 같은 값이어도 다시 전송 대기에 오름)
+func (s *ScoreGaugeService) Flush() {
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = make(map[string]domain.ScoreSnapshot)
+	s.mu.Unlock()
+
+	for clientID, snapshot := range pending {
+		if err := s.screenPort.UpdateScoreGauge(snapshot); err != nil {
+			continue
+		}
+		s.mu.Lock()
+		s.lastSent[clientID] = snapshot
+		s.mu.Unlock()
+	}
+}
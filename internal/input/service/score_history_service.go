@@ -0,0 +1,67 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+	portout "jiaa-server-core/internal/input/port/out"
+)
+
+const (
+	// defaultScoreHistoryRange 기간 미지정 시 조회 범위
+	defaultScoreHistoryRange = time.Hour
+	// 자동 해상도 선택 기준 (조회 구간 길이)
+	maxSecondResolutionRange = time.Hour
+	maxMinuteResolutionRange = 48 * time.Hour
+)
+
+// ScoreHistoryService 점수 이력 조회 서비스
+// 기록은 ScoreBoardService가 산정 시마다 ScoreHistoryPort로 직접 수행
+type ScoreHistoryService struct {
+	historyPort portout.ScoreHistoryPort
+}
+
+// NewScoreHistoryService ScoreHistoryService 생성자 (DI)
+func NewScoreHistoryService(historyPort portout.ScoreHistoryPort) *ScoreHistoryService {
+	return &ScoreHistoryService{
+		historyPort: historyPort,
+	}
+}
+
+// GetScoreHistory 기간 내 점수 이력 조회
+// to 미지정 시 현재, from 미지정 시 to 기준 1시간 전
+func (s *ScoreHistoryService) GetScoreHistory(clientID string, from, to time.Time, resolution domain.Resolution) ([]domain.ScoreSample, domain.Resolution, error) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-defaultScoreHistoryRange)
+	}
+	if !from.Before(to) {
+		return nil, "", fmt.Errorf("invalid time range: from (%s) must be before to (%s)",
+			from.Format(time.RFC3339), to.Format(time.RFC3339))
+	}
+
+	if resolution == "" {
+		resolution = autoResolution(to.Sub(from))
+	}
+
+	samples, err := s.historyPort.QueryScores(clientID, from, to, resolution)
+	if err != nil {
+		return nil, "", err
+	}
+	return samples, resolution, nil
+}
+
+// autoResolution 조회 구간 길이에 맞는 해상도 선택 (그래프 한 장에 수천 점 이내)
+func autoResolution(span time.Duration) domain.Resolution {
+	switch {
+	case span <= maxSecondResolutionRange:
+		return domain.ResolutionSecond
+	case span <= maxMinuteResolutionRange:
+		return domain.ResolutionMinute
+	default:
+		return domain.ResolutionHour
+	}
+}
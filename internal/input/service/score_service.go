@@ -1,14 +1,50 @@
 package service
 
 import (
+	"log"
 	"math"
+	"sync/atomic"
+
+	"jiaa-server-core/internal/input/domain"
+)
+
+// ScoreService 산정 상태
+const (
+	ScoreStateSleeping   = "SLEEPING"   // 졸음 (눈 감음/고개 숙임)
+	ScoreStateDistracted = "DISTRACTED" // 유해 사이트
+	ScoreStateThinking   = "THINKING"   // 생각 모드 (건드리지 마)
+	ScoreStateFocusing   = "FOCUSING"   // 일반 집중
+	ScoreStateIdling     = "IDLING"     // 멍 때리기
+	ScoreStateNeutral    = "NEUTRAL"    // 현상 유지
 )
 
 // ScoreService 점수 산정 로직 (JIAA Algorithm)
-type ScoreService struct{}
+// 가중치/임계값은 ScoreModel로 주입되며 실행 중 교체 가능 (Hot Reload)
+type ScoreService struct {
+	model atomic.Pointer[domain.ScoreModel]
+}
 
+// NewScoreService 기본 모델로 ScoreService 생성
 func NewScoreService() *ScoreService {
-	return &ScoreService{}
+	s := &ScoreService{}
+	model := domain.DefaultScoreModel()
+	s.model.Store(&model)
+	return s
+}
+
+// UpdateScoreModel 모델을 검증 후 교체 (검증 실패 시 기존 모델 유지)
+func (s *ScoreService) UpdateScoreModel(model domain.ScoreModel) error {
+	if err := model.Validate(); err != nil {
+		return err
+	}
+	previous := s.model.Swap(&model)
+	log.Printf("[SCORE] Score model swapped: %s → %s", previous.Version, model.Version)
+	return nil
+}
+
+// CurrentScoreModel 현재 적용 중인 모델 조회
+func (s *ScoreService) CurrentScoreModel() domain.ScoreModel {
+	return *s.model.Load()
 }
 
 // CalculateInput 점수 계산에 필요한 입력 데이터
@@ -16,74 +52,195 @@ type CalculateInput struct {
 	EyesClosedDurationSec float64 // 눈 감은 지속 시간 (초)
 	HeadPitch             float64 // 고개 각도 (Pitch)
 	URLCategory           string  // URL 카테고리 (PLAY/STUDY/WORK/NEUTRAL)
-	OSActivityCount       int     // 키보드+마우스 입력 횟수
+	OSActivityCount       int     // 키보드+마우스 입력 횟수 (최근 윈도우 평활화)
+	RawOSActivityCount    int     // 직전 1초 키보드+마우스 입력 횟수 (평활화 전, 키 난타 제외 계산용)
 	VisionScore           int     // 시선 집중도 (0-100)
 	CurrentScore          int     // 현재 점수
+	KeystrokeCount        int     // 직전 1초 키 입력 수
+	KeyboardEntropy       float64 // 키보드 엔트로피 (0.0 ~ 5.0)
+	AvgDwellTimeMs        float64 // 평균 키 누름 시간 (ms)
 }
 
 // CalculateResult 계산 결과
 type CalculateResult struct {
-	FinalScore int
-	State      string
+	FinalScore   int
+	State        string
+	ModelVersion string                // 산정에 사용된 ScoreModel 버전
+	Breakdown    domain.ScoreBreakdown // 센서별 기여 내역
 }
 
 // CalculateScore 사용자의 상태와 점수를 계산
 func (s *ScoreService) CalculateScore(input CalculateInput) CalculateResult {
+	// 산정 도중 모델이 교체되어도 한 번의 계산은 같은 모델로 수행
+	model := s.model.Load()
+	result := calculateWithModel(model, input)
+	result.ModelVersion = model.Version
+	return result
+}
+
+// calculateWithModel 주어진 모델로 점수 계산
+func calculateWithModel(model *domain.ScoreModel, input CalculateInput) CalculateResult {
 	// 1단계: "즉결 처형" (Sanctions) - 최우선 순위
 
 	// 졸음 감지 (Sleep)
-	// 조건: eyes_closed == true (3초 이상 지속) OR head_pitch < -20 (고개 숙임)
-	isSleeping := input.EyesClosedDurationSec >= 3.0 || input.HeadPitch < -20.0
-	if isSleeping {
-		return CalculateResult{FinalScore: 0, State: "SLEEPING"}
+	// 조건: eyes_closed == true (기본 3초 이상 지속) OR head_pitch < -20 (고개 숙임)
+	eyesClosed := input.EyesClosedDurationSec >= model.SleepEyesClosedSec
+	headDown := input.HeadPitch < model.SleepHeadPitch
+	if eyesClosed || headDown {
+		// 직전 점수 전체를 원인 센서의 감점으로 기록
+		breakdown := domain.ScoreBreakdown{Carried: input.CurrentScore}
+		if eyesClosed {
+			breakdown.EyeFocus = -input.CurrentScore
+		} else {
+			breakdown.HeadPose = -input.CurrentScore
+		}
+		return CalculateResult{FinalScore: 0, State: ScoreStateSleeping, Breakdown: breakdown}
 	}
 
 	// 유해 사이트 (Banned URL)
 	// 조건: url_category == "PLAY" (게임, 유튜브 등)
 	if input.URLCategory == "PLAY" {
-		return CalculateResult{FinalScore: 10, State: "DISTRACTED"}
+		return CalculateResult{
+			FinalScore: model.DistractedScore,
+			State:      ScoreStateDistracted,
+			Breakdown: domain.ScoreBreakdown{
+				Carried:  input.CurrentScore,
+				Activity: model.DistractedScore - input.CurrentScore,
+			},
+		}
 	}
 
 	// 2단계: "생각 모드 보호" (Thinking Mode Protection)
 	// 조건: os_activity == 0 (입력 없음), vision_score > 70 (화면은 잘 봄), head_pitch > -10 (고개 듬)
-	if input.OSActivityCount == 0 && input.VisionScore > 70 && input.HeadPitch > -10.0 {
+	if input.OSActivityCount == 0 && input.VisionScore > model.ThinkingVisionMin && input.HeadPitch > model.ThinkingHeadPitch {
 		// 점수: 90점 고정 (혹은 MAX(현재점수, 85)) -> 요청대로 90점 고정 로직 적용하되, 기존 점수가 더 높으면 유지
-		newScore := 90
-		if input.CurrentScore > 90 {
+		newScore := model.ThinkingScore
+		if input.CurrentScore > newScore {
 			newScore = input.CurrentScore
 		}
-		return CalculateResult{FinalScore: newScore, State: "THINKING"}
+		// 입력 없이 화면을 보고 있는 것이 점수의 근거 (올린 만큼 시선 가점)
+		return CalculateResult{
+			FinalScore: newScore,
+			State:      ScoreStateThinking,
+			Breakdown: domain.ScoreBreakdown{
+				Carried:  input.CurrentScore,
+				EyeFocus: newScore - input.CurrentScore,
+			},
+		}
 	}
 
 	// 3단계: "일반 집중 모드" (Active Focus)
 	// 조건: os_activity > 0 (입력 있음)
 	if input.OSActivityCount > 0 {
+		// 키 난타는 입력으로 인정하지 않음 (마우스/클릭만 남김)
+		// 키 입력 수는 직전 1초 값이므로 같은 1초의 평활화 전 활동량에서 뺌
+		typing := classifyTyping(model, input)
+		productiveCount := input.OSActivityCount
+		if typing == typingMashing {
+			productiveCount = input.RawOSActivityCount - input.KeystrokeCount
+			if productiveCount < 0 {
+				productiveCount = 0
+			}
+		}
+
 		// OS_Norm: 마우스/키보드 횟수를 0~100으로 정규화 (예: 1초에 5타 이상이면 100점)
 		// 입력 input.OSActivityCount가 1초 기준이라고 가정
-		osNorm := float64(input.OSActivityCount) * 20.0
+		osNorm := float64(productiveCount) * model.PointsPerInput
 		if osNorm > 100.0 {
 			osNorm = 100.0
 		}
 
-		// Final = (Vision * 0.6) + (OS_Norm * 0.4)
-		weighted := (float64(input.VisionScore) * 0.6) + (osNorm * 0.4)
-		finalScore := int(math.Round(weighted))
+		// Final = (Vision * 0.6) + (OS_Norm * 0.4) + 타이핑 품질 보정
+		eyeFocus := float64(input.VisionScore) * model.VisionWeight
+		weighted := eyeFocus + (osNorm * model.OSWeight)
+		baseScore := int(math.Round(weighted))
+		finalScore := clampScore(baseScore + typingAdjustment(model, typing))
 
-		return CalculateResult{FinalScore: finalScore, State: "FOCUSING"}
+		// 반올림 오차는 활동 점수에, 0~100 보정 손실은 타이핑 점수에 흡수 (세 항목 합 = 최종 점수)
+		eyeFocusScore := int(math.Round(eyeFocus))
+		return CalculateResult{
+			FinalScore: finalScore,
+			State:      ScoreStateFocusing,
+			Breakdown: domain.ScoreBreakdown{
+				EyeFocus: eyeFocusScore,
+				Activity: baseScore - eyeFocusScore,
+				Typing:   finalScore - baseScore,
+			},
+		}
 	}
 
 	// 4단계: "멍 때리기" (Idling)
 	// 조건: os_activity == 0 AND vision_score < 50
-	if input.OSActivityCount == 0 && input.VisionScore < 50 {
+	if input.OSActivityCount == 0 && input.VisionScore < model.IdleVisionMax {
 		// 점수: 감점 (Decay) - 1초마다 5점씩 깎음
-		newScore := input.CurrentScore - 5
+		newScore := input.CurrentScore - model.DecayPerSecond
 		if newScore < 0 {
 			newScore = 0
 		}
-		return CalculateResult{FinalScore: newScore, State: "IDLING"}
+		return CalculateResult{
+			FinalScore: newScore,
+			State:      ScoreStateIdling,
+			Breakdown: domain.ScoreBreakdown{
+				Carried: input.CurrentScore,
+				Idle:    newScore - input.CurrentScore,
+			},
+		}
 	}
 
 	// 그 외 (Gray Area: 입력 없고 50 <= 시선 <= 70)
 	// 현상 유지 또는 완만한 감점? -> 일단 현상 유지 (NEUTRAL)
-	return CalculateResult{FinalScore: input.CurrentScore, State: "NEUTRAL"}
+	return CalculateResult{
+		FinalScore: input.CurrentScore,
+		State:      ScoreStateNeutral,
+		Breakdown:  domain.ScoreBreakdown{Carried: input.CurrentScore},
+	}
+}
+
+// typingQuality 타이핑 품질 판정 결과
+type typingQuality int
+
+const (
+	typingUnknown typingQuality = iota // 판정 불가 (키 입력 부족 또는 중간 구간)
+	typingMashing                      // 키 난타 (낮은 엔트로피)
+	typingSteady                       // 빠르고 안정적인 타이핑
+)
+
+// classifyTyping 키보드 엔트로피와 평균 Dwell로 타이핑 품질 판정
+// 같은 키를 반복해서 누르면 엔트로피가 낮고, 꾹 누르고 있거나 너무 짧게 튀는 입력은 Dwell 범위를 벗어남
+// Dwell을 보고하지 않는 (구버전) 클라이언트는 엔트로피도 0이므로 판정하지 않음
+func classifyTyping(model *domain.ScoreModel, input CalculateInput) typingQuality {
+	if input.KeystrokeCount < model.TypingMinKeystrokes || input.AvgDwellTimeMs <= 0 {
+		return typingUnknown
+	}
+	if input.KeyboardEntropy < model.MashEntropyMax {
+		return typingMashing
+	}
+	if input.KeyboardEntropy >= model.SteadyEntropyMin &&
+		input.AvgDwellTimeMs >= model.SteadyDwellMinMs && input.AvgDwellTimeMs <= model.SteadyDwellMaxMs {
+		return typingSteady
+	}
+	return typingUnknown
+}
+
+// typingAdjustment 타이핑 품질에 따른 점수 보정
+func typingAdjustment(model *domain.ScoreModel, typing typingQuality) int {
+	switch typing {
+	case typingSteady:
+		return model.TypingBonus
+	case typingMashing:
+		return -model.MashPenalty
+	default:
+		return 0
+	}
+}
+
+// clampScore 점수를 0~100 범위로 보정
+func clampScore(score int) int {
+	if score < 0 {
+		return 0
+	}
+	if score > 100 {
+		return 100
+	}
+	return score
 }
@@ -1,36 +1,63 @@
 package service
 
 import (
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
+	"jiaa-server-core/internal/input/adapter/out/memory"
 	"jiaa-server-core/internal/input/domain"
 )
 
 // MockBlacklistPort 테스트용 Mock
 type MockBlacklistPort struct {
 	blacklistedURLs map[string]bool
-	blacklistedApps map[string]bool
+	patternRules    []domain.PatternRule
 }
 
 func NewMockBlacklistPort() *MockBlacklistPort {
-	return &MockBlacklistPort{
+	mock := &MockBlacklistPort{
 		blacklistedURLs: map[string]bool{
 			"youtube.com": true,
 			"netflix.com": true,
 		},
-		blacklistedApps: map[string]bool{
-			"Steam":   true,
-			"Discord": true,
-		},
 	}
+	for _, app := range []string{"Steam", "Discord"} {
+		rule, _ := domain.NewPatternRule(domain.PatternFieldApp, domain.PatternGlob, app, domain.ActionCloseApp, 0)
+		mock.patternRules = append(mock.patternRules, rule)
+	}
+	return mock
 }
 
-func (m *MockBlacklistPort) IsBlacklisted(url string) bool {
+func (m *MockBlacklistPort) IsBlacklisted(ctx domain.RuleContext, url string) bool {
 	return m.blacklistedURLs[url]
 }
 
-func (m *MockBlacklistPort) IsAppBlacklisted(appName string) bool {
-	return m.blacklistedApps[appName]
+func (m *MockBlacklistPort) MatchApp(ctx domain.RuleContext, appName string) (domain.PatternRule, bool) {
+	return m.match(domain.PatternFieldApp, appName)
+}
+
+func (m *MockBlacklistPort) MatchWindowTitle(ctx domain.RuleContext, title string) (domain.PatternRule, bool) {
+	return m.match(domain.PatternFieldWindowTitle, title)
+}
+
+func (m *MockBlacklistPort) PolicyChain(clientID string) []domain.ScopedPolicy {
+	return nil
+}
+
+func (m *MockBlacklistPort) InLockdown(clientID string) bool {
+	return false
+}
+
+func (m *MockBlacklistPort) match(field domain.PatternField, value string) (domain.PatternRule, bool) {
+	for _, rule := range m.patternRules {
+		if rule.Field == field && rule.Matches(value) {
+			return rule, true
+		}
+	}
+	return domain.PatternRule{}, false
 }
 
 // MockCommandPort 테스트용 Mock
@@ -140,6 +167,36 @@ func TestReflexService_ProcessActivity_BlacklistedApp(t *testing.T) {
 	}
 }
 
+func TestReflexService_ProcessHeartbeat_WindowTitle(t *testing.T) {
+	blacklistPort := NewMockBlacklistPort()
+	rule, err := domain.NewPatternRule(domain.PatternFieldWindowTitle, domain.PatternRegex, `- youtube`, domain.ActionMinimizeAll, 6)
+	if err != nil {
+		t.Fatalf("NewPatternRule failed: %v", err)
+	}
+	blacklistPort.patternRules = append(blacklistPort.patternRules, rule)
+	commandPort := &MockCommandPort{}
+	dataRelayPort := &MockDataRelayPort{}
+
+	service := NewReflexService(blacklistPort, commandPort, dataRelayPort)
+
+	action, err := service.ProcessHeartbeat(domain.Heartbeat{ClientID: "client-123", ActiveWindowTitle: "Lofi Mix - YouTube - Chrome"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if action == nil || action.ActionType != domain.ActionMinimizeAll || action.Intensity != 6 {
+		t.Fatalf("Expected MINIMIZE_ALL with intensity 6, got %+v", action)
+	}
+
+	action, _ = service.ProcessHeartbeat(domain.Heartbeat{ClientID: "client-123", ActiveWindowTitle: "main.go - VS Code"})
+	if action != nil {
+		t.Errorf("Expected no action for allowed window, got %+v", action)
+	}
+	if len(commandPort.SentCommands) != 1 || len(dataRelayPort.RelayedActivities) != 0 {
+		t.Errorf("Expected one command and no relay, got %d commands, %d relays",
+			len(commandPort.SentCommands), len(dataRelayPort.RelayedActivities))
+	}
+}
+
 // MockPhysicalControlPort 테스트용 Mock
 type MockPhysicalControlPort struct {
 	SentCommands []domain.SabotageAction
@@ -154,6 +211,10 @@ func (m *MockPhysicalControlPort) SendToPhysicalController(cmd domain.SabotageAc
 type MockScreenControlPort struct {
 	SentCommands []domain.SabotageAction
 	AIResults    []string
+	Gauges       []domain.ScoreSnapshot
+	GaugeErr     error // 설정 시 게이지 전송 실패 (미연결 클라이언트)
+	Messages     []string
+	Achievements []domain.Achievement
 }
 
 func (m *MockScreenControlPort) SendToScreenController(cmd domain.SabotageAction) error {
@@ -166,6 +227,24 @@ func (m *MockScreenControlPort) SendAIResult(clientID string, markdown string) e
 	return nil
 }
 
+func (m *MockScreenControlPort) UpdateScoreGauge(snapshot domain.ScoreSnapshot) error {
+	if m.GaugeErr != nil {
+		return m.GaugeErr
+	}
+	m.Gauges = append(m.Gauges, snapshot)
+	return nil
+}
+
+func (m *MockScreenControlPort) ShowMessage(clientID string, message string) error {
+	m.Messages = append(m.Messages, message)
+	return nil
+}
+
+func (m *MockScreenControlPort) NotifyAchievement(clientID string, achievement domain.Achievement) error {
+	m.Achievements = append(m.Achievements, achievement)
+	return nil
+}
+
 func TestCommandRouterService_HandleStateChange_Sleeping(t *testing.T) {
 	physicalPort := &MockPhysicalControlPort{}
 	screenPort := &MockScreenControlPort{}
@@ -223,3 +302,1865 @@ func TestSolutionRouterService_RouteAIResult(t *testing.T) {
 		t.Errorf("Expected 1 AI result sent, got %d", len(screenPort.AIResults))
 	}
 }
+
+func TestScoreBoardService_ProcessHeartbeat(t *testing.T) {
+	service := NewScoreBoardService(NewScoreService())
+
+	snapshot := service.ProcessHeartbeat(domain.Heartbeat{
+		ClientID:           "client-123",
+		KeystrokeCount:     5,
+		ConcentrationScore: 0.8,
+		Timestamp:          time.Now(),
+	})
+
+	if snapshot.State != ScoreStateFocusing {
+		t.Errorf("Expected State FOCUSING, got '%s'", snapshot.State)
+	}
+	// (80 * 0.6) + (100 * 0.4) = 88
+	if snapshot.Score != 88 {
+		t.Errorf("Expected Score 88, got %d", snapshot.Score)
+	}
+
+	latest, exists := service.GetLatestScore("client-123")
+	if !exists {
+		t.Fatal("Expected latest score to exist")
+	}
+	if latest.Score != snapshot.Score {
+		t.Errorf("Expected latest Score %d, got %d", snapshot.Score, latest.Score)
+	}
+
+	if _, exists := service.GetLatestScore("unknown"); exists {
+		t.Error("Expected no score for unknown client")
+	}
+}
+
+func TestScoreBoardService_EyesClosedAccumulates(t *testing.T) {
+	service := NewScoreBoardService(NewScoreService())
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	var snapshot domain.ScoreSnapshot
+	for i := 0; i < 3; i++ {
+		snapshot = service.ProcessHeartbeat(domain.Heartbeat{
+			ClientID:           "client-123",
+			IsEyesClosed:       true,
+			ConcentrationScore: 0.6,
+			Timestamp:          start.Add(time.Duration(i) * time.Second),
+		})
+		if i < 2 && snapshot.State == ScoreStateSleeping {
+			t.Fatalf("Should not be SLEEPING after %d closed heartbeats", i+1)
+		}
+	}
+
+	if snapshot.State != ScoreStateSleeping {
+		t.Errorf("Expected State SLEEPING after 3s eyes closed, got '%s'", snapshot.State)
+	}
+}
+
+func TestScoreBoardService_DecayUsesPreviousScore(t *testing.T) {
+	service := NewScoreBoardService(NewScoreService())
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	// 입력 없음 + 시선 양호 → THINKING (90점)
+	service.ProcessHeartbeat(domain.Heartbeat{ClientID: "client-123", ConcentrationScore: 0.9, Timestamp: start})
+
+	// 입력 없음 + 시선 이탈 → 직전 점수에서 5점 감점
+	snapshot := service.ProcessHeartbeat(domain.Heartbeat{ClientID: "client-123", ConcentrationScore: 0.2, Timestamp: start.Add(time.Second)})
+
+	if snapshot.State != ScoreStateIdling {
+		t.Errorf("Expected State IDLING, got '%s'", snapshot.State)
+	}
+	if snapshot.Score != 85 {
+		t.Errorf("Expected Score 85 (90 - 5), got %d", snapshot.Score)
+	}
+}
+
+func TestScoreBoardService_EndSession(t *testing.T) {
+	service := NewScoreBoardService(NewScoreService())
+	service.ProcessHeartbeat(domain.Heartbeat{ClientID: "client-123", KeystrokeCount: 2, Timestamp: time.Now()})
+
+	service.EndSession("client-123")
+
+	if _, exists := service.GetLatestScore("client-123"); exists {
+		t.Error("Expected session to be removed after EndSession")
+	}
+}
+
+func TestScoreService_ModelVersionRecorded(t *testing.T) {
+	service := NewScoreService()
+
+	result := service.CalculateScore(CalculateInput{OSActivityCount: 1, VisionScore: 50})
+	if result.ModelVersion != domain.DefaultScoreModelVersion {
+		t.Errorf("Expected ModelVersion '%s', got '%s'", domain.DefaultScoreModelVersion, result.ModelVersion)
+	}
+}
+
+func TestScoreService_UpdateScoreModel(t *testing.T) {
+	service := NewScoreService()
+
+	model := domain.DefaultScoreModel()
+	model.Version = "cohort-b"
+	model.SleepEyesClosedSec = 5.0
+
+	if err := service.UpdateScoreModel(model); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// 기본 모델에서는 3초면 졸음이지만 cohort-b는 5초
+	result := service.CalculateScore(CalculateInput{EyesClosedDurationSec: 3.0, VisionScore: 60})
+	if result.State == ScoreStateSleeping {
+		t.Error("Should not be SLEEPING with 5s threshold")
+	}
+	if result.ModelVersion != "cohort-b" {
+		t.Errorf("Expected ModelVersion 'cohort-b', got '%s'", result.ModelVersion)
+	}
+}
+
+func TestScoreService_UpdateScoreModel_InvalidKeepsPrevious(t *testing.T) {
+	service := NewScoreService()
+
+	model := domain.DefaultScoreModel()
+	model.Version = "broken"
+	model.VisionWeight = 0.9 // 가중치 합 1.3
+
+	if err := service.UpdateScoreModel(model); err == nil {
+		t.Error("Expected validation error for invalid weights")
+	}
+	if got := service.CurrentScoreModel().Version; got != domain.DefaultScoreModelVersion {
+		t.Errorf("Expected previous model to be kept, got '%s'", got)
+	}
+}
+
+func TestScoreService_Breakdown(t *testing.T) {
+	service := NewScoreService()
+
+	tests := []struct {
+		name     string
+		input    CalculateInput
+		expected domain.ScoreBreakdown
+	}{
+		{
+			name:     "Focusing splits vision and activity",
+			input:    CalculateInput{OSActivityCount: 5, VisionScore: 80, CurrentScore: 70},
+			expected: domain.ScoreBreakdown{EyeFocus: 48, Activity: 40},
+		},
+		{
+			name:     "Eyes closed blames eye focus",
+			input:    CalculateInput{EyesClosedDurationSec: 3.0, CurrentScore: 75},
+			expected: domain.ScoreBreakdown{Carried: 75, EyeFocus: -75},
+		},
+		{
+			name:     "Head down blames head pose",
+			input:    CalculateInput{HeadPitch: -30, CurrentScore: 60},
+			expected: domain.ScoreBreakdown{Carried: 60, HeadPose: -60},
+		},
+		{
+			name:     "Play URL blames activity",
+			input:    CalculateInput{URLCategory: "PLAY", CurrentScore: 70},
+			expected: domain.ScoreBreakdown{Carried: 70, Activity: -60},
+		},
+		{
+			name:     "Idling records decay",
+			input:    CalculateInput{VisionScore: 20, CurrentScore: 3},
+			expected: domain.ScoreBreakdown{Carried: 3, Idle: -3},
+		},
+		{
+			name:     "Thinking raises score through eye focus",
+			input:    CalculateInput{VisionScore: 80, CurrentScore: 60},
+			expected: domain.ScoreBreakdown{Carried: 60, EyeFocus: 30},
+		},
+		{
+			name:     "Thinking keeps a higher score",
+			input:    CalculateInput{VisionScore: 80, CurrentScore: 95},
+			expected: domain.ScoreBreakdown{Carried: 95},
+		},
+		{
+			name:     "Neutral carries the score",
+			input:    CalculateInput{VisionScore: 60, CurrentScore: 55},
+			expected: domain.ScoreBreakdown{Carried: 55},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := service.CalculateScore(tt.input)
+			if result.Breakdown != tt.expected {
+				t.Errorf("Breakdown = %+v, want %+v", result.Breakdown, tt.expected)
+			}
+			// 모든 구간에서 항목 합 = 최종 점수
+			if result.Breakdown.Total() != result.FinalScore {
+				t.Errorf("Breakdown total %d != final score %d", result.Breakdown.Total(), result.FinalScore)
+			}
+		})
+	}
+}
+
+func TestScoreService_TypingQuality(t *testing.T) {
+	service := NewScoreService()
+
+	tests := []struct {
+		name          string
+		input         CalculateInput
+		expectedScore int
+		expected      domain.ScoreBreakdown
+	}{
+		{
+			name: "Steady typing earns bonus",
+			input: CalculateInput{OSActivityCount: 5, KeystrokeCount: 5, KeyboardEntropy: 3.5, AvgDwellTimeMs: 90,
+				VisionScore: 80},
+			expectedScore: 98,
+			expected:      domain.ScoreBreakdown{EyeFocus: 48, Activity: 40, Typing: 10},
+		},
+		{
+			name: "Key mashing is not productive input",
+			input: CalculateInput{OSActivityCount: 5, RawOSActivityCount: 5, KeystrokeCount: 5, KeyboardEntropy: 0.5, AvgDwellTimeMs: 90,
+				VisionScore: 80},
+			expectedScore: 38,
+			expected:      domain.ScoreBreakdown{EyeFocus: 48, Activity: 0, Typing: -10},
+		},
+		{
+			// 평활화된 활동량(2)이 아니라 같은 1초의 원시 활동량(키 6 + 클릭/마우스 2)에서 키 입력을 뺌
+			name: "Mashing keeps the same second's mouse input",
+			input: CalculateInput{OSActivityCount: 2, RawOSActivityCount: 8, KeystrokeCount: 6, KeyboardEntropy: 0.5, AvgDwellTimeMs: 90,
+				VisionScore: 80},
+			expectedScore: 54,
+			expected:      domain.ScoreBreakdown{EyeFocus: 48, Activity: 16, Typing: -10},
+		},
+		{
+			name: "Bonus is capped at 100",
+			input: CalculateInput{OSActivityCount: 5, KeystrokeCount: 5, KeyboardEntropy: 3.5, AvgDwellTimeMs: 90,
+				VisionScore: 100},
+			expectedScore: 100,
+			expected:      domain.ScoreBreakdown{EyeFocus: 60, Activity: 40, Typing: 0},
+		},
+		{
+			name:          "No dwell reported leaves score unchanged",
+			input:         CalculateInput{OSActivityCount: 5, KeystrokeCount: 5, VisionScore: 80},
+			expectedScore: 88,
+			expected:      domain.ScoreBreakdown{EyeFocus: 48, Activity: 40},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := service.CalculateScore(tt.input)
+			if result.State != ScoreStateFocusing {
+				t.Errorf("State = %s, want FOCUSING", result.State)
+			}
+			if result.FinalScore != tt.expectedScore {
+				t.Errorf("FinalScore = %d, want %d", result.FinalScore, tt.expectedScore)
+			}
+			if result.Breakdown != tt.expected {
+				t.Errorf("Breakdown = %+v, want %+v", result.Breakdown, tt.expected)
+			}
+		})
+	}
+}
+
+// MockStateReceiverUseCase 테스트용 Mock
+type MockStateReceiverUseCase struct {
+	ReceivedCommands []domain.StateCommand
+}
+
+func (m *MockStateReceiverUseCase) HandleStateChange(cmd domain.StateCommand) error {
+	m.ReceivedCommands = append(m.ReceivedCommands, cmd)
+	return nil
+}
+
+func TestStateDeciderService_ConfirmAndDwell(t *testing.T) {
+	receiver := &MockStateReceiverUseCase{}
+	decider := NewStateDeciderService(DefaultStateDeciderConfig(), receiver)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	snapshot := func(sec int, score int, state string) domain.ScoreSnapshot {
+		return domain.ScoreSnapshot{ClientID: "client-123", Score: score, State: state,
+			Timestamp: start.Add(time.Duration(sec) * time.Second)}
+	}
+
+	// 후보가 Confirm(2초) 동안 유지되어야 첫 상태 확정
+	if cmd := decider.Decide(snapshot(0, 70, ScoreStateFocusing)); cmd != nil {
+		t.Fatalf("Expected no command before confirm, got %s", cmd.State)
+	}
+	cmd := decider.Decide(snapshot(2, 72, ScoreStateFocusing))
+	if cmd == nil || cmd.State != domain.StateFocused {
+		t.Fatalf("Expected FOCUSED after confirm, got %v", cmd)
+	}
+
+	// 잠깐 떨어졌다 돌아오면 전이 없음
+	decider.Decide(snapshot(3, 40, ScoreStateFocusing))
+	if cmd := decider.Decide(snapshot(4, 70, ScoreStateFocusing)); cmd != nil {
+		t.Errorf("Expected no flap, got %s", cmd.State)
+	}
+
+	// 후보가 Confirm을 넘겨도 확정 후 MinDwell(5초) 전에는 전이 없음
+	decider.Decide(snapshot(4, 40, ScoreStateFocusing))
+	if cmd := decider.Decide(snapshot(6, 40, ScoreStateFocusing)); cmd != nil {
+		t.Errorf("Expected MinDwell to hold state, got %s", cmd.State)
+	}
+	cmd = decider.Decide(snapshot(7, 40, ScoreStateFocusing))
+	if cmd == nil || cmd.State != domain.StateDistracted {
+		t.Fatalf("Expected DISTRACTED after dwell, got %v", cmd)
+	}
+
+	if len(receiver.ReceivedCommands) != 2 {
+		t.Errorf("Expected 2 commands routed, got %d", len(receiver.ReceivedCommands))
+	}
+}
+
+func TestStateDeciderService_Hysteresis(t *testing.T) {
+	config := DefaultStateDeciderConfig()
+	config.Confirm = 0
+	config.MinDwell = 0
+	decider := NewStateDeciderService(config, nil)
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	decider.Decide(domain.ScoreSnapshot{ClientID: "c", Score: 70, State: ScoreStateFocusing, Timestamp: now})
+
+	// 경계(50) 바로 아래는 히스테리시스(5) 안이므로 FOCUSED 유지
+	if cmd := decider.Decide(domain.ScoreSnapshot{ClientID: "c", Score: 48, State: ScoreStateFocusing, Timestamp: now}); cmd != nil {
+		t.Errorf("Expected FOCUSED to hold within hysteresis, got %s", cmd.State)
+	}
+	cmd := decider.Decide(domain.ScoreSnapshot{ClientID: "c", Score: 44, State: ScoreStateFocusing, Timestamp: now})
+	if cmd == nil || cmd.State != domain.StateDistracted {
+		t.Errorf("Expected DISTRACTED beyond hysteresis, got %v", cmd)
+	}
+}
+
+func TestStateDeciderService_SleepingIsImmediate(t *testing.T) {
+	receiver := &MockStateReceiverUseCase{}
+	decider := NewStateDeciderService(DefaultStateDeciderConfig(), receiver)
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	decider.Decide(domain.ScoreSnapshot{ClientID: "c", Score: 70, State: ScoreStateFocusing, Timestamp: now})
+	decider.Decide(domain.ScoreSnapshot{ClientID: "c", Score: 70, State: ScoreStateFocusing, Timestamp: now.Add(2 * time.Second)})
+
+	cmd := decider.Decide(domain.ScoreSnapshot{ClientID: "c", Score: 0, State: ScoreStateSleeping, Timestamp: now.Add(3 * time.Second)})
+	if cmd == nil || cmd.State != domain.StateSleeping {
+		t.Fatalf("Expected immediate SLEEPING, got %v", cmd)
+	}
+	if !cmd.RequiresImmediateAction() {
+		t.Error("SLEEPING command should require immediate action")
+	}
+}
+
+// MockScoreHistoryPort 테스트용 점수 이력 저장소
+type MockScoreHistoryPort struct {
+	Appended        []domain.ScoreSnapshot
+	QueryResolution domain.Resolution
+}
+
+func (m *MockScoreHistoryPort) AppendScore(snapshot domain.ScoreSnapshot) error {
+	m.Appended = append(m.Appended, snapshot)
+	return nil
+}
+
+func (m *MockScoreHistoryPort) QueryScores(clientID string, from, to time.Time, resolution domain.Resolution) ([]domain.ScoreSample, error) {
+	m.QueryResolution = resolution
+	return []domain.ScoreSample{}, nil
+}
+
+func TestScoreBoardService_RecordsHistory(t *testing.T) {
+	history := &MockScoreHistoryPort{}
+	board := NewScoreBoardService(NewScoreService())
+	board.SetScoreHistory(history)
+
+	snapshot := board.ProcessHeartbeat(domain.Heartbeat{ClientID: "client-1", KeystrokeCount: 3, Timestamp: time.Now()})
+
+	if len(history.Appended) != 1 || history.Appended[0] != snapshot {
+		t.Errorf("Expected snapshot to be recorded, got %+v", history.Appended)
+	}
+}
+
+func TestScoreHistoryService_AutoResolution(t *testing.T) {
+	history := &MockScoreHistoryPort{}
+	historyService := NewScoreHistoryService(history)
+	to := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		span time.Duration
+		want domain.Resolution
+	}{
+		{30 * time.Minute, domain.ResolutionSecond},
+		{24 * time.Hour, domain.ResolutionMinute},
+		{7 * 24 * time.Hour, domain.ResolutionHour},
+	}
+	for _, tt := range tests {
+		_, resolution, err := historyService.GetScoreHistory("client-1", to.Add(-tt.span), to, "")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if resolution != tt.want || history.QueryResolution != tt.want {
+			t.Errorf("span %v: resolution = %s, want %s", tt.span, resolution, tt.want)
+		}
+	}
+
+	if _, _, err := historyService.GetScoreHistory("client-1", to, to.Add(-time.Hour), ""); err == nil {
+		t.Error("Expected error when from is after to")
+	}
+}
+
+func TestScoreGaugeService_PushesLatestChange(t *testing.T) {
+	screenPort := &MockScreenControlPort{}
+	gauge := NewScoreGaugeService(screenPort, time.Second)
+	board := NewScoreBoardService(NewScoreService())
+	board.AddScoreListener(gauge)
+
+	start := time.Now()
+	board.ProcessHeartbeat(domain.Heartbeat{ClientID: "client-1", KeystrokeCount: 5, ConcentrationScore: 0.8, Timestamp: start})
+	board.ProcessHeartbeat(domain.Heartbeat{ClientID: "client-1", KeystrokeCount: 5, ConcentrationScore: 0.5, Timestamp: start.Add(time.Second)})
+	gauge.Flush()
+
+	// 주기 안의 변경은 마지막 값 하나만 전송
+	if len(screenPort.Gauges) != 1 {
+		t.Fatalf("Expected 1 gauge update, got %d", len(screenPort.Gauges))
+	}
+	pushed := screenPort.Gauges[0]
+
+	// 같은 점수/상태는 다시 전송하지 않음
+	gauge.OnScore(pushed)
+	gauge.Flush()
+	if len(screenPort.Gauges) != 1 {
+		t.Errorf("Expected unchanged score not to be pushed, got %d updates", len(screenPort.Gauges))
+	}
+
+	// 세션 종료 후에는 같은 값도 다시 전송
+	board.EndSession("client-1")
+	gauge.OnScore(pushed)
+	gauge.Flush()
+	if len(screenPort.Gauges) != 2 {
+		t.Errorf("Expected gauge to be pushed after session reset, got %d updates", len(screenPort.Gauges))
+	}
+}
+
+func TestScoreGaugeService_FailedSendIsNotRecorded(t *testing.T) {
+	screenPort := &MockScreenControlPort{GaugeErr: errors.New("client not connected")}
+	gauge := NewScoreGaugeService(screenPort, time.Second)
+	snapshot := domain.ScoreSnapshot{ClientID: "client-1", Score: 80, State: "FOCUSING"}
+
+	gauge.OnScore(snapshot)
+	gauge.Flush()
+
+	// 전송 실패한 값은 마지막 전송 값으로 기록되지 않으므로 같은 값이 다시 전송됨
+	screenPort.GaugeErr = nil
+	gauge.OnScore(snapshot)
+	gauge.Flush()
+	if len(screenPort.Gauges) != 1 {
+		t.Fatalf("Expected the unsent value to be pushed after reconnect, got %d updates", len(screenPort.Gauges))
+	}
+}
+
+// MockEmergencyUseCase 테스트용 Mock
+type MockEmergencyUseCase struct {
+	ScreamTexts []string
+}
+
+func (m *MockEmergencyUseCase) HandleEmergency(clientID string, errorLog string, screamText string) error {
+	m.ScreamTexts = append(m.ScreamTexts, screamText)
+	return nil
+}
+
+func TestCommandRouterService_HandleStateChange_EmergencyPayload(t *testing.T) {
+	router := NewCommandRouterService(&MockPhysicalControlPort{}, &MockScreenControlPort{})
+	emergency := &MockEmergencyUseCase{}
+	router.SetEmergencyHandler(emergency)
+
+	cmd := domain.NewStateCommand("client-123", domain.StateEmergency).
+		WithPayload(domain.EmergencyPayload{ScreamText: "살려줘"}.Encode())
+	if err := router.HandleStateChange(*cmd); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(emergency.ScreamTexts) != 1 || emergency.ScreamTexts[0] != "살려줘" {
+		t.Errorf("Expected scream text from payload, got %v", emergency.ScreamTexts)
+	}
+}
+
+// ChanStateReceiverUseCase 비동기 전달 확인용 Mock
+type ChanStateReceiverUseCase struct {
+	Commands chan domain.StateCommand
+}
+
+func (m *ChanStateReceiverUseCase) HandleStateChange(cmd domain.StateCommand) error {
+	m.Commands <- cmd
+	return nil
+}
+
+// loudPCM 0.5초 분량(16kHz) 16-bit PCM 생성
+func loudPCM(amplitude int16) []byte {
+	data := make([]byte, 8000*2)
+	for i := 0; i < 8000; i++ {
+		data[2*i] = byte(uint16(amplitude))
+		data[2*i+1] = byte(uint16(amplitude) >> 8)
+	}
+	return data
+}
+
+func TestAudioMonitorService_SustainedLoudness(t *testing.T) {
+	receiver := &ChanStateReceiverUseCase{Commands: make(chan domain.StateCommand, 1)}
+	monitor := NewAudioMonitorService(DefaultAudioEmergencyConfig(), receiver)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	chunk := func(amplitude int16, offset time.Duration, transcript string) domain.AudioChunk {
+		return domain.AudioChunk{ClientID: "client-1", AudioData: loudPCM(amplitude), Transcript: transcript, Timestamp: start.Add(offset)}
+	}
+
+	// 큰 소리 0.5초 → 조용 → 큰 소리 0.5초: 연속이 아니므로 발생하지 않음
+	if monitor.ProcessAudioChunk(chunk(30000, 0, "")) {
+		t.Fatal("Expected no emergency after 0.5s")
+	}
+	monitor.ProcessAudioChunk(chunk(100, 500*time.Millisecond, ""))
+	if monitor.ProcessAudioChunk(chunk(30000, time.Second, "살려줘")) {
+		t.Fatal("Expected quiet chunk to reset sustained loudness")
+	}
+
+	// 1초 지속 → EMERGENCY (최신 STT 결과 첨부)
+	if !monitor.ProcessAudioChunk(chunk(30000, 1500*time.Millisecond, "")) {
+		t.Fatal("Expected emergency after sustained loudness")
+	}
+	select {
+	case cmd := <-receiver.Commands:
+		if cmd.State != domain.StateEmergency {
+			t.Errorf("Expected EMERGENCY, got %s", cmd.State)
+		}
+		if payload := domain.ParseEmergencyPayload(cmd.Payload); payload.ScreamText != "살려줘" {
+			t.Errorf("Expected latest transcript in payload, got %+v", payload)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected state command to be delivered")
+	}
+
+	// 쿨다운 중에는 다시 발생하지 않음
+	monitor.ProcessAudioChunk(chunk(30000, 2*time.Second, ""))
+	if monitor.ProcessAudioChunk(chunk(30000, 2500*time.Millisecond, "")) {
+		t.Error("Expected cooldown to suppress repeated emergency")
+	}
+
+	// 연결 종료 시 음량 상태는 제거하되 쿨다운은 유지
+	monitor.now = func() time.Time { return start.Add(3 * time.Second) }
+	monitor.Forget("client-1")
+	if transcript := monitor.LatestTranscript("client-1"); transcript != "" {
+		t.Errorf("Expected state to be forgotten, got transcript %q", transcript)
+	}
+	monitor.ProcessAudioChunk(chunk(30000, 4*time.Second, ""))
+	if monitor.ProcessAudioChunk(chunk(30000, 4500*time.Millisecond, "")) {
+		t.Error("Expected cooldown to survive a reconnect")
+	}
+
+	// 쿨다운이 끝나면 연결 종료 클라이언트 상태 제거
+	monitor.now = func() time.Time { return start.Add(time.Minute) }
+	monitor.Forget("client-1")
+	if len(monitor.clients) != 0 {
+		t.Errorf("Expected no tracked clients after cooldown, got %d", len(monitor.clients))
+	}
+}
+
+// MockGamificationStore 테스트용 Mock
+type MockGamificationStore struct {
+	Saved   map[string]domain.GamificationProgress
+	SaveErr error // 설정 시 저장 실패
+}
+
+func (m *MockGamificationStore) LoadProgress(clientID string) (*domain.GamificationProgress, bool, error) {
+	progress, exists := m.Saved[clientID]
+	if !exists {
+		return nil, false, nil
+	}
+	return &progress, true, nil
+}
+
+func (m *MockGamificationStore) SaveProgress(progress *domain.GamificationProgress) error {
+	if m.SaveErr != nil {
+		return m.SaveErr
+	}
+	m.Saved[progress.ClientID] = *progress
+	return nil
+}
+
+func TestGamificationService_AwardsXPAndLevelsUp(t *testing.T) {
+	store := &MockGamificationStore{Saved: make(map[string]domain.GamificationProgress)}
+	screen := &MockScreenControlPort{}
+	config := DefaultGamificationConfig()
+	config.Curve = domain.LevelCurve{BaseXP: 5, Exponent: 1}
+	gamification := NewGamificationService(config, store, screen)
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	// FOCUSING 4초(4 XP) + SLEEPING 1초(0 XP) + THINKING 1초(2 XP) → Lv.2
+	states := []string{ScoreStateFocusing, ScoreStateFocusing, ScoreStateFocusing, ScoreStateFocusing, ScoreStateSleeping, ScoreStateThinking}
+	for i, state := range states {
+		gamification.OnScore(domain.ScoreSnapshot{ClientID: "client-1", Score: 60, State: state, Timestamp: start.Add(time.Duration(i) * time.Second)})
+	}
+
+	info, err := gamification.GetGamificationInfo("client-1")
+	if err != nil {
+		t.Fatalf("GetGamificationInfo failed: %v", err)
+	}
+	if info.Level != 2 || info.Experience != 1 || info.NextLevelExp != 10 {
+		t.Errorf("Expected Lv.2 with 1/10 XP, got %+v", info)
+	}
+	if info.TotalStudySeconds != 5 || info.AverageScore != 60 {
+		t.Errorf("Expected 5 study seconds and average 60, got %+v", info)
+	}
+	if len(screen.Messages) != 1 {
+		t.Errorf("Expected one level-up message, got %v", screen.Messages)
+	}
+
+	// 세션 종료 시 저장되고, 이후 조회는 저장소에서 읽음
+	gamification.Forget("client-1")
+	if saved, exists := store.Saved["client-1"]; !exists || saved.TotalXP != 6 {
+		t.Fatalf("Expected progress to be saved on session end, got %+v", saved)
+	}
+	info, _ = gamification.GetGamificationInfo("client-1")
+	if info.Level != 2 {
+		t.Errorf("Expected level to survive session end, got %+v", info)
+	}
+}
+
+func TestGamificationService_RetriesFailedSaveAfterForget(t *testing.T) {
+	store := &MockGamificationStore{Saved: make(map[string]domain.GamificationProgress), SaveErr: errors.New("disk full")}
+	gamification := NewGamificationService(DefaultGamificationConfig(), store, &MockScreenControlPort{})
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 3; i++ {
+		gamification.OnScore(domain.ScoreSnapshot{ClientID: "client-1", Score: 80, State: ScoreStateFocusing, Timestamp: start.Add(time.Duration(i) * time.Second)})
+	}
+
+	// 세션 종료 시 저장 실패 → 메모리에서 빠져도 재시도 목록에 남음
+	gamification.Forget("client-1")
+	if _, exists := store.Saved["client-1"]; exists {
+		t.Fatal("Expected save to fail")
+	}
+	if info, _ := gamification.GetGamificationInfo("client-1"); info.TotalStudySeconds != 3 {
+		t.Errorf("Expected unsaved progress to stay readable, got %+v", info)
+	}
+
+	store.SaveErr = nil
+	gamification.Flush()
+	if saved := store.Saved["client-1"]; saved.TotalXP != 3 {
+		t.Fatalf("Expected failed save to be retried on flush, got %+v", saved)
+	}
+
+	// 재시도가 끝나면 다시 저장하지 않음
+	delete(store.Saved, "client-1")
+	gamification.Flush()
+	if _, exists := store.Saved["client-1"]; exists {
+		t.Error("Expected retry list to be cleared after a successful save")
+	}
+}
+
+func TestGamificationService_ReconnectKeepsUnsavedProgress(t *testing.T) {
+	store := &MockGamificationStore{Saved: make(map[string]domain.GamificationProgress), SaveErr: errors.New("disk full")}
+	gamification := NewGamificationService(DefaultGamificationConfig(), store, &MockScreenControlPort{})
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	gamification.OnScore(domain.ScoreSnapshot{ClientID: "client-1", Score: 80, State: ScoreStateFocusing, Timestamp: start})
+	gamification.OnScore(domain.ScoreSnapshot{ClientID: "client-1", Score: 80, State: ScoreStateFocusing, Timestamp: start.Add(time.Second)})
+	gamification.Forget("client-1")
+
+	// 재접속하면 저장소의 옛 값이 아니라 저장 실패한 진행도에서 이어서 누적
+	gamification.OnScore(domain.ScoreSnapshot{ClientID: "client-1", Score: 80, State: ScoreStateFocusing, Timestamp: start.Add(time.Hour)})
+	store.SaveErr = nil
+	gamification.Flush()
+	if saved := store.Saved["client-1"]; saved.TotalXP != 3 || saved.ScoreCount != 3 {
+		t.Errorf("Expected reconnect to continue from unsaved progress, got %+v", saved)
+	}
+}
+
+func TestGamificationService_ConcurrentFlushAndForget(t *testing.T) {
+	store := &MockGamificationStore{Saved: make(map[string]domain.GamificationProgress)}
+	var mu sync.Mutex
+	lockedStore := &lockedGamificationStore{store: store, mu: &mu}
+	gamification := NewGamificationService(DefaultGamificationConfig(), lockedStore, &MockScreenControlPort{})
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 200; i++ {
+		gamification.OnScore(domain.ScoreSnapshot{ClientID: "client-1", Score: 80, State: ScoreStateFocusing, Timestamp: start.Add(time.Duration(i) * time.Second)})
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			gamification.Flush()
+		}()
+	}
+	gamification.Forget("client-1")
+	wg.Wait()
+
+	// 동시에 실행된 Flush가 오래된 스냅샷으로 최종 저장을 덮어쓰지 않음
+	mu.Lock()
+	defer mu.Unlock()
+	if saved := store.Saved["client-1"]; saved.ScoreCount != 200 {
+		t.Errorf("Expected the latest progress to be saved last, got %d scores", saved.ScoreCount)
+	}
+}
+
+// lockedGamificationStore 동시 저장 테스트용 (Mock 맵 보호)
+type lockedGamificationStore struct {
+	store *MockGamificationStore
+	mu    *sync.Mutex
+}
+
+func (l *lockedGamificationStore) LoadProgress(clientID string) (*domain.GamificationProgress, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.store.LoadProgress(clientID)
+}
+
+func (l *lockedGamificationStore) SaveProgress(progress *domain.GamificationProgress) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.store.SaveProgress(progress)
+}
+
+// MockAchievementStore 테스트용 Mock
+type MockAchievementStore struct {
+	Saved map[string]*domain.AchievementProgress
+	Saves int
+}
+
+func (m *MockAchievementStore) LoadAchievements(clientID string) (*domain.AchievementProgress, bool, error) {
+	progress, exists := m.Saved[clientID]
+	if !exists {
+		return nil, false, nil
+	}
+	return progress.Clone(), true, nil
+}
+
+func (m *MockAchievementStore) SaveAchievements(progress *domain.AchievementProgress) error {
+	m.Saved[progress.ClientID] = progress.Clone()
+	m.Saves++
+	return nil
+}
+
+func TestAchievementService_RecordEventSavesOnlyChanges(t *testing.T) {
+	store := &MockAchievementStore{Saved: make(map[string]*domain.AchievementProgress)}
+	achievements := NewAchievementService(DefaultAchievementConfig(), store, &MockScreenControlPort{})
+	err := achievements.UpdateAchievementRules(domain.AchievementRuleSet{
+		Version: "test",
+		Rules: []domain.AchievementRule{
+			{ID: "focus_1h", Type: domain.AchievementFocusDuration, Duration: time.Hour, ResetOn: []domain.ProgressEventType{domain.ProgressEventBlockURL}},
+			{ID: "resolved_3", Type: domain.AchievementEventCount, Event: domain.ProgressEventEmergencyResolved, Count: 3},
+		},
+	})
+	if err != nil {
+		t.Fatalf("UpdateAchievementRules failed: %v", err)
+	}
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	record := func(eventType domain.ProgressEventType) {
+		achievements.RecordEvent(domain.NewProgressEvent("client-1", eventType, start))
+	}
+
+	// 누적 시간이 없을 때의 초기화, 규칙과 무관한 이벤트는 저장하지 않음
+	record(domain.ProgressEventBlockURL)
+	record(domain.ProgressEventCloseApp)
+	achievements.Flush()
+	if store.Saves != 0 {
+		t.Fatalf("Expected no save for unchanged progress, got %d", store.Saves)
+	}
+
+	// 카운터 증가와 누적 시간 초기화는 저장
+	record(domain.ProgressEventEmergencyResolved)
+	achievements.Flush()
+	achievements.OnScore(domain.ScoreSnapshot{ClientID: "client-1", Score: 80, State: ScoreStateFocusing, Timestamp: start})
+	achievements.OnScore(domain.ScoreSnapshot{ClientID: "client-1", Score: 80, State: ScoreStateFocusing, Timestamp: start.Add(time.Second)})
+	achievements.Flush()
+	record(domain.ProgressEventBlockURL)
+	achievements.Flush()
+	if store.Saves != 3 {
+		t.Errorf("Expected 3 saves, got %d", store.Saves)
+	}
+	if saved := store.Saved["client-1"]; saved.Rules["resolved_3"].Count != 1 || saved.Rules["focus_1h"].Accumulated != 0 {
+		t.Errorf("Unexpected saved progress: %+v", saved.Rules)
+	}
+}
+
+func TestAchievementService_EvaluatesRules(t *testing.T) {
+	store := &MockAchievementStore{Saved: make(map[string]*domain.AchievementProgress)}
+	screen := &MockScreenControlPort{}
+	config := DefaultAchievementConfig()
+	config.Location = time.UTC
+	achievements := NewAchievementService(config, store, screen)
+
+	err := achievements.UpdateAchievementRules(domain.AchievementRuleSet{
+		Version: "test",
+		Rules: []domain.AchievementRule{
+			{ID: "focus_5s", Type: domain.AchievementFocusDuration, Duration: 5 * time.Second, ResetOn: []domain.ProgressEventType{domain.ProgressEventBlockURL}},
+			{ID: "streak_2d", Type: domain.AchievementDailyStreak, Days: 2, DailyMinimum: 2 * time.Second},
+			{ID: "first_resolved", Type: domain.AchievementEventCount, Event: domain.ProgressEventEmergencyResolved, Count: 1},
+		},
+	})
+	if err != nil {
+		t.Fatalf("UpdateAchievementRules failed: %v", err)
+	}
+
+	day1 := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	focus := func(at time.Time) {
+		achievements.OnScore(domain.ScoreSnapshot{ClientID: "client-1", Score: 70, State: ScoreStateFocusing, Timestamp: at})
+	}
+
+	// 3초 집중 후 BLOCK_URL → 누적 초기화, 다시 5초 집중해야 달성
+	for i := 0; i < 3; i++ {
+		focus(day1.Add(time.Duration(i) * time.Second))
+	}
+	achievements.RecordEvent(domain.NewProgressEvent("client-1", domain.ProgressEventBlockURL, day1.Add(3*time.Second)))
+	for i := 3; i < 7; i++ {
+		focus(day1.Add(time.Duration(i) * time.Second))
+	}
+	if len(screen.Achievements) != 0 {
+		t.Fatalf("Expected BLOCK_URL to reset focus run, got %+v", screen.Achievements)
+	}
+	focus(day1.Add(7 * time.Second))
+	if len(screen.Achievements) != 1 || screen.Achievements[0].ID != "focus_5s" {
+		t.Fatalf("Expected focus_5s unlocked, got %+v", screen.Achievements)
+	}
+
+	// 다음 날도 2초 이상 집중 → 2일 연속
+	day2 := day1.AddDate(0, 0, 1)
+	focus(day2)
+	focus(day2.Add(time.Second))
+	if len(screen.Achievements) != 2 || screen.Achievements[1].ID != "streak_2d" {
+		t.Fatalf("Expected streak_2d unlocked, got %+v", screen.Achievements)
+	}
+
+	achievements.RecordEvent(domain.NewProgressEvent("client-1", domain.ProgressEventEmergencyResolved, day2.Add(time.Minute)))
+	list, err := achievements.GetAchievements("client-1")
+	if err != nil {
+		t.Fatalf("GetAchievements failed: %v", err)
+	}
+	for _, achievement := range list {
+		if !achievement.Unlocked || achievement.UnlockedAt.IsZero() {
+			t.Errorf("Expected %s unlocked with time, got %+v", achievement.ID, achievement)
+		}
+	}
+
+	// 달성 기록은 즉시 저장됨
+	if saved := store.Saved["client-1"]; saved == nil || len(saved.UnlockedAt) != 3 {
+		t.Errorf("Expected unlocked achievements to be saved, got %+v", saved)
+	}
+}
+
+func TestAchievementService_DailyStreakUsesClientTimezone(t *testing.T) {
+	store := &MockAchievementStore{Saved: make(map[string]*domain.AchievementProgress)}
+	screen := &MockScreenControlPort{}
+	config := DefaultAchievementConfig()
+	config.Location = time.UTC
+	achievements := NewAchievementService(config, store, screen)
+	achievements.SetTimezones(fixedTimezones{location: time.FixedZone("KST", 9*3600)})
+	err := achievements.UpdateAchievementRules(domain.AchievementRuleSet{
+		Version: "test",
+		Rules:   []domain.AchievementRule{{ID: "streak_2d", Type: domain.AchievementDailyStreak, Days: 2, DailyMinimum: time.Second}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateAchievementRules failed: %v", err)
+	}
+	focus := func(at time.Time) {
+		achievements.OnScore(domain.ScoreSnapshot{ClientID: "client-1", Score: 70, State: ScoreStateFocusing, Timestamp: at})
+	}
+
+	// UTC로는 1/1, 1/2 이틀이지만 KST로는 둘 다 1/2
+	focus(time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC))
+	focus(time.Date(2024, 1, 2, 1, 0, 0, 0, time.UTC))
+	if len(screen.Achievements) != 0 {
+		t.Fatalf("Expected one client-local day, got %+v", screen.Achievements)
+	}
+
+	// KST 1/3
+	focus(time.Date(2024, 1, 2, 23, 0, 0, 0, time.UTC))
+	if len(screen.Achievements) != 1 || screen.Achievements[0].ID != "streak_2d" {
+		t.Errorf("Expected streak_2d on the next client-local day, got %+v", screen.Achievements)
+	}
+}
+
+// reentrantAchievementStore 저장 중 서비스를 다시 호출하는 Mock (락 보유 저장 검출)
+type reentrantAchievementStore struct {
+	MockAchievementStore
+	service *AchievementService
+}
+
+func (r *reentrantAchievementStore) SaveAchievements(progress *domain.AchievementProgress) error {
+	r.service.CurrentAchievementRules()
+	return r.MockAchievementStore.SaveAchievements(progress)
+}
+
+func TestAchievementService_SavesUnlockOutsideLock(t *testing.T) {
+	store := &reentrantAchievementStore{MockAchievementStore: MockAchievementStore{Saved: make(map[string]*domain.AchievementProgress)}}
+	achievements := NewAchievementService(DefaultAchievementConfig(), store, &MockScreenControlPort{})
+	store.service = achievements
+	err := achievements.UpdateAchievementRules(domain.AchievementRuleSet{
+		Version: "test",
+		Rules:   []domain.AchievementRule{{ID: "first_block", Type: domain.AchievementEventCount, Event: domain.ProgressEventBlockURL, Count: 1}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateAchievementRules failed: %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		achievements.RecordEvent(domain.NewProgressEvent("client-1", domain.ProgressEventBlockURL, time.Now()))
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected unlock to be saved without holding the service lock")
+	}
+	if saved := store.Saved["client-1"]; saved == nil || len(saved.UnlockedAt) != 1 {
+		t.Errorf("Expected unlock to be saved immediately, got %+v", saved)
+	}
+}
+
+func TestActivityUsageService_FactBombOnBlockURL(t *testing.T) {
+	config := DefaultActivityUsageConfig()
+	config.Location = time.UTC
+	config.MaxDwell = 3 * time.Hour
+	usage := NewActivityUsageService(config, memory.NewActivityUsageAdapter(memory.DefaultUsageRetentionDays))
+	monday := time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC)
+	usage.now = func() time.Time { return monday.Add(3 * time.Hour) }
+
+	visit := func(url string, at time.Time) domain.ClientActivity {
+		return domain.ClientActivity{ClientID: "client-1", URL: url, ActivityType: domain.ActivityURLVisit, Timestamp: at}
+	}
+
+	// 지난주 일요일 23:50 ~ 월요일 00:20 youtube (자정 분할), 이번 주 2시간 youtube
+	usage.RecordActivity(visit("https://www.youtube.com/watch?v=1", monday.Add(-9*time.Hour-10*time.Minute)))
+	usage.RecordActivity(domain.ClientActivity{ClientID: "client-1", ActivityType: domain.ActivityIdleStart, Timestamp: monday.Add(-8*time.Hour - 40*time.Minute)})
+	usage.RecordActivity(visit("youtube.com/shorts", monday))
+	usage.RecordActivity(visit("github.com", monday.Add(2*time.Hour)))
+
+	lastWeek, thisWeek := domain.PeriodLastWeek.Range(monday)
+	stats, _ := usage.GetUsage("client-1", lastWeek, thisWeek)
+	if len(stats) != 1 || stats[0].Seconds != 600 {
+		t.Errorf("Expected 10 minutes of youtube last week, got %+v", stats)
+	}
+
+	reflex := NewReflexService(NewMockBlacklistPort(), &MockCommandPort{}, &MockDataRelayPort{})
+	reflex.SetActivityUsage(usage)
+	reflex.SetFactBomb(usage)
+
+	action, err := reflex.ProcessActivity(visit("youtube.com", monday.Add(3*time.Hour)))
+	if err != nil || action == nil {
+		t.Fatalf("Expected BLOCK_URL action, got %v, %v", action, err)
+	}
+	expected := "차단된 URL에 접근하였습니다. 이번 주 youtube.com에서 2시간 20분 동안 머물렀습니다."
+	if action.Message != expected {
+		t.Errorf("Expected message %q, got %q", expected, action.Message)
+	}
+
+	// 차단된 URL은 체류로 치지 않음 (github 1시간만 누적)
+	usage.RecordActivity(visit("github.com", monday.Add(4*time.Hour)))
+	stats, _ = usage.GetUsage("client-1", thisWeek, thisWeek.AddDate(0, 0, 7))
+	for _, stat := range stats {
+		if stat.Target.Name == "github.com" && stat.Seconds != 3600 {
+			t.Errorf("Expected 1 hour of github, got %d seconds", stat.Seconds)
+		}
+	}
+
+	if _, exists, _ := usage.GetFactBomb("client-1", "netflix_detected", ""); exists {
+		t.Error("Expected no fact bomb without accumulated netflix time")
+	}
+}
+
+// MockStreakStore 테스트용 Mock
+type MockStreakStore struct {
+	Saved map[string]domain.StudyStreak
+}
+
+func (m *MockStreakStore) LoadStreak(clientID string) (*domain.StudyStreak, bool, error) {
+	streak, exists := m.Saved[clientID]
+	if !exists {
+		return nil, false, nil
+	}
+	return &streak, true, nil
+}
+
+func (m *MockStreakStore) SaveStreak(streak *domain.StudyStreak) error {
+	m.Saved[streak.ClientID] = *streak
+	return nil
+}
+
+func TestStreakService_GoalStreakAndWarning(t *testing.T) {
+	store := &MockStreakStore{Saved: make(map[string]domain.StudyStreak)}
+	screen := &MockScreenControlPort{}
+	config := DefaultStreakConfig()
+	config.Location = time.UTC
+	streaks := NewStreakService(config, store, screen)
+
+	day1 := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	streaks.now = func() time.Time { return day1 }
+	if _, err := streaks.SetDailyGoal("client-1", 2*time.Second, "Asia/Seoul"); err != nil {
+		t.Fatalf("SetDailyGoal failed: %v", err)
+	}
+	if _, err := streaks.SetDailyGoal("client-1", time.Second, "Mars/Olympus"); err == nil {
+		t.Error("Expected invalid timezone to be rejected")
+	}
+
+	focus := func(at time.Time, state string) {
+		streaks.OnScore(domain.ScoreSnapshot{ClientID: "client-1", Score: 70, State: state, Timestamp: at})
+	}
+
+	// 서울 기준 1/1, 1/2 연속 달성 (DISTRACTED는 누적하지 않음)
+	focus(day1, ScoreStateFocusing)
+	focus(day1.Add(time.Second), ScoreStateDistracted)
+	focus(day1.Add(2*time.Second), ScoreStateThinking)
+	day2 := day1.AddDate(0, 0, 1)
+	focus(day2, ScoreStateFocusing)
+	focus(day2.Add(time.Second), ScoreStateFocusing)
+	if len(screen.Messages) != 2 || screen.Messages[1] != "🔥 오늘 목표 달성! 2일 연속" {
+		t.Fatalf("Expected goal met messages, got %v", screen.Messages)
+	}
+
+	// 1/3 서울 22:00 (UTC 13:00) 목표 미달 → 하루 한 번 경고
+	streaks.now = func() time.Time { return time.Date(2024, 1, 3, 13, 0, 0, 0, time.UTC) }
+	streaks.CheckAtRisk()
+	streaks.CheckAtRisk()
+	if len(screen.Messages) != 3 || screen.Messages[2] != "⏰ 오늘 목표까지 2초 남았습니다. 2일 연속 기록이 끊기지 않게 집중해 보세요!" {
+		t.Fatalf("Expected one at-risk warning, got %v", screen.Messages)
+	}
+
+	status, err := streaks.GetStreak("client-1")
+	if err != nil {
+		t.Fatalf("GetStreak failed: %v", err)
+	}
+	if !status.AtRisk || status.CurrentStreak != 2 || status.Remaining != 2*time.Second || status.Timezone != "Asia/Seoul" {
+		t.Errorf("Unexpected streak status: %+v", status)
+	}
+
+	// 세션 종료 시 저장
+	streaks.Forget("client-1")
+	if saved := store.Saved["client-1"]; saved.BestStreak != 2 || saved.WarnedDay != "2024-01-03" {
+		t.Errorf("Expected streak saved on forget, got %+v", saved)
+	}
+}
+
+// MockLeaderboardStore 테스트용 Mock (LeaderboardMemberPort, DailyStatsPort)
+type MockLeaderboardStore struct {
+	Members map[string]domain.LeaderboardMember
+	Stats   map[string]domain.DailyStudyStats // key: clientID/day
+	SaveErr error                             // 설정 시 하루 기록 저장 실패
+	LoadErr error                             // 설정 시 멤버십 조회 실패
+}
+
+func NewMockLeaderboardStore() *MockLeaderboardStore {
+	return &MockLeaderboardStore{
+		Members: make(map[string]domain.LeaderboardMember),
+		Stats:   make(map[string]domain.DailyStudyStats),
+	}
+}
+
+func (m *MockLeaderboardStore) LoadMember(clientID string) (domain.LeaderboardMember, bool, error) {
+	if m.LoadErr != nil {
+		return domain.LeaderboardMember{}, false, m.LoadErr
+	}
+	member, exists := m.Members[clientID]
+	return member, exists, nil
+}
+
+func (m *MockLeaderboardStore) SaveMember(member domain.LeaderboardMember) error {
+	m.Members[member.ClientID] = member
+	return nil
+}
+
+func (m *MockLeaderboardStore) ListGroupMembers(groupID string) ([]domain.LeaderboardMember, error) {
+	var members []domain.LeaderboardMember
+	for _, member := range m.Members {
+		if member.GroupID == groupID {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+func (m *MockLeaderboardStore) LoadDailyStats(clientID, day string) (domain.DailyStudyStats, bool, error) {
+	stats, exists := m.Stats[clientID+"/"+day]
+	return stats, exists, nil
+}
+
+func (m *MockLeaderboardStore) SaveDailyStats(stats domain.DailyStudyStats) error {
+	if m.SaveErr != nil {
+		return m.SaveErr
+	}
+	m.Stats[stats.ClientID+"/"+stats.Day] = stats
+	return nil
+}
+
+func (m *MockLeaderboardStore) QueryDailyStats(clientID, fromDay, toDay string) ([]domain.DailyStudyStats, error) {
+	var result []domain.DailyStudyStats
+	for _, stats := range m.Stats {
+		if stats.ClientID == clientID && stats.Day >= fromDay && stats.Day <= toDay {
+			result = append(result, stats)
+		}
+	}
+	return result, nil
+}
+
+func TestLeaderboardService_RetriesPreviousDayAfterFailedSave(t *testing.T) {
+	store := NewMockLeaderboardStore()
+	config := DefaultLeaderboardConfig()
+	config.Location = time.UTC
+	leaderboard := NewLeaderboardService(config, store, store)
+	day1 := time.Date(2024, 1, 10, 23, 59, 58, 0, time.UTC)
+
+	store.SaveErr = errors.New("disk full")
+	for i := 0; i < 4; i++ {
+		leaderboard.OnScore(domain.ScoreSnapshot{ClientID: "client-1", Score: 80, State: ScoreStateFocusing, Timestamp: day1.Add(time.Duration(i) * time.Second)})
+	}
+
+	// 전날 기록 저장 실패 → 재시도 목록에 남아 조회에도 반영
+	days, err := leaderboard.GetDailyStats("client-1", day1.Truncate(24*time.Hour), day1.Add(48*time.Hour))
+	if err != nil {
+		t.Fatalf("GetDailyStats failed: %v", err)
+	}
+	if len(days) != 2 || days[0].Day != "2024-01-10" || days[0].ScoreCount != 2 || days[1].ScoreCount != 2 {
+		t.Fatalf("Expected unsaved previous day and today, got %+v", days)
+	}
+
+	store.SaveErr = nil
+	leaderboard.Flush()
+	if saved := store.Stats["client-1/2024-01-10"]; saved.ScoreCount != 2 {
+		t.Errorf("Expected previous day to be retried on flush, got %+v", saved)
+	}
+	if saved := store.Stats["client-1/2024-01-11"]; saved.ScoreCount != 2 {
+		t.Errorf("Expected today to be saved on flush, got %+v", saved)
+	}
+}
+
+func TestLeaderboardService_RanksGroupMembers(t *testing.T) {
+	store := NewMockLeaderboardStore()
+	config := DefaultLeaderboardConfig()
+	config.Location = time.UTC
+	leaderboard := NewLeaderboardService(config, store, store)
+
+	wednesday := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+	leaderboard.now = func() time.Time { return wednesday.Add(time.Hour) }
+
+	for _, member := range []domain.LeaderboardMember{
+		{ClientID: "alice", GroupID: "class-1", DisplayName: "Alice"},
+		{ClientID: "bob", GroupID: "class-1"},
+		{ClientID: "carol", GroupID: "class-1", OptOut: true},
+		{ClientID: "dave", GroupID: "class-2"},
+	} {
+		if _, err := leaderboard.SetMembership(member); err != nil {
+			t.Fatalf("SetMembership failed: %v", err)
+		}
+	}
+
+	// bob은 월요일에 1시간 (저장된 기록), alice는 오늘 3초 집중 (메모리 기록)
+	store.Stats["bob/2024-01-08"] = domain.DailyStudyStats{ClientID: "bob", Day: "2024-01-08", FocusedTime: time.Hour, ScoreSum: 50, ScoreCount: 1}
+	for i, clientID := range []string{"alice", "carol", "dave"} {
+		for tick := 0; tick < 3; tick++ {
+			leaderboard.OnScore(domain.ScoreSnapshot{ClientID: clientID, Score: 80 + i, State: ScoreStateFocusing, Timestamp: wednesday.Add(time.Duration(tick) * time.Second)})
+		}
+	}
+
+	daily, err := leaderboard.GetLeaderboard("class-1", domain.LeaderboardDaily, domain.MetricFocusedTime, 0)
+	if err != nil {
+		t.Fatalf("GetLeaderboard failed: %v", err)
+	}
+	if len(daily.Entries) != 1 || daily.Entries[0].DisplayName != "Alice" || daily.Entries[0].FocusedSeconds != 3 {
+		t.Errorf("Expected only alice on the daily board, got %+v", daily.Entries)
+	}
+
+	weekly, err := leaderboard.GetLeaderboard("class-1", domain.LeaderboardWeekly, domain.MetricFocusedTime, 0)
+	if err != nil {
+		t.Fatalf("GetLeaderboard failed: %v", err)
+	}
+	if len(weekly.Entries) != 2 || weekly.Entries[0].ClientID != "bob" || weekly.Entries[1].ClientID != "alice" {
+		t.Errorf("Expected bob then alice on the weekly board (carol opted out), got %+v", weekly.Entries)
+	}
+
+	byScore, _ := leaderboard.GetLeaderboard("class-1", domain.LeaderboardWeekly, domain.MetricAverageScore, 0)
+	if byScore.Entries[0].ClientID != "alice" || byScore.Entries[0].AverageScore != 80 {
+		t.Errorf("Expected alice first by average score, got %+v", byScore.Entries)
+	}
+
+	if _, err := leaderboard.GetLeaderboard("class-1", domain.LeaderboardAllTime, domain.MetricFocusedTime, 0); err != ErrAllTimeUnavailable {
+		t.Errorf("Expected all-time to require gamification, got %v", err)
+	}
+
+	leaderboard.Forget("alice")
+	if saved := store.Stats["alice/2024-01-10"]; saved.ScoreCount != 3 {
+		t.Errorf("Expected alice's daily stats saved on forget, got %+v", saved)
+	}
+}
+
+// MockEventLogPort 테스트용 Mock
+type MockEventLogPort struct {
+	Logs  map[string]*domain.DailyEventLog // key: clientID/day
+	Weeks map[string]string                // 클라이언트별 리포트 전달 주
+}
+
+func (m *MockEventLogPort) AddEvent(event domain.ProgressEvent, day string) error {
+	key := event.ClientID + "/" + day
+	if m.Logs[key] == nil {
+		m.Logs[key] = domain.NewDailyEventLog(event.ClientID, day)
+	}
+	m.Logs[key].Add(event)
+	return nil
+}
+
+func (m *MockEventLogPort) QueryEventLogs(clientID, fromDay, toDay string) ([]domain.DailyEventLog, error) {
+	var result []domain.DailyEventLog
+	for _, eventLog := range m.Logs {
+		if eventLog.ClientID == clientID && eventLog.Day >= fromDay && eventLog.Day <= toDay {
+			result = append(result, *eventLog)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockEventLogPort) GetReportWeek(clientID string) (string, error) {
+	return m.Weeks[clientID], nil
+}
+
+func (m *MockEventLogPort) SaveReportWeek(clientID, week string) error {
+	if m.Weeks == nil {
+		m.Weeks = make(map[string]string)
+	}
+	m.Weeks[clientID] = week
+	return nil
+}
+
+func TestWeeklyReportService_DeliversOnSchedule(t *testing.T) {
+	statsConfig := DefaultLeaderboardConfig()
+	statsConfig.Location = time.UTC
+	studyStats := NewLeaderboardService(statsConfig, NewMockLeaderboardStore(), NewMockLeaderboardStore())
+
+	screen := &MockScreenControlPort{}
+	eventLog := &MockEventLogPort{Logs: make(map[string]*domain.DailyEventLog)}
+	config := DefaultWeeklyReportConfig()
+	config.Location = time.UTC
+	reports := NewWeeklyReportService(config, studyStats, eventLog, NewSolutionRouterService(screen))
+
+	monday := time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC)
+	snapshot := domain.ScoreSnapshot{ClientID: "client-1", Score: 70, State: ScoreStateFocusing, Timestamp: monday}
+	studyStats.OnScore(snapshot)
+	reports.OnScore(snapshot)
+	reports.RecordEvent(domain.NewProgressEvent("client-1", domain.ProgressEventBlockURL, monday.Add(time.Hour)).WithTarget("youtube.com"))
+
+	// 일요일 21:00 전에는 전달하지 않음
+	reports.now = func() time.Time { return monday.AddDate(0, 0, 6).Add(11 * time.Hour) }
+	reports.DeliverDue()
+	if len(screen.AIResults) != 0 {
+		t.Fatalf("Expected no report before the scheduled time, got %d", len(screen.AIResults))
+	}
+
+	reports.now = func() time.Time { return monday.AddDate(0, 0, 6).Add(12 * time.Hour) }
+	reports.DeliverDue()
+	reports.DeliverDue()
+	if len(screen.AIResults) != 1 {
+		t.Fatalf("Expected exactly one report per week, got %d", len(screen.AIResults))
+	}
+
+	report, err := reports.GetWeeklyReport("client-1", monday)
+	if err != nil {
+		t.Fatalf("GetWeeklyReport failed: %v", err)
+	}
+	if report.Totals().ScoreCount != 1 || report.BlockedURLs != 1 || report.Distractions[0].Target != "youtube.com" {
+		t.Errorf("Unexpected report: %+v", report)
+	}
+	if screen.AIResults[0] != report.Markdown() {
+		t.Errorf("Expected delivered markdown to match the report")
+	}
+
+	// 재시작해도 같은 주에는 다시 전달하지 않고, 다음 주에는 전달
+	restarted := NewWeeklyReportService(config, studyStats, eventLog, NewSolutionRouterService(screen))
+	restarted.OnScore(snapshot)
+	restarted.now = reports.now
+	restarted.DeliverDue()
+	if len(screen.AIResults) != 1 {
+		t.Errorf("Expected no re-delivery after restart, got %d reports", len(screen.AIResults))
+	}
+	restarted.now = func() time.Time { return monday.AddDate(0, 0, 13).Add(12 * time.Hour) }
+	restarted.DeliverDue()
+	if len(screen.AIResults) != 2 || eventLog.Weeks["client-1"] != "2024-01-15" {
+		t.Errorf("Expected next week's report delivered and recorded, got %d reports, week %q", len(screen.AIResults), eventLog.Weeks["client-1"])
+	}
+}
+
+func TestReflexService_GroupAndClientPolicies(t *testing.T) {
+	members := NewMockLeaderboardStore()
+	members.SaveMember(domain.LeaderboardMember{ClientID: "designer", GroupID: "design"})
+	members.SaveMember(domain.LeaderboardMember{ClientID: "gamer", GroupID: "design"})
+
+	blacklist := memory.NewBlacklistAdapterWithDefaults()
+	blacklist.SetMemberStore(members)
+
+	// 디자인 그룹은 Discord 허용, 그중 gamer는 다시 차단
+	allowDiscord, _ := domain.NewAllowPatternRule(domain.PatternFieldApp, domain.PatternGlob, "discord*")
+	if err := blacklist.AddPatternRule(domain.GroupPolicy("design"), allowDiscord); err != nil {
+		t.Fatalf("AddPatternRule failed: %v", err)
+	}
+	blockDiscord, _ := domain.NewPatternRule(domain.PatternFieldApp, domain.PatternGlob, "discord*", domain.ActionMinimizeAll, 4)
+	blacklist.AddPatternRule(domain.ClientPolicy("gamer"), blockDiscord)
+	allowEdu, _ := domain.ParseURLRule("youtube.com/edu")
+	allowEdu.Allow = true
+	blacklist.AddURLRule(domain.GroupPolicy("design"), allowEdu)
+
+	service := NewReflexService(blacklist, &MockCommandPort{}, &MockDataRelayPort{})
+	openDiscord := func(clientID string) *domain.SabotageAction {
+		action, _ := service.ProcessActivity(domain.ClientActivity{ClientID: clientID, AppName: "Discord.exe", ActivityType: domain.ActivityAppOpen})
+		return action
+	}
+
+	if action := openDiscord("student"); action == nil || action.ActionType != domain.ActionCloseApp {
+		t.Errorf("Expected global CLOSE_APP for student, got %+v", action)
+	}
+	if action := openDiscord("designer"); action != nil {
+		t.Errorf("Expected Discord allowed for design group, got %+v", action)
+	}
+	if action := openDiscord("gamer"); action == nil || action.ActionType != domain.ActionMinimizeAll || action.Intensity != 4 {
+		t.Errorf("Expected client policy MINIMIZE_ALL for gamer, got %+v", action)
+	}
+
+	ctx := func(clientID string) domain.RuleContext {
+		return domain.RuleContext{ClientID: clientID, At: time.Now()}
+	}
+	if blacklist.IsBlacklisted(ctx("designer"), "https://youtube.com/edu/lecture") {
+		t.Error("Expected youtube.com/edu allowed for design group")
+	}
+	if !blacklist.IsBlacklisted(ctx("designer"), "https://youtube.com/watch?v=1") || !blacklist.IsBlacklisted(ctx("student"), "https://youtube.com/edu") {
+		t.Error("Expected the rest of youtube.com blocked")
+	}
+
+	// 조회한 그룹은 캐시되어 멤버십 저장소를 다시 읽지 않음
+	members.LoadErr = errors.New("disk failure")
+	if action := openDiscord("designer"); action != nil {
+		t.Errorf("Expected cached group kept on a store failure, got %+v", action)
+	}
+
+	// 멤버십 변경은 SetMembership 알림으로 반영
+	leaderboard := NewLeaderboardService(DefaultLeaderboardConfig(), members, members)
+	leaderboard.OnMembershipChange(blacklist.UpdateMembership)
+	if _, err := leaderboard.SetMembership(domain.LeaderboardMember{ClientID: "student", GroupID: "design"}); err != nil {
+		t.Fatalf("SetMembership failed: %v", err)
+	}
+	if action := openDiscord("student"); action != nil {
+		t.Errorf("Expected Discord allowed after joining design group, got %+v", action)
+	}
+}
+
+// MockStudySessionStore 테스트용 Mock (StudySessionPort)
+type MockStudySessionStore struct {
+	sessions []domain.StudySession
+}
+
+func (m *MockStudySessionStore) SaveSession(session domain.StudySession) error {
+	m.sessions = append(m.sessions, session)
+	return nil
+}
+
+func (m *MockStudySessionStore) DeleteSession(clientID, sessionID string) (bool, error) {
+	for i, session := range m.sessions {
+		if session.ClientID == clientID && session.ID == sessionID {
+			m.sessions = append(m.sessions[:i], m.sessions[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockStudySessionStore) ListSessions(clientID string, from, to time.Time) ([]domain.StudySession, error) {
+	var result []domain.StudySession
+	for _, session := range m.sessions {
+		if session.ClientID == clientID && session.Start.Before(to) && session.End.After(from) {
+			result = append(result, session)
+		}
+	}
+	return result, nil
+}
+
+// fixedTimezones 테스트용 클라이언트 시간대
+type fixedTimezones struct {
+	location *time.Location
+}
+
+func (f fixedTimezones) ClientLocation(clientID string) *time.Location {
+	return f.location
+}
+
+func TestReflexService_ScheduledRules(t *testing.T) {
+	seoul := time.FixedZone("KST", 9*3600)
+	blacklist := memory.NewBlacklistAdapter()
+
+	// 평일 09:00-18:00(클라이언트 시간대)에만 youtube.com 차단
+	youtube, _ := domain.ParseURLRule("youtube.com")
+	youtube.Schedule, _ = domain.NewRuleSchedule([]string{"mon-fri 09:00-18:00"}, false)
+	blacklist.AddURLRule(domain.GlobalPolicy, youtube)
+	// 학습 세션 중에만 Discord 종료
+	discord, _ := domain.NewPatternRule(domain.PatternFieldApp, domain.PatternGlob, "discord*", domain.ActionCloseApp, 0)
+	discord.Schedule, _ = domain.NewRuleSchedule(nil, true)
+	blacklist.AddPatternRule(domain.GlobalPolicy, discord)
+
+	sessions := NewStudySessionService(&MockStudySessionStore{})
+	service := NewReflexService(blacklist, &MockCommandPort{}, &MockDataRelayPort{})
+	service.SetTimezones(fixedTimezones{location: seoul})
+	service.SetStudySessions(sessions)
+
+	// 2024-01-01(월) 10:00 KST = 01:00 UTC
+	mondayMorning := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	visit := func(at time.Time) *domain.SabotageAction {
+		action, _ := service.ProcessActivity(domain.ClientActivity{ClientID: "client-1", URL: "https://youtube.com/watch", ActivityType: domain.ActivityURLVisit, Timestamp: at})
+		return action
+	}
+	if visit(mondayMorning) == nil {
+		t.Error("Expected youtube.com blocked on Monday 10:00 KST")
+	}
+	if action := visit(mondayMorning.Add(10 * time.Hour)); action != nil {
+		t.Errorf("Expected youtube.com allowed on Monday 20:00 KST, got %+v", action)
+	}
+	if action := visit(mondayMorning.AddDate(0, 0, 5)); action != nil {
+		t.Errorf("Expected youtube.com allowed on Saturday, got %+v", action)
+	}
+
+	openDiscord := func(at time.Time) *domain.SabotageAction {
+		action, _ := service.ProcessActivity(domain.ClientActivity{ClientID: "client-1", AppName: "Discord", ActivityType: domain.ActivityAppOpen, Timestamp: at})
+		return action
+	}
+	if action := openDiscord(mondayMorning); action != nil {
+		t.Errorf("Expected Discord allowed outside study session, got %+v", action)
+	}
+	if _, err := sessions.DeclareSession("client-1", mondayMorning, mondayMorning.Add(2*time.Hour), "algorithms"); err != nil {
+		t.Fatalf("DeclareSession failed: %v", err)
+	}
+	if _, err := sessions.DeclareSession("client-1", mondayMorning.Add(time.Hour), mondayMorning.Add(3*time.Hour), ""); err != ErrStudySessionOverlap {
+		t.Errorf("Expected ErrStudySessionOverlap, got %v", err)
+	}
+	if openDiscord(mondayMorning.Add(30*time.Minute)) == nil {
+		t.Error("Expected Discord closed during study session")
+	}
+
+	previews := service.PreviewRules("client-1", mondayMorning.Add(10*time.Hour))
+	if len(previews) != 2 {
+		t.Fatalf("Expected 2 previews, got %d", len(previews))
+	}
+	for _, preview := range previews {
+		if preview.Active {
+			t.Errorf("Expected %s inactive on Monday 20:00 KST", preview.Pattern)
+		}
+	}
+}
+
+// MockBlacklistStore 테스트용 Mock (BlacklistStorePort)
+type MockBlacklistStore struct {
+	policies    map[domain.PolicyTarget]*domain.BlacklistPolicy
+	initialized bool
+}
+
+func NewMockBlacklistStore() *MockBlacklistStore {
+	return &MockBlacklistStore{policies: make(map[domain.PolicyTarget]*domain.BlacklistPolicy)}
+}
+
+func (m *MockBlacklistStore) LoadPolicies() ([]domain.ScopedPolicy, bool, error) {
+	var policies []domain.ScopedPolicy
+	for target, policy := range m.policies {
+		policies = append(policies, domain.ScopedPolicy{Target: target, Policy: policy.Clone()})
+	}
+	return policies, m.initialized, nil
+}
+
+func (m *MockBlacklistStore) SavePolicy(target domain.PolicyTarget, policy *domain.BlacklistPolicy) error {
+	m.initialized = true
+	if policy.IsEmpty() {
+		delete(m.policies, target)
+		return nil
+	}
+	m.policies[target] = policy.Clone()
+	return nil
+}
+
+func TestBlacklistService_PersistsRules(t *testing.T) {
+	store := NewMockBlacklistStore()
+	now := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+
+	service := NewBlacklistService(memory.NewBlacklistAdapterWithDefaults(), store)
+	service.now = func() time.Time { return now }
+	if err := service.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if global := store.policies[domain.GlobalPolicy]; global == nil || len(global.URLRules) == 0 {
+		t.Fatal("Expected defaults seeded into empty store")
+	}
+
+	shorts, _ := domain.ParseURLRule("youtube.com/shorts")
+	shorts.Meta = domain.RuleMetadata{CreatedBy: "teacher-kim", Reason: "수업 중 쇼츠 금지"}
+	added, err := service.AddURLRule(domain.GroupPolicy("class-3a"), shorts)
+	if err != nil {
+		t.Fatalf("AddURLRule failed: %v", err)
+	}
+	if !added.Meta.CreatedAt.Equal(now) || added.Meta.CreatedBy != "teacher-kim" {
+		t.Errorf("Expected metadata stamped, got %+v", added.Meta)
+	}
+	if err := service.RemoveURLRule(domain.GlobalPolicy, "youtube.com"); err != nil {
+		t.Fatalf("RemoveURLRule failed: %v", err)
+	}
+	if err := service.RemoveURLRule(domain.GlobalPolicy, "youtube.com"); err != ErrBlacklistRuleNotFound {
+		t.Errorf("Expected ErrBlacklistRuleNotFound, got %v", err)
+	}
+	if err := service.ReplacePolicy(domain.ClientPolicy("pc-01"), &domain.BlacklistPolicy{}); err != nil {
+		t.Fatalf("ReplacePolicy failed: %v", err)
+	}
+
+	// 재시작: 기본값으로 시작해도 저장된 정책으로 교체
+	restarted := memory.NewBlacklistAdapterWithDefaults()
+	if err := NewBlacklistService(restarted, store).Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	group := restarted.Policy(domain.GroupPolicy("class-3a"))
+	if len(group.URLRules) != 1 || group.URLRules[0].Meta.Reason != "수업 중 쇼츠 금지" {
+		t.Errorf("Expected group rule restored with metadata, got %+v", group.URLRules)
+	}
+	ctx := domain.RuleContext{ClientID: "pc-01", At: now}
+	if restarted.IsBlacklisted(ctx, "https://youtube.com/watch") {
+		t.Error("Expected removed global rule to stay removed after restart")
+	}
+	if !restarted.IsBlacklisted(ctx, "https://netflix.com") {
+		t.Error("Expected remaining global rules after restart")
+	}
+
+	// 규칙을 모두 지운 저장소는 첫 실행이 아니므로 기본값을 다시 넣지 않음
+	for _, scoped := range restarted.Policies() {
+		if err := NewBlacklistService(restarted, store).ReplacePolicy(scoped.Target, &domain.BlacklistPolicy{}); err != nil {
+			t.Fatalf("ReplacePolicy failed: %v", err)
+		}
+	}
+	if len(store.policies) != 0 {
+		t.Fatalf("Expected every policy removed from store, got %d", len(store.policies))
+	}
+	emptied := memory.NewBlacklistAdapterWithDefaults()
+	if err := NewBlacklistService(emptied, store).Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(store.policies) != 0 || emptied.IsBlacklisted(ctx, "https://netflix.com") {
+		t.Error("Expected defaults not re-seeded after every rule was removed")
+	}
+}
+
+func TestBlacklistService_SyncRules(t *testing.T) {
+	store := NewMockBlacklistStore()
+	blacklist := memory.NewBlacklistAdapter()
+	service := NewBlacklistService(blacklist, store)
+
+	roblox, _ := domain.ParseURLRule("roblox.com")
+	minecraft, _ := domain.NewPatternRule(domain.PatternFieldApp, domain.PatternGlob, "minecraft*", domain.ActionCloseApp, 0)
+	if _, err := service.SyncRules("data-service", []domain.URLRule{roblox}, []domain.PatternRule{minecraft}); err != nil {
+		t.Fatalf("SyncRules failed: %v", err)
+	}
+	ctx := domain.RuleContext{At: time.Now()}
+	if !blacklist.IsBlacklisted(ctx, "https://www.roblox.com/games") {
+		t.Error("Expected synced url rule applied")
+	}
+	if global := store.policies[domain.GlobalPolicy]; global == nil || global.URLRules[0].Meta.Source != "data-service" {
+		t.Error("Expected synced rules persisted with their source")
+	}
+
+	// 목록에서 빠진 규칙은 제거
+	diff, err := service.SyncRules("data-service", nil, []domain.PatternRule{minecraft})
+	if err != nil {
+		t.Fatalf("SyncRules failed: %v", err)
+	}
+	if diff.Removed != 1 || blacklist.IsBlacklisted(ctx, "https://roblox.com") {
+		t.Errorf("Expected roblox.com removed, diff %+v", diff)
+	}
+	if _, blocked := blacklist.MatchApp(ctx, "Minecraft Launcher"); !blocked {
+		t.Error("Expected minecraft rule kept")
+	}
+
+	// 관리 API로 수정해도 동기화 출처는 유지되어 다음 동기화에서 제거됨
+	edited := minecraft
+	edited.Meta = domain.RuleMetadata{CreatedBy: "teacher-kim", Reason: "수업 중 금지"}
+	manual, _ := domain.ParseURLRule("twitch.tv")
+	replacement := &domain.BlacklistPolicy{}
+	replacement.AddURLRule(manual)
+	replacement.AddPatternRule(edited)
+	if err := service.ReplacePolicy(domain.GlobalPolicy, replacement); err != nil {
+		t.Fatalf("ReplacePolicy failed: %v", err)
+	}
+	if rule := store.policies[domain.GlobalPolicy].PatternRules[0]; rule.Meta.Source != "data-service" || rule.Meta.Reason != "수업 중 금지" {
+		t.Errorf("Expected edited rule to keep its sync source, got %+v", rule.Meta)
+	}
+	diff, err = service.SyncRules("data-service", nil, nil)
+	if err != nil {
+		t.Fatalf("SyncRules failed: %v", err)
+	}
+	if _, blocked := blacklist.MatchApp(ctx, "Minecraft Launcher"); diff.Removed != 1 || blocked {
+		t.Errorf("Expected edited synced rule removed by the next sync, diff %+v", diff)
+	}
+	if !blacklist.IsBlacklisted(ctx, "https://twitch.tv") {
+		t.Error("Expected manual rule untouched by sync")
+	}
+}
+
+func TestReflexService_Lockdown(t *testing.T) {
+	members := NewMockLeaderboardStore()
+	members.SaveMember(domain.LeaderboardMember{ClientID: "pc-01", GroupID: "class-3a"})
+
+	blacklist := memory.NewBlacklistAdapterWithDefaults()
+	blacklist.SetMemberStore(members)
+	admin := NewBlacklistService(blacklist, NewMockBlacklistStore())
+	reflex := NewReflexService(blacklist, &MockCommandPort{}, &MockDataRelayPort{})
+
+	exam, _ := domain.ParseURLRule("exam.school.kr")
+	editor, _ := domain.NewAllowPatternRule(domain.PatternFieldApp, domain.PatternGlob, "code*")
+	lockdown, err := domain.NewLockdown([]domain.URLRule{exam}, []domain.PatternRule{editor})
+	if err != nil {
+		t.Fatalf("NewLockdown failed: %v", err)
+	}
+	if _, err := admin.SetLockdown(domain.GlobalPolicy, lockdown); err != ErrLockdownScope {
+		t.Errorf("Expected ErrLockdownScope for global lockdown, got %v", err)
+	}
+	if _, err := admin.SetLockdown(domain.GroupPolicy("class-3a"), lockdown); err != nil {
+		t.Fatalf("SetLockdown failed: %v", err)
+	}
+
+	visit := func(url string) *domain.SabotageAction {
+		action, _ := reflex.ProcessActivity(domain.ClientActivity{ClientID: "pc-01", URL: url, ActivityType: domain.ActivityURLVisit})
+		return action
+	}
+	openApp := func(app string) *domain.SabotageAction {
+		action, _ := reflex.ProcessActivity(domain.ClientActivity{ClientID: "pc-01", AppName: app, ActivityType: domain.ActivityAppOpen})
+		return action
+	}
+
+	if action := visit("https://exam.school.kr/test/1"); action != nil {
+		t.Errorf("Expected allowlisted domain allowed, got %+v", action)
+	}
+	if action := visit("https://docs.python.org"); action == nil || action.ActionType != domain.ActionBlockURL {
+		t.Errorf("Expected BLOCK_URL for unlisted domain in lockdown, got %+v", action)
+	}
+	if action := openApp("Code.exe"); action != nil {
+		t.Errorf("Expected allowlisted app allowed, got %+v", action)
+	}
+	if action := openApp("Notepad"); action == nil || action.ActionType != domain.ActionCloseApp || action.Message != domain.LockdownMessage {
+		t.Errorf("Expected CLOSE_APP for unlisted app in lockdown, got %+v", action)
+	}
+	if action := openApp("Notepad"); action == nil || action.TargetApp != "Notepad" {
+		t.Errorf("Expected CLOSE_APP to target the app, got %+v", action)
+	}
+	// 종료 보고는 시험 모드로 평가하지 않고 분석기로 릴레이
+	relay := &MockDataRelayPort{}
+	closing := NewReflexService(blacklist, &MockCommandPort{}, relay)
+	if action, _ := closing.ProcessActivity(domain.ClientActivity{ClientID: "pc-01", AppName: "Notepad", ActivityType: domain.ActivityAppClose}); action != nil {
+		t.Errorf("Expected no action for APP_CLOSE in lockdown, got %+v", action)
+	}
+	if len(relay.RelayedActivities) != 1 {
+		t.Errorf("Expected APP_CLOSE relayed to the analyzer, got %d", len(relay.RelayedActivities))
+	}
+	if previews := reflex.PreviewRules("pc-01", time.Now()); len(previews) == 0 || previews[0].Schedule != domain.LockdownSchedule {
+		t.Errorf("Expected lockdown rules first in preview, got %+v", previews)
+	}
+
+	// 해제하면 평소 정책으로 복귀
+	if err := admin.ClearLockdown(domain.GroupPolicy("class-3a")); err != nil {
+		t.Fatalf("ClearLockdown failed: %v", err)
+	}
+	if err := admin.ClearLockdown(domain.GroupPolicy("class-3a")); err != ErrLockdownNotActive {
+		t.Errorf("Expected ErrLockdownNotActive, got %v", err)
+	}
+	if action := openApp("Notepad"); action != nil {
+		t.Errorf("Expected Notepad allowed after lockdown, got %+v", action)
+	}
+	if action := visit("https://youtube.com"); action == nil {
+		t.Error("Expected normal blacklist after lockdown")
+	}
+}
+
+// MockUnlockPassStore 테스트용 Mock (UnlockPassPort, UnlockAuditPort)
+type MockUnlockPassStore struct {
+	passes []domain.UnlockPass
+	audit  []domain.UnlockAuditEntry
+}
+
+func (m *MockUnlockPassStore) SavePass(pass domain.UnlockPass) error {
+	for i, existing := range m.passes {
+		if existing.ClientID == pass.ClientID && existing.ID == pass.ID {
+			m.passes[i] = pass
+			return nil
+		}
+	}
+	m.passes = append(m.passes, pass)
+	return nil
+}
+
+func (m *MockUnlockPassStore) LoadPass(clientID, passID string) (domain.UnlockPass, bool, error) {
+	for _, pass := range m.passes {
+		if pass.ClientID == clientID && pass.ID == passID {
+			return pass, true, nil
+		}
+	}
+	return domain.UnlockPass{}, false, nil
+}
+
+func (m *MockUnlockPassStore) ListPasses(clientID string, from, to time.Time) ([]domain.UnlockPass, error) {
+	var result []domain.UnlockPass
+	for _, pass := range m.passes {
+		if pass.ClientID == clientID && !pass.IssuedAt.Before(from) && pass.IssuedAt.Before(to) {
+			result = append(result, pass)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockUnlockPassStore) AppendAudit(entry domain.UnlockAuditEntry) error {
+	m.audit = append(m.audit, entry)
+	return nil
+}
+
+func (m *MockUnlockPassStore) ListAudit(clientID string, from, to time.Time) ([]domain.UnlockAuditEntry, error) {
+	var result []domain.UnlockAuditEntry
+	for _, entry := range m.audit {
+		if entry.ClientID == clientID && !entry.At.Before(from) && entry.At.Before(to) {
+			result = append(result, entry)
+		}
+	}
+	return result, nil
+}
+
+func TestUnlockPassService_GrantUseAndQuota(t *testing.T) {
+	now := time.Date(2026, 3, 2, 10, 0, 0, 0, time.Local)
+	blacklist := memory.NewBlacklistAdapterWithDefaults()
+	store := &MockUnlockPassStore{}
+	config := UnlockPassConfig{DefaultDuration: 15 * time.Minute, MaxDuration: time.Hour, MaxPassesPerDay: 2, MaxTimePerDay: 40 * time.Minute}
+	unlock := NewUnlockPassService(config, store, store, blacklist)
+	unlock.now = func() time.Time { return now }
+
+	commands := &MockCommandPort{}
+	relay := &MockDataRelayPort{}
+	reflex := NewReflexService(blacklist, commands, relay)
+	reflex.SetUnlockPasses(unlock)
+	visit := func(url string) *domain.SabotageAction {
+		action, _ := reflex.ProcessActivity(domain.ClientActivity{ClientID: "pc-01", URL: url, ActivityType: domain.ActivityURLVisit, Timestamp: now})
+		return action
+	}
+
+	// 적용되지 않는 규칙은 발급 불가
+	if _, err := unlock.GrantPass("pc-01", domain.RuleFieldURL, "docs.python.org", 0, "teacher", ""); err != ErrUnlockRuleNotFound {
+		t.Errorf("Expected ErrUnlockRuleNotFound, got %v", err)
+	}
+	if _, err := unlock.GrantPass("pc-01", domain.RuleFieldURL, "youtube.com", 2*time.Hour, "teacher", ""); err != ErrUnlockPassTooLong {
+		t.Errorf("Expected ErrUnlockPassTooLong, got %v", err)
+	}
+
+	pass, err := unlock.GrantPass("pc-01", domain.RuleFieldURL, "youtube.com", 0, "teacher", "lecture video")
+	if err != nil {
+		t.Fatalf("GrantPass failed: %v", err)
+	}
+	if pass.Duration() != 15*time.Minute {
+		t.Errorf("Expected default duration, got %v", pass.Duration())
+	}
+
+	// 이용권이 있으면 차단 대신 분석기로 릴레이, 다른 클라이언트/규칙은 그대로 차단
+	if action := visit("https://www.youtube.com/watch?v=1"); action != nil {
+		t.Errorf("Expected unlocked URL not blocked, got %+v", action)
+	}
+	if len(relay.RelayedActivities) != 1 {
+		t.Errorf("Expected unlocked activity relayed, got %d", len(relay.RelayedActivities))
+	}
+	// 같은 대상의 반복 사용은 감사 기록을 남기지 않음
+	now = now.Add(time.Second)
+	if action := visit("https://www.youtube.com/watch?v=1"); action != nil {
+		t.Errorf("Expected unlocked URL not blocked on repeat, got %+v", action)
+	}
+	if action := visit("https://netflix.com"); action == nil {
+		t.Error("Expected other rule still blocked")
+	}
+	if action, _ := reflex.ProcessActivity(domain.ClientActivity{ClientID: "pc-02", URL: "https://youtube.com", ActivityType: domain.ActivityURLVisit, Timestamp: now}); action == nil {
+		t.Error("Expected other client still blocked")
+	}
+
+	// 만료되면 다시 차단
+	now = now.Add(20 * time.Minute)
+	if action := visit("https://youtube.com"); action == nil {
+		t.Error("Expected block after the pass expired")
+	}
+
+	// 하루 한도: 시간(15 + 30 > 40분), 개수(2개)
+	if _, err := unlock.GrantPass("pc-01", domain.RuleFieldURL, "youtube.com", 30*time.Minute, "teacher", ""); !errors.Is(err, ErrUnlockQuotaExceeded) {
+		t.Errorf("Expected ErrUnlockQuotaExceeded for time quota, got %v", err)
+	}
+	steam, _ := domain.NewAllowPatternRule(domain.PatternFieldApp, domain.PatternGlob, "steam*")
+	appPass, err := unlock.GrantPass("pc-01", string(domain.PatternFieldApp), steam.Key(), 10*time.Minute, "teacher", "")
+	if err != nil {
+		t.Fatalf("GrantPass (app) failed: %v", err)
+	}
+	if action, _ := reflex.ProcessActivity(domain.ClientActivity{ClientID: "pc-01", AppName: "Steam.exe", ActivityType: domain.ActivityAppOpen, Timestamp: now}); action != nil {
+		t.Errorf("Expected unlocked app not closed, got %+v", action)
+	}
+	if _, err := unlock.GrantPass("pc-01", domain.RuleFieldURL, "youtube.com", time.Minute, "teacher", ""); !errors.Is(err, ErrUnlockQuotaExceeded) {
+		t.Errorf("Expected ErrUnlockQuotaExceeded for pass count, got %v", err)
+	}
+
+	// 회수하면 즉시 차단
+	if err := unlock.RevokePass("pc-01", appPass.ID, "teacher"); err != nil {
+		t.Fatalf("RevokePass failed: %v", err)
+	}
+	if err := unlock.RevokePass("pc-01", appPass.ID, "teacher"); err != ErrUnlockPassNotFound {
+		t.Errorf("Expected ErrUnlockPassNotFound for revoked pass, got %v", err)
+	}
+	if action, _ := reflex.ProcessActivity(domain.ClientActivity{ClientID: "pc-01", AppName: "Steam.exe", ActivityType: domain.ActivityAppOpen, Timestamp: now}); action == nil {
+		t.Error("Expected app closed after revoke")
+	}
+
+	// 하루가 지나면 한도 초기화
+	now = now.AddDate(0, 0, 1)
+	if _, err := unlock.GrantPass("pc-01", domain.RuleFieldURL, "youtube.com", 0, "teacher", ""); err != nil {
+		t.Errorf("Expected quota reset on the next day, got %v", err)
+	}
+
+	var actions []domain.UnlockAuditAction
+	for _, entry := range store.audit {
+		actions = append(actions, entry.Action)
+	}
+	expected := []domain.UnlockAuditAction{
+		domain.UnlockAuditGrant, domain.UnlockAuditUse, domain.UnlockAuditDeny, domain.UnlockAuditGrant,
+		domain.UnlockAuditUse, domain.UnlockAuditDeny, domain.UnlockAuditRevoke, domain.UnlockAuditGrant,
+	}
+	if fmt.Sprint(actions) != fmt.Sprint(expected) {
+		t.Errorf("Expected audit %v, got %v", expected, actions)
+	}
+}
+
+func TestReflexService_ThrottlesRepeats(t *testing.T) {
+	blacklistPort := NewMockBlacklistPort()
+	window, _ := domain.NewPatternRule(domain.PatternFieldWindowTitle, domain.PatternGlob, "*twitch*", domain.ActionMinimizeAll, 5)
+	blacklistPort.patternRules = append(blacklistPort.patternRules, window)
+	commands := &MockCommandPort{}
+	relay := &MockDataRelayPort{}
+	reflex := NewReflexService(blacklistPort, commands, relay)
+	reflex.SetThrottle(ReflexThrottleConfig{Cooldown: 30 * time.Second, DedupWindow: 5 * time.Second})
+
+	start := time.Now()
+	// 하트비트 주기(1초)로 같은 URL 보고
+	for i := 0; i < 10; i++ {
+		at := start.Add(time.Duration(i) * time.Second)
+		reflex.ProcessActivity(domain.ClientActivity{ClientID: "pc-01", URL: "youtube.com", ActivityType: domain.ActivityURLVisit, Timestamp: at})
+		reflex.ProcessHeartbeat(domain.Heartbeat{ClientID: "pc-01", ActiveWindowTitle: "Twitch - Chrome", Timestamp: at})
+	}
+
+	if len(commands.SentCommands) != 2 {
+		t.Fatalf("Expected one BLOCK_URL and one MINIMIZE_ALL, got %d commands", len(commands.SentCommands))
+	}
+	if len(relay.RelayedActivities) != 18 {
+		t.Fatalf("Expected suppressed URL and window title repeats relayed, got %d", len(relay.RelayedActivities))
+	}
+	if relay.RelayedActivities[0].Metadata["reflex_suppressed"] != string(domain.ActionBlockURL) {
+		t.Errorf("Expected relayed activity marked as suppressed, got %v", relay.RelayedActivities[0].Metadata)
+	}
+	relayed := relay.RelayedActivities[1]
+	if relayed.ActivityType != domain.ActivityWindowTitle || relayed.Metadata["window_title"] != "Twitch - Chrome" ||
+		relayed.Metadata["reflex_suppressed"] != string(domain.ActionMinimizeAll) || !relayed.Timestamp.Equal(start.Add(time.Second)) {
+		t.Errorf("Expected suppressed window title relayed with its mark, got %+v", relayed)
+	}
+
+	metrics := reflex.Metrics()
+	if metrics.Sent != 2 || metrics.Suppressed != 18 {
+		t.Errorf("Expected 2 sent and 18 suppressed, got %+v", metrics)
+	}
+	if metrics.SuppressedByAction[domain.ActionBlockURL] != 9 || metrics.SuppressedByAction[domain.ActionMinimizeAll] != 9 {
+		t.Errorf("Unexpected suppressed counts by action: %v", metrics.SuppressedByAction)
+	}
+
+	// 다른 클라이언트는 별도, 나갔다가 다시 들어오면(중복 제거 창보다 긴 공백) 즉시 전송
+	if action, _ := reflex.ProcessActivity(domain.ClientActivity{ClientID: "pc-02", URL: "youtube.com", ActivityType: domain.ActivityURLVisit, Timestamp: start}); action == nil {
+		t.Error("Expected other client not throttled")
+	}
+	if action, _ := reflex.ProcessActivity(domain.ClientActivity{ClientID: "pc-01", URL: "youtube.com", ActivityType: domain.ActivityURLVisit, Timestamp: start.Add(20 * time.Second)}); action == nil {
+		t.Error("Expected a new visit after a gap to be blocked again")
+	}
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+)
+
+// StateDeciderConfig 상태 판정 파라미터
+type StateDeciderConfig struct {
+	MinDwell   time.Duration // 현재 상태를 최소 유지해야 하는 시간
+	Confirm    time.Duration // 새 상태 후보가 이 시간 동안 유지되어야 전이
+	Hysteresis int           // 점수 구간 경계를 넘을 때 필요한 여유 점수
+}
+
+// DefaultStateDeciderConfig 기본 상태 판정 파라미터
+func DefaultStateDeciderConfig() StateDeciderConfig {
+	return StateDeciderConfig{
+		MinDwell:   5 * time.Second,
+		Confirm:    2 * time.Second,
+		Hysteresis: 5,
+	}
+}
+
+// StateDeciderService 로컬 상태 판정 서비스 (Local Decider)
+// ScoreService 결과를 StateCommand 전이로 바꿔 StateReceiverUseCase에 직접 전달
+// Dev 6(Kafka) 없이 한 대의 노트북에서 전체 루프를 돌리기 위한 용도
+// 매초 상태가 튀지 않도록 점수 구간 히스테리시스 + 최소 유지 시간 적용
+type StateDeciderService struct {
+	config       StateDeciderConfig
+	stateUseCase portin.StateReceiverUseCase
+	clients      map[string]*decisionState
+	mu           sync.Mutex
+}
+
+// decisionState 클라이언트별 판정 상태
+type decisionState struct {
+	current        domain.CommandState // 확정된 상태 (첫 확정 전이면 빈 값)
+	currentSince   time.Time
+	candidate      domain.CommandState // 전이 후보
+	candidateSince time.Time
+}
+
+// NewStateDeciderService StateDeciderService 생성자 (DI)
+func NewStateDeciderService(config StateDeciderConfig, stateUseCase portin.StateReceiverUseCase) *StateDeciderService {
+	return &StateDeciderService{
+		config:       config,
+		stateUseCase: stateUseCase,
+		clients:      make(map[string]*decisionState),
+	}
+}
+
+// Decide 점수 스냅샷을 반영하고, 상태 전이가 확정되면 StateReceiverUseCase로 전달
+func (s *StateDeciderService) Decide(snapshot domain.ScoreSnapshot) *domain.StateCommand {
+	cmd := s.evaluate(snapshot)
+	if cmd == nil {
+		return nil
+	}
+
+	log.Printf("[STATE_DECIDER] Client: %s → %s (score: %d, model: %s)",
+		cmd.ClientID, cmd.State, snapshot.Score, snapshot.ModelVersion)
+
+	if s.stateUseCase != nil {
+		if err := s.stateUseCase.HandleStateChange(*cmd); err != nil {
+			log.Printf("[STATE_DECIDER] Failed to handle state change: %v", err)
+		}
+	}
+	return cmd
+}
+
+// Forget 클라이언트 판정 상태 제거
+func (s *StateDeciderService) Forget(clientID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.clients, clientID)
+}
+
+// evaluate 판정 상태 갱신 후 전이가 확정되면 StateCommand 생성
+func (s *StateDeciderService) evaluate(snapshot domain.ScoreSnapshot) *domain.StateCommand {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := snapshot.Timestamp
+	state, exists := s.clients[snapshot.ClientID]
+	if !exists {
+		state = &decisionState{}
+		s.clients[snapshot.ClientID] = state
+	}
+
+	target := s.targetState(state.current, snapshot)
+
+	if target == state.current {
+		state.candidate = ""
+		return nil
+	}
+
+	if target != state.candidate {
+		state.candidate = target
+		state.candidateSince = now
+	}
+
+	// 졸음은 ScoreService가 이미 N초 지속을 확인했으므로 즉시 전이
+	urgent := snapshot.State == ScoreStateSleeping
+	if !urgent {
+		if now.Sub(state.candidateSince) < s.config.Confirm {
+			return nil
+		}
+		if state.current != "" && now.Sub(state.currentSince) < s.config.MinDwell {
+			return nil
+		}
+	}
+
+	state.current = target
+	state.currentSince = now
+	state.candidate = ""
+
+	cmd := domain.NewStateCommand(snapshot.ClientID, target).WithPriority(statePriority(target))
+	cmd.Timestamp = now
+	return cmd
+}
+
+// targetState 스냅샷으로 목표 상태 결정
+// ScoreService의 제재/보호 상태는 그대로 따르고, 나머지는 점수 구간으로 판정
+func (s *StateDeciderService) targetState(current domain.CommandState, snapshot domain.ScoreSnapshot) domain.CommandState {
+	switch snapshot.State {
+	case ScoreStateSleeping:
+		return domain.StateSleeping
+	case ScoreStateDistracted:
+		return domain.StateDistracted
+	case ScoreStateThinking:
+		return domain.StateThinking
+	}
+	return s.scoreBand(current, snapshot.Score)
+}
+
+// scoreBand 점수 구간 판정 (히스테리시스 적용)
+// THINKING > 80 ≥ FOCUSED > 50 ≥ DISTRACTED > 30 ≥ SLEEPING
+// 현재 상태의 구간을 벗어나려면 경계를 Hysteresis만큼 더 넘어야 함
+func (s *StateDeciderService) scoreBand(current domain.CommandState, score int) domain.CommandState {
+	lower, upper, banded := bandBounds(current)
+	if banded && score > lower-s.config.Hysteresis && score <= upper+s.config.Hysteresis {
+		return current
+	}
+
+	switch {
+	case score > 80:
+		return domain.StateThinking
+	case score > 50:
+		return domain.StateFocused
+	case score > 30:
+		return domain.StateDistracted
+	default:
+		return domain.StateSleeping
+	}
+}
+
+// bandBounds 상태별 점수 구간 (lower < score <= upper)
+func bandBounds(state domain.CommandState) (int, int, bool) {
+	switch state {
+	case domain.StateThinking:
+		return 80, 100, true
+	case domain.StateFocused:
+		return 50, 80, true
+	case domain.StateDistracted:
+		return 30, 50, true
+	case domain.StateSleeping:
+		return -1, 30, true
+	default:
+		return 0, 0, false
+	}
+}
+
+// statePriority 상태별 명령 우선순위
+func statePriority(state domain.CommandState) int {
+	switch state {
+	case domain.StateSleeping:
+		return 8
+	case domain.StateDistracted:
+		return 6
+	default:
+		return 5
+	}
+}
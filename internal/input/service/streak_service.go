@@ -0,0 +1,299 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+	portout "jiaa-server-core/internal/input/port/out"
+)
+
+// StreakConfig 하루 목표/연속 달성 파라미터
+type StreakConfig struct {
+	DefaultGoal   time.Duration  // 목표를 설정하지 않은 클라이언트의 하루 목표
+	WarnBefore    time.Duration  // 자정까지 이 시간 이하로 남았는데 목표 미달이면 경고
+	CheckInterval time.Duration  // 목표 미달 경고 확인 주기
+	MaxTickGap    time.Duration  // 이보다 긴 하트비트 간격은 끊김으로 보고 한 틱만 인정
+	FlushInterval time.Duration  // 기록 저장 주기
+	Location      *time.Location // 시간대를 설정하지 않은 클라이언트의 날짜 기준
+}
+
+// DefaultStreakConfig 기본 하루 목표/연속 달성 파라미터
+func DefaultStreakConfig() StreakConfig {
+	return StreakConfig{
+		DefaultGoal:   time.Hour,
+		WarnBefore:    3 * time.Hour,
+		CheckInterval: time.Minute,
+		MaxTickGap:    3 * domain.HeartbeatInterval,
+		FlushInterval: 10 * time.Second,
+		Location:      time.Local,
+	}
+}
+
+// StreakService 하루 집중 목표/연속 달성 서비스
+// ScoreBoardService의 산정 결과(ScoreListener)로 클라이언트 시간대 기준 당일 집중 시간을 누적하고,
+// 목표를 달성한 날이 이어지면 연속 일수를 올림
+// 접속 중인 클라이언트가 자정이 가까운데 목표 미달이면 하루 한 번 SHOW_MESSAGE로 경고
+type StreakService struct {
+	config     StreakConfig
+	store      portout.StreakStorePort
+	screenPort portout.ScreenControlPort
+	clients    *clientRecords[*streakRecord]
+	stopChan   chan struct{}
+	now        func() time.Time
+}
+
+// streakRecord 클라이언트별 기록과 날짜 기준 시간대 (Timezone 변경 시 갱신)
+type streakRecord struct {
+	streak   domain.StudyStreak
+	location *time.Location
+}
+
+// streakWarning 목표 미달 경고
+type streakWarning struct {
+	clientID string
+	status   domain.StreakStatus
+}
+
+// NewStreakService StreakService 생성자 (DI)
+func NewStreakService(config StreakConfig, store portout.StreakStorePort, screenPort portout.ScreenControlPort) *StreakService {
+	defaults := DefaultStreakConfig()
+	if config.DefaultGoal <= 0 {
+		config.DefaultGoal = defaults.DefaultGoal
+	}
+	if config.CheckInterval <= 0 {
+		config.CheckInterval = defaults.CheckInterval
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = defaults.FlushInterval
+	}
+	if config.Location == nil {
+		config.Location = time.Local
+	}
+	return &StreakService{
+		config:     config,
+		store:      store,
+		screenPort: screenPort,
+		clients: newClientRecords("STREAK",
+			func(record *streakRecord) error { return store.SaveStreak(&record.streak) },
+			func(record *streakRecord) *streakRecord {
+				clone := *record
+				return &clone
+			},
+			func(record *streakRecord) string { return record.streak.ClientID }),
+		stopChan: make(chan struct{}),
+		now:      time.Now,
+	}
+}
+
+// OnScore 산정 결과 수신 → FOCUSING/THINKING 시간을 당일 집중 시간으로 누적
+func (s *StreakService) OnScore(snapshot domain.ScoreSnapshot) {
+	s.clients.Lock()
+	entry, err := s.entry(snapshot.ClientID)
+	if err != nil {
+		s.clients.Unlock()
+		log.Printf("[STREAK] Failed to load streak for %s: %v", snapshot.ClientID, err)
+		return
+	}
+
+	elapsed := entry.tick(snapshot.Timestamp, s.config.MaxTickGap)
+	var focus time.Duration
+	if isFocusedState(snapshot.State) {
+		focus = elapsed
+	}
+	record := entry.value
+	day := snapshot.Timestamp.In(record.location).Format(domain.DayLayout)
+	met := record.streak.AddFocus(day, focus)
+	s.clients.markDirty(entry)
+	current := record.streak.CurrentStreak
+	s.clients.Unlock()
+
+	if met {
+		log.Printf("[STREAK] 🔥 Daily goal met! Client: %s, streak: %d", snapshot.ClientID, current)
+		message := fmt.Sprintf("🔥 오늘 목표 달성! %d일 연속", current)
+		if err := s.screenPort.ShowMessage(snapshot.ClientID, message); err != nil {
+			log.Printf("[STREAK] Failed to notify goal met: %v", err)
+		}
+	}
+}
+
+// SetDailyGoal 하루 목표와 시간대 설정 후 즉시 저장
+// 이미 오늘 누적한 시간이 새 목표 이상이면 바로 달성 처리
+func (s *StreakService) SetDailyGoal(clientID string, goal time.Duration, timezone string) (domain.StreakStatus, error) {
+	if err := domain.ValidateDailyGoal(goal); err != nil {
+		return domain.StreakStatus{}, err
+	}
+	var location *time.Location
+	if timezone != "" {
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			return domain.StreakStatus{}, fmt.Errorf("invalid timezone %q", timezone)
+		}
+		location = loc
+	}
+
+	s.clients.Lock()
+	entry, cached, err := s.lookup(clientID)
+	if err != nil {
+		s.clients.Unlock()
+		return domain.StreakStatus{}, err
+	}
+	record := entry.value
+	record.streak.DailyGoal = goal
+	if location != nil {
+		record.streak.Timezone = timezone
+		record.location = location
+	}
+	now := s.now().In(record.location)
+	record.streak.AddFocus(now.Format(domain.DayLayout), 0)
+	status := record.streak.Status(now, s.config.WarnBefore)
+	if cached {
+		s.clients.markDirty(entry)
+	}
+	s.clients.Unlock()
+
+	// 세션이 없는 클라이언트는 메모리에 올리지 않고 바로 저장
+	if cached {
+		err = s.clients.SaveNow(clientID)
+	} else {
+		err = s.clients.SaveDetached(clientID, record)
+	}
+	if err != nil {
+		return domain.StreakStatus{}, err
+	}
+	log.Printf("[STREAK] Daily goal set: client=%s, goal=%s, timezone=%s", clientID, goal, record.streak.Timezone)
+	return status, nil
+}
+
+// GetStreak 현재 연속 달성 현황 조회
+// 세션이 없는 클라이언트는 저장소에서 읽음 (저장된 값이 없으면 기본 목표)
+func (s *StreakService) GetStreak(clientID string) (domain.StreakStatus, error) {
+	s.clients.Lock()
+	defer s.clients.Unlock()
+
+	entry, _, err := s.lookup(clientID)
+	if err != nil {
+		return domain.StreakStatus{}, err
+	}
+	return entry.value.streak.Status(s.now().In(entry.value.location), s.config.WarnBefore), nil
+}
+
+// ClientLocation 클라이언트 시간대 (설정하지 않았거나 조회 실패 시 기본 시간대)
+func (s *StreakService) ClientLocation(clientID string) *time.Location {
+	s.clients.Lock()
+	defer s.clients.Unlock()
+
+	entry, _, err := s.lookup(clientID)
+	if err != nil {
+		log.Printf("[STREAK] Failed to load timezone for %s: %v", clientID, err)
+		return s.config.Location
+	}
+	return entry.value.location
+}
+
+// CheckAtRisk 접속 중인 클라이언트 중 목표 미달 위험인 클라이언트에 경고 (하루 한 번)
+func (s *StreakService) CheckAtRisk() {
+	now := s.now()
+
+	s.clients.Lock()
+	var warnings []streakWarning
+	for clientID, entry := range s.clients.entries {
+		record := entry.value
+		local := now.In(record.location)
+		day := local.Format(domain.DayLayout)
+		if record.streak.WarnedDay == day {
+			continue
+		}
+		status := record.streak.Status(local, s.config.WarnBefore)
+		if !status.AtRisk {
+			continue
+		}
+		record.streak.WarnedDay = day
+		s.clients.markDirty(entry)
+		warnings = append(warnings, streakWarning{clientID: clientID, status: status})
+	}
+	s.clients.Unlock()
+
+	for _, warning := range warnings {
+		log.Printf("[STREAK] ⏰ Daily goal at risk: client=%s, remaining=%s", warning.clientID, warning.status.Remaining)
+		if err := s.screenPort.ShowMessage(warning.clientID, streakWarningMessage(warning.status)); err != nil {
+			log.Printf("[STREAK] Failed to warn at-risk goal: %v", err)
+		}
+	}
+}
+
+// Forget 세션 종료 시 기록을 저장하고 메모리에서 제거
+func (s *StreakService) Forget(clientID string) {
+	s.clients.Forget(clientID)
+}
+
+// Start 주기적 저장 및 목표 미달 경고 확인 시작
+func (s *StreakService) Start() {
+	s.clients.Start(s.config.FlushInterval)
+	go func() {
+		ticker := time.NewTicker(s.config.CheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.stopChan:
+				return
+			case <-ticker.C:
+				s.CheckAtRisk()
+			}
+		}
+	}()
+	log.Printf("[STREAK] Started (default goal: %s, warn before: %s)", s.config.DefaultGoal, s.config.WarnBefore)
+}
+
+// Stop 주기 작업 중지 후 남은 변경 저장
+func (s *StreakService) Stop() {
+	close(s.stopChan)
+	s.clients.Stop()
+}
+
+// Flush 저장되지 않은 기록과 저장 실패한 기록을 모두 저장
+func (s *StreakService) Flush() {
+	s.clients.Flush()
+}
+
+// entry 클라이언트 메모리 기록 조회 (없으면 저장소에서 읽어 생성, 호출자가 락 보유)
+func (s *StreakService) entry(clientID string) (*clientEntry[*streakRecord], error) {
+	entry, cached, err := s.lookup(clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !cached {
+		entry = s.clients.put(clientID, entry.value)
+	}
+	return entry, nil
+}
+
+// lookup 메모리, 재시도 목록 또는 저장소의 기록 조회 (메모리에 올리지 않음, 호출자가 락 보유)
+func (s *StreakService) lookup(clientID string) (entry *clientEntry[*streakRecord], cached bool, err error) {
+	if entry, exists := s.clients.get(clientID); exists {
+		return entry, true, nil
+	}
+	if record, pending := s.clients.peek(clientID); pending {
+		return &clientEntry[*streakRecord]{value: record}, false, nil
+	}
+
+	streak, exists, err := s.store.LoadStreak(clientID)
+	if err != nil {
+		return nil, false, err
+	}
+	if !exists {
+		streak = domain.NewStudyStreak(clientID, s.config.DefaultGoal)
+	}
+	record := &streakRecord{streak: *streak, location: streak.Location(s.config.Location)}
+	return &clientEntry[*streakRecord]{value: record}, false, nil
+}
+
+// streakWarningMessage 목표 미달 경고 멘트
+func streakWarningMessage(status domain.StreakStatus) string {
+	remaining := domain.FormatDwell(int64(status.Remaining / time.Second))
+	if status.CurrentStreak > 0 {
+		return fmt.Sprintf("⏰ 오늘 목표까지 %s 남았습니다. %d일 연속 기록이 끊기지 않게 집중해 보세요!", remaining, status.CurrentStreak)
+	}
+	return fmt.Sprintf("⏰ 오늘 목표까지 %s 남았습니다.", remaining)
+}
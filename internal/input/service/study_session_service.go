@@ -0,0 +1,84 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+	portout "jiaa-server-core/internal/input/port/out"
+)
+
+var (
+	// ErrStudySessionOverlap 기존 세션과 겹치는 선언
+	ErrStudySessionOverlap = errors.New("study session overlaps an existing session")
+	// ErrStudySessionNotFound 취소할 세션 없음
+	ErrStudySessionNotFound = errors.New("study session not found")
+)
+
+// StudySessionService 학습 세션 선언 서비스
+// 클라이언트가 미리 선언한 세션 구간을 저장하고, 세션 한정 차단 규칙(RuleSchedule.StudySessionsOnly)의
+// 평가 기준(InStudySession)을 제공
+type StudySessionService struct {
+	store portout.StudySessionPort
+}
+
+// NewStudySessionService StudySessionService 생성자 (DI)
+func NewStudySessionService(store portout.StudySessionPort) *StudySessionService {
+	return &StudySessionService{store: store}
+}
+
+// DeclareSession 학습 세션 선언 (기존 세션과 겹치면 ErrStudySessionOverlap)
+func (s *StudySessionService) DeclareSession(clientID string, start, end time.Time, label string) (domain.StudySession, error) {
+	session, err := domain.NewStudySession(clientID, start, end, label)
+	if err != nil {
+		return domain.StudySession{}, err
+	}
+
+	existing, err := s.store.ListSessions(clientID, start, end)
+	if err != nil {
+		return domain.StudySession{}, err
+	}
+	if len(existing) > 0 {
+		return domain.StudySession{}, ErrStudySessionOverlap
+	}
+
+	if err := s.store.SaveSession(session); err != nil {
+		return domain.StudySession{}, err
+	}
+	log.Printf("[STUDY_SESSION] Declared: client=%s, %s ~ %s", clientID,
+		start.Format(time.RFC3339), end.Format(time.RFC3339))
+	return session, nil
+}
+
+// CancelSession 세션 취소
+func (s *StudySessionService) CancelSession(clientID, sessionID string) error {
+	deleted, err := s.store.DeleteSession(clientID, sessionID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrStudySessionNotFound
+	}
+	return nil
+}
+
+// ListSessions 기간 [from, to)와 겹치는 세션 조회
+func (s *StudySessionService) ListSessions(clientID string, from, to time.Time) ([]domain.StudySession, error) {
+	return s.store.ListSessions(clientID, from, to)
+}
+
+// InStudySession at이 선언한 세션 안인지 (조회 실패 시 세션 밖으로 간주)
+func (s *StudySessionService) InStudySession(clientID string, at time.Time) bool {
+	sessions, err := s.store.ListSessions(clientID, at, at.Add(time.Nanosecond))
+	if err != nil {
+		log.Printf("[STUDY_SESSION] Failed to load sessions for %s: %v", clientID, err)
+		return false
+	}
+	for _, session := range sessions {
+		if session.Contains(at) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,272 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+	portout "jiaa-server-core/internal/input/port/out"
+)
+
+// UnlockPassConfig 이용권 파라미터
+type UnlockPassConfig struct {
+	DefaultDuration time.Duration // 시간을 지정하지 않은 이용권
+	MaxDuration     time.Duration // 이용권 하나의 최대 시간
+	MaxPassesPerDay int           // 클라이언트당 하루 발급 개수 한도
+	MaxTimePerDay   time.Duration // 클라이언트당 하루 이용 시간 한도 (회수된 이용권은 회수까지만)
+}
+
+// DefaultUnlockPassConfig 기본 이용권 파라미터
+func DefaultUnlockPassConfig() UnlockPassConfig {
+	return UnlockPassConfig{
+		DefaultDuration: 15 * time.Minute,
+		MaxDuration:     time.Hour,
+		MaxPassesPerDay: 3,
+		MaxTimePerDay:   time.Hour,
+	}
+}
+
+var (
+	// ErrUnlockRuleNotFound 클라이언트에게 적용되는 차단 규칙이 아님
+	ErrUnlockRuleNotFound = errors.New("no blocking rule matches the unlock pass")
+	// ErrUnlockQuotaExceeded 하루 이용권 한도 초과
+	ErrUnlockQuotaExceeded = errors.New("daily unlock quota exceeded")
+	// ErrUnlockPassNotFound 회수할 이용권 없음
+	ErrUnlockPassNotFound = errors.New("unlock pass not found")
+	// ErrUnlockPassTooLong 최대 시간 초과
+	ErrUnlockPassTooLong = errors.New("unlock pass exceeds the maximum duration")
+)
+
+// UnlockPassService 차단 해제 이용권 서비스
+// 클라이언트별 하루 한도(개수, 시간) 안에서 규칙 하나를 잠시 풀어 주고,
+// 발급/거절/사용/회수를 모두 감사 기록(UnlockAuditPort)과 로그에 남김
+// 사용은 이용권과 대상별 첫 사용만 기록 (창 제목 이용권은 하트비트마다 사용되므로)
+// 시험 모드(허용 목록 전용)에는 이용권을 적용하지 않음 (ReflexService에서 확인)
+type UnlockPassService struct {
+	config    UnlockPassConfig
+	passes    portout.UnlockPassPort
+	audit     portout.UnlockAuditPort
+	blacklist portout.BlacklistPort
+	timezones portin.ClientTimezoneUseCase // 하루 한도의 날짜 경계 (선택, 없으면 서버 시간대)
+	mu        sync.Mutex                   // 한도 확인과 발급 직렬화
+	used      map[string]time.Time         // 사용 기록한 이용권+대상 (값: 이용권 만료 시각, 지나면 정리)
+	usedMu    sync.Mutex
+	now       func() time.Time
+}
+
+// NewUnlockPassService UnlockPassService 생성자 (DI)
+func NewUnlockPassService(config UnlockPassConfig, passes portout.UnlockPassPort, audit portout.UnlockAuditPort, blacklist portout.BlacklistPort) *UnlockPassService {
+	defaults := DefaultUnlockPassConfig()
+	if config.MaxDuration <= 0 {
+		config.MaxDuration = defaults.MaxDuration
+	}
+	if config.DefaultDuration <= 0 || config.DefaultDuration > config.MaxDuration {
+		config.DefaultDuration = min(defaults.DefaultDuration, config.MaxDuration)
+	}
+	return &UnlockPassService{
+		config:    config,
+		passes:    passes,
+		audit:     audit,
+		blacklist: blacklist,
+		used:      make(map[string]time.Time),
+		now:       time.Now,
+	}
+}
+
+// SetTimezones 하루 한도의 날짜 경계로 쓸 클라이언트 시간대 설정
+func (s *UnlockPassService) SetTimezones(timezones portin.ClientTimezoneUseCase) {
+	s.timezones = timezones
+}
+
+// GrantPass 이용권 발급
+// 클라이언트에게 적용되는 차단 규칙이어야 하며, 하루 한도를 넘으면 거절(감사 기록)
+func (s *UnlockPassService) GrantPass(clientID, field, ruleKey string, duration time.Duration, grantedBy, reason string) (domain.UnlockPass, error) {
+	if duration == 0 {
+		duration = s.config.DefaultDuration
+	}
+	if duration > s.config.MaxDuration {
+		return domain.UnlockPass{}, ErrUnlockPassTooLong
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	pass, err := domain.NewUnlockPass(clientID, field, ruleKey, now, duration)
+	if err != nil {
+		return domain.UnlockPass{}, err
+	}
+	pass.GrantedBy = grantedBy
+	pass.Reason = reason
+	if !domain.HasBlockingRule(s.blacklist.PolicyChain(clientID), field, ruleKey) {
+		return domain.UnlockPass{}, ErrUnlockRuleNotFound
+	}
+
+	if err := s.checkQuota(clientID, now, duration); err != nil {
+		s.record(domain.UnlockAuditEntry{
+			At:       now,
+			ClientID: clientID,
+			Action:   domain.UnlockAuditDeny,
+			RuleKey:  ruleKey,
+			Actor:    grantedBy,
+			Detail:   err.Error(),
+		})
+		return domain.UnlockPass{}, err
+	}
+
+	if err := s.passes.SavePass(pass); err != nil {
+		return domain.UnlockPass{}, err
+	}
+	s.record(domain.UnlockAuditEntry{
+		At:       now,
+		ClientID: clientID,
+		Action:   domain.UnlockAuditGrant,
+		PassID:   pass.ID,
+		RuleKey:  ruleKey,
+		Actor:    grantedBy,
+		Detail:   fmt.Sprintf("%v until %s: %s", duration, pass.ExpiresAt.Format(time.RFC3339), reason),
+	})
+	return pass, nil
+}
+
+// RevokePass 이용권 조기 회수
+func (s *UnlockPassService) RevokePass(clientID, passID, revokedBy string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pass, exists, err := s.passes.LoadPass(clientID, passID)
+	if err != nil {
+		return err
+	}
+	now := s.now()
+	if !exists || !pass.Active(now) {
+		return ErrUnlockPassNotFound
+	}
+
+	pass.RevokedAt = now
+	if err := s.passes.SavePass(pass); err != nil {
+		return err
+	}
+	s.record(domain.UnlockAuditEntry{
+		At:       now,
+		ClientID: clientID,
+		Action:   domain.UnlockAuditRevoke,
+		PassID:   pass.ID,
+		RuleKey:  pass.RuleKey,
+		Actor:    revokedBy,
+	})
+	return nil
+}
+
+// ListPasses 발급 시각이 [from, to)인 이용권 조회
+func (s *UnlockPassService) ListPasses(clientID string, from, to time.Time) ([]domain.UnlockPass, error) {
+	return s.passes.ListPasses(clientID, from, to)
+}
+
+// ListAudit 기간 [from, to)의 감사 기록 조회
+func (s *UnlockPassService) ListAudit(clientID string, from, to time.Time) ([]domain.UnlockAuditEntry, error) {
+	return s.audit.ListAudit(clientID, from, to)
+}
+
+// UseURLPass rawURL을 풀어 주는 효력 있는 이용권이 있으면 사용 기록 후 true
+func (s *UnlockPassService) UseURLPass(clientID, rawURL string, at time.Time) bool {
+	return s.use(clientID, rawURL, at, func(pass domain.UnlockPass) bool {
+		return pass.CoversURL(rawURL)
+	})
+}
+
+// UsePatternPass 앱/창 제목 규칙을 풀어 주는 효력 있는 이용권이 있으면 사용 기록 후 true
+func (s *UnlockPassService) UsePatternPass(clientID string, rule domain.PatternRule, value string, at time.Time) bool {
+	return s.use(clientID, value, at, func(pass domain.UnlockPass) bool {
+		return pass.CoversRule(rule)
+	})
+}
+
+// use 최대 시간 안에 발급된 이용권 중 효력 있고 대상을 덮는 이용권 사용
+func (s *UnlockPassService) use(clientID, target string, at time.Time, covers func(pass domain.UnlockPass) bool) bool {
+	if at.IsZero() {
+		at = s.now()
+	}
+	passes, err := s.passes.ListPasses(clientID, at.Add(-s.config.MaxDuration), at.Add(time.Nanosecond))
+	if err != nil {
+		log.Printf("[UNLOCK_PASS] Failed to load passes for %s: %v", clientID, err)
+		return false
+	}
+	for _, pass := range passes {
+		if !pass.Active(at) || !covers(pass) {
+			continue
+		}
+		if s.firstUse(pass, target, at) {
+			s.record(domain.UnlockAuditEntry{
+				At:       at,
+				ClientID: clientID,
+				Action:   domain.UnlockAuditUse,
+				PassID:   pass.ID,
+				RuleKey:  pass.RuleKey,
+				Target:   target,
+			})
+		}
+		return true
+	}
+	return false
+}
+
+// firstUse 이용권이 이 대상에 처음 쓰였는지 (처음이면 기록, 만료된 항목은 정리)
+func (s *UnlockPassService) firstUse(pass domain.UnlockPass, target string, at time.Time) bool {
+	s.usedMu.Lock()
+	defer s.usedMu.Unlock()
+
+	key := pass.ClientID + "\x00" + pass.ID + "\x00" + target
+	if _, used := s.used[key]; used {
+		return false
+	}
+	for usedKey, expiresAt := range s.used {
+		if !at.Before(expiresAt) {
+			delete(s.used, usedKey)
+		}
+	}
+	s.used[key] = pass.ExpiresAt
+	return true
+}
+
+// checkQuota 오늘(클라이언트 시간대) 발급한 이용권 개수/시간 한도 확인
+func (s *UnlockPassService) checkQuota(clientID string, now time.Time, duration time.Duration) error {
+	location := time.Local
+	if s.timezones != nil {
+		location = s.timezones.ClientLocation(clientID)
+	}
+	dayStart := domain.StartOfDay(now.In(location))
+	today, err := s.passes.ListPasses(clientID, dayStart, dayStart.AddDate(0, 0, 1))
+	if err != nil {
+		return err
+	}
+
+	if s.config.MaxPassesPerDay > 0 && len(today) >= s.config.MaxPassesPerDay {
+		return fmt.Errorf("%w: %d passes per day", ErrUnlockQuotaExceeded, s.config.MaxPassesPerDay)
+	}
+	used := time.Duration(0)
+	for _, pass := range today {
+		end := pass.ExpiresAt
+		if !pass.RevokedAt.IsZero() && pass.RevokedAt.Before(end) {
+			end = pass.RevokedAt
+		}
+		used += end.Sub(pass.IssuedAt)
+	}
+	if s.config.MaxTimePerDay > 0 && used+duration > s.config.MaxTimePerDay {
+		return fmt.Errorf("%w: %v of %v per day used", ErrUnlockQuotaExceeded, used.Round(time.Second), s.config.MaxTimePerDay)
+	}
+	return nil
+}
+
+// record 감사 기록 (로그는 항상 남기고, 저장 실패는 로그로만)
+func (s *UnlockPassService) record(entry domain.UnlockAuditEntry) {
+	log.Printf("[UNLOCK_AUDIT] %s client=%s pass=%s rule=%s actor=%q target=%q %s",
+		entry.Action, entry.ClientID, entry.PassID, entry.RuleKey, entry.Actor, entry.Target, entry.Detail)
+	if err := s.audit.AppendAudit(entry); err != nil {
+		log.Printf("[UNLOCK_PASS] Failed to store audit entry: %v", err)
+	}
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"jiaa-server-core/internal/input/domain"
+	portin "jiaa-server-core/internal/input/port/in"
+	portout "jiaa-server-core/internal/input/port/out"
+)
+
+// WeeklyReportConfig 주간 리포트 파라미터
+type WeeklyReportConfig struct {
+	DeliveryWeekday time.Weekday   // 전달 요일
+	DeliveryTime    time.Duration  // 전달 시각 (자정 기준 경과 시간)
+	CheckInterval   time.Duration  // 전달 시각 확인 주기
+	TopN            int            // 방해 요소/체류 대상 표시 개수
+	Location        *time.Location // 일/주 경계 시간대
+}
+
+// DefaultWeeklyReportConfig 기본 주간 리포트 파라미터 (일요일 21:00)
+func DefaultWeeklyReportConfig() WeeklyReportConfig {
+	return WeeklyReportConfig{
+		DeliveryWeekday: time.Sunday,
+		DeliveryTime:    21 * time.Hour,
+		CheckInterval:   time.Minute,
+		TopN:            5,
+		Location:        time.Local,
+	}
+}
+
+// WeeklyReportService 주간 학습 리포트 서비스
+// 하루 기록(StudyStatsUseCase), 차단/응급 이벤트 집계(EventLogPort), 체류 시간(ActivityUsageUseCase)으로
+// 마크다운 리포트를 만들어 매주 정해진 시각에 접속 중인 클라이언트에게 SolutionRouter로 전달
+type WeeklyReportService struct {
+	config     WeeklyReportConfig
+	studyStats portin.StudyStatsUseCase
+	eventLog   portout.EventLogPort
+	router     portin.SolutionReceiverUseCase
+	usage      portin.ActivityUsageUseCase // 체류 시간 (선택)
+	connected  map[string]bool             // 점수 스트림이 있는 클라이언트
+	delivered  map[string]string           // 클라이언트별 마지막 전달 주 캐시 (월요일 YYYY-MM-DD, 저장은 EventLogPort)
+	mu         sync.Mutex
+	stopChan   chan struct{}
+	now        func() time.Time
+}
+
+// NewWeeklyReportService WeeklyReportService 생성자 (DI)
+func NewWeeklyReportService(config WeeklyReportConfig, studyStats portin.StudyStatsUseCase, eventLog portout.EventLogPort, router portin.SolutionReceiverUseCase) *WeeklyReportService {
+	defaults := DefaultWeeklyReportConfig()
+	if config.CheckInterval <= 0 {
+		config.CheckInterval = defaults.CheckInterval
+	}
+	if config.TopN <= 0 {
+		config.TopN = defaults.TopN
+	}
+	if config.Location == nil {
+		config.Location = time.Local
+	}
+	return &WeeklyReportService{
+		config:     config,
+		studyStats: studyStats,
+		eventLog:   eventLog,
+		router:     router,
+		connected:  make(map[string]bool),
+		delivered:  make(map[string]string),
+		stopChan:   make(chan struct{}),
+		now:        time.Now,
+	}
+}
+
+// SetActivityUsage 체류 시간 조회 설정 (리포트의 "가장 오래 머문 곳")
+func (s *WeeklyReportService) SetActivityUsage(usage portin.ActivityUsageUseCase) {
+	s.usage = usage
+}
+
+// RecordEvent 차단/응급 이벤트를 하루 집계에 누적
+func (s *WeeklyReportService) RecordEvent(event domain.ProgressEvent) {
+	day := event.Timestamp.In(s.config.Location).Format(domain.DayLayout)
+	if err := s.eventLog.AddEvent(event, day); err != nil {
+		log.Printf("[WEEKLY_REPORT] Failed to record event for %s: %v", event.ClientID, err)
+	}
+}
+
+// OnScore 점수 스트림이 있는 클라이언트를 전달 대상으로 기록
+func (s *WeeklyReportService) OnScore(snapshot domain.ScoreSnapshot) {
+	s.mu.Lock()
+	s.connected[snapshot.ClientID] = true
+	s.mu.Unlock()
+}
+
+// Forget 세션 종료 시 전달 대상에서 제외
+func (s *WeeklyReportService) Forget(clientID string) {
+	s.mu.Lock()
+	delete(s.connected, clientID)
+	s.mu.Unlock()
+}
+
+// GetWeeklyReport weekOf가 속한 주의 리포트 생성
+func (s *WeeklyReportService) GetWeeklyReport(clientID string, weekOf time.Time) (domain.WeeklyReport, error) {
+	weekStart, weekEnd := domain.PeriodThisWeek.Range(weekOf.In(s.config.Location))
+	previousStart := weekStart.AddDate(0, 0, -7)
+
+	days, err := s.studyStats.GetDailyStats(clientID, weekStart, weekEnd)
+	if err != nil {
+		return domain.WeeklyReport{}, err
+	}
+	previousWeek, err := s.studyStats.GetDailyStats(clientID, previousStart, weekStart)
+	if err != nil {
+		return domain.WeeklyReport{}, err
+	}
+	logs, err := s.eventLog.QueryEventLogs(clientID, weekStart.Format(domain.DayLayout),
+		weekEnd.AddDate(0, 0, -1).Format(domain.DayLayout))
+	if err != nil {
+		return domain.WeeklyReport{}, err
+	}
+
+	var usage []domain.UsageStat
+	if s.usage != nil {
+		usage, err = s.usage.GetUsage(clientID, weekStart, weekEnd)
+		if err != nil {
+			return domain.WeeklyReport{}, err
+		}
+	}
+
+	return domain.NewWeeklyReport(clientID, weekStart, days, previousWeek, logs, usage, s.config.TopN), nil
+}
+
+// DeliverDue 이번 주 전달 시각이 지났으면 아직 받지 않은 접속 중 클라이언트에 리포트 전달
+func (s *WeeklyReportService) DeliverDue() {
+	now := s.now().In(s.config.Location)
+	weekStart, _ := domain.PeriodThisWeek.Range(now)
+	offset := (int(s.config.DeliveryWeekday) + 6) % 7 // 월요일 기준 일수
+	if now.Before(weekStart.AddDate(0, 0, offset).Add(s.config.DeliveryTime)) {
+		return
+	}
+	week := weekStart.Format(domain.DayLayout)
+
+	s.mu.Lock()
+	var due []string
+	for clientID := range s.connected {
+		if s.delivered[clientID] != week {
+			due = append(due, clientID)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, clientID := range due {
+		// 재시작 전에 이미 전달한 주면 다시 보내지 않음
+		delivered, err := s.eventLog.GetReportWeek(clientID)
+		if err != nil {
+			log.Printf("[WEEKLY_REPORT] Failed to load delivered week for %s: %v", clientID, err)
+			continue
+		}
+		if delivered == week {
+			s.mu.Lock()
+			s.delivered[clientID] = week
+			s.mu.Unlock()
+			continue
+		}
+
+		report, err := s.GetWeeklyReport(clientID, now)
+		if err != nil {
+			log.Printf("[WEEKLY_REPORT] Failed to build report for %s: %v", clientID, err)
+			continue
+		}
+		if err := s.router.RouteAIResult(clientID, report.Markdown()); err != nil {
+			log.Printf("[WEEKLY_REPORT] Failed to deliver report to %s: %v", clientID, err)
+			continue
+		}
+
+		if err := s.eventLog.SaveReportWeek(clientID, week); err != nil {
+			log.Printf("[WEEKLY_REPORT] Failed to save delivered week for %s: %v", clientID, err)
+		}
+		s.mu.Lock()
+		s.delivered[clientID] = week
+		s.mu.Unlock()
+		log.Printf("[WEEKLY_REPORT] 📊 Report delivered: client=%s, week=%s", clientID, week)
+	}
+}
+
+// Start 전달 시각 확인 시작
+func (s *WeeklyReportService) Start() {
+	go func() {
+		ticker := time.NewTicker(s.config.CheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.stopChan:
+				return
+			case <-ticker.C:
+				s.DeliverDue()
+			}
+		}
+	}()
+	log.Printf("[WEEKLY_REPORT] Started (delivery: %s %s)", s.config.DeliveryWeekday, s.config.DeliveryTime)
+}
+
+// Stop 전달 시각 확인 중지
+func (s *WeeklyReportService) Stop() {
+	close(s.stopChan)
+}
@@ -24,11 +24,13 @@ const (
 type ServerCommand_CommandType int32
 
 const (
-	ServerCommand_NONE         ServerCommand_CommandType = 0
-	ServerCommand_SHAKE_MOUSE  ServerCommand_CommandType = 1 // 졸음 깨우기 (물리)
-	ServerCommand_BLOCK_SCREEN ServerCommand_CommandType = 2 // 화면 가리기 (딴짓)
-	ServerCommand_SHOW_MESSAGE ServerCommand_CommandType = 3 // 경고 메시지/RAG 결과 띄우기
-	ServerCommand_PLAY_SOUND   ServerCommand_CommandType = 4 // TTS 읽기
+	ServerCommand_NONE                 ServerCommand_CommandType = 0
+	ServerCommand_SHAKE_MOUSE          ServerCommand_CommandType = 1 // 졸음 깨우기 (물리)
+	ServerCommand_BLOCK_SCREEN         ServerCommand_CommandType = 2 // 화면 가리기 (딴짓)
+	ServerCommand_SHOW_MESSAGE         ServerCommand_CommandType = 3 // 경고 메시지/RAG 결과 띄우기
+	ServerCommand_PLAY_SOUND           ServerCommand_CommandType = 4 // TTS 읽기
+	ServerCommand_UPDATE_SCORE         ServerCommand_CommandType = 5 // 점수 게이지 갱신 (payload: ScoreUpdateRequest JSON)
+	ServerCommand_ACHIEVEMENT_UNLOCKED ServerCommand_CommandType = 6 // 업적 달성 알림 (payload: Achievement JSON)
 )
 
 // Enum value maps for ServerCommand_CommandType.
@@ -39,13 +41,17 @@ var (
 		2: "BLOCK_SCREEN",
 		3: "SHOW_MESSAGE",
 		4: "PLAY_SOUND",
+		5: "UPDATE_SCORE",
+		6: "ACHIEVEMENT_UNLOCKED",
 	}
 	ServerCommand_CommandType_value = map[string]int32{
-		"NONE":         0,
-		"SHAKE_MOUSE":  1,
-		"BLOCK_SCREEN": 2,
-		"SHOW_MESSAGE": 3,
-		"PLAY_SOUND":   4,
+		"NONE":                 0,
+		"SHAKE_MOUSE":          1,
+		"BLOCK_SCREEN":         2,
+		"SHOW_MESSAGE":         3,
+		"PLAY_SOUND":           4,
+		"UPDATE_SCORE":         5,
+		"ACHIEVEMENT_UNLOCKED": 6,
 	}
 )
 
@@ -481,6 +487,9 @@ type AudioRequest struct {
 	MediaInfoJson string                 `protobuf:"bytes,4,opt,name=media_info_json,json=mediaInfoJson,proto3" json:"media_info_json,omitempty"` // 미디어 정보 JSON
 	ProcessInfo   string                 `protobuf:"bytes,10,opt,name=process_info,json=processInfo,proto3" json:"process_info,omitempty"`        // (추가) 현재 활성 프로세스 정보 (JSON)
 	Windows       string                 `protobuf:"bytes,11,opt,name=windows,proto3" json:"windows,omitempty"`                                   // (추가) 열린 창 목록 정보 (JSON)
+	ClientId      string                 `protobuf:"bytes,12,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`                 // (추가) 클라이언트 ID (EMERGENCY 판정 대상)
+	Transcript    string                 `protobuf:"bytes,13,opt,name=transcript,proto3" json:"transcript,omitempty"`                             // (추가) 클라이언트 STT 최신 결과 (있으면 EMERGENCY에 첨부)
+	SampleRate    int32                  `protobuf:"varint,14,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`          // (추가) PCM 샘플 레이트 (0이면 서버 기본값)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -557,6 +566,27 @@ func (x *AudioRequest) GetWindows() string {
 	return ""
 }
 
+func (x *AudioRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *AudioRequest) GetTranscript() string {
+	if x != nil {
+		return x.Transcript
+	}
+	return ""
+}
+
+func (x *AudioRequest) GetSampleRate() int32 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
 type AudioResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Transcript    string                 `protobuf:"bytes,1,opt,name=transcript,proto3" json:"transcript,omitempty"`                       // 변환된 텍스트
@@ -637,17 +667,19 @@ const file_api_proto_core_proto_rawDesc = "" +
 	"\vis_dragging\x18\n" +
 	" \x01(\bR\n" +
 	"isDragging\x12$\n" +
-	"\x0eavg_dwell_time\x18\v \x01(\x01R\favgDwellTime\"\xc1\x01\n" +
+	"\x0eavg_dwell_time\x18\v \x01(\x01R\favgDwellTime\"\xee\x01\n" +
 	"\rServerCommand\x128\n" +
 	"\x04type\x18\x01 \x01(\x0e2$.jiaa.core.ServerCommand.CommandTypeR\x04type\x12\x18\n" +
-	"\apayload\x18\x02 \x01(\tR\apayload\"\\\n" +
+	"\apayload\x18\x02 \x01(\tR\apayload\"\x88\x01\n" +
 	"\vCommandType\x12\b\n" +
 	"\x04NONE\x10\x00\x12\x0f\n" +
 	"\vSHAKE_MOUSE\x10\x01\x12\x10\n" +
 	"\fBLOCK_SCREEN\x10\x02\x12\x10\n" +
 	"\fSHOW_MESSAGE\x10\x03\x12\x0e\n" +
 	"\n" +
-	"PLAY_SOUND\x10\x04\">\n" +
+	"PLAY_SOUND\x10\x04\x12\x10\n" +
+	"\fUPDATE_SCORE\x10\x05\x12\x18\n" +
+	"\x14ACHIEVEMENT_UNLOCKED\x10\x06\">\n" +
 	"\x0eAnalysisReport\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\"\x1f\n" +
@@ -661,7 +693,7 @@ const file_api_proto_core_proto_rawDesc = "" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
 	"\acommand\x18\x03 \x01(\tR\acommand\x12\x1d\n" +
 	"\n" +
-	"target_app\x18\x04 \x01(\tR\ttargetApp\"\xcb\x01\n" +
+	"target_app\x18\x04 \x01(\tR\ttargetApp\"\xa9\x02\n" +
 	"\fAudioRequest\x12\x1d\n" +
 	"\n" +
 	"audio_data\x18\x01 \x01(\fR\taudioData\x12\x19\n" +
@@ -670,7 +702,13 @@ const file_api_proto_core_proto_rawDesc = "" +
 	"\x0fmedia_info_json\x18\x04 \x01(\tR\rmediaInfoJson\x12!\n" +
 	"\fprocess_info\x18\n" +
 	" \x01(\tR\vprocessInfo\x12\x18\n" +
-	"\awindows\x18\v \x01(\tR\awindows\"j\n" +
+	"\awindows\x18\v \x01(\tR\awindows\x12\x1b\n" +
+	"\tclient_id\x18\f \x01(\tR\bclientId\x12\x1e\n" +
+	"\n" +
+	"transcript\x18\r \x01(\tR\n" +
+	"transcript\x12\x1f\n" +
+	"\vsample_rate\x18\x0e \x01(\x05R\n" +
+	"sampleRate\"j\n" +
 	"\rAudioResponse\x12\x1e\n" +
 	"\n" +
 	"transcript\x18\x01 \x01(\tR\n" +
@@ -0,0 +1,1258 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.31.1
+// source: api/proto/scoring.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ScoreState int32
+
+const (
+	ScoreState_SCORE_STATE_UNKNOWN ScoreState = 0
+	ScoreState_THINKING            ScoreState = 1 // Score > 80 - 건드리지 마
+	ScoreState_FOCUSED             ScoreState = 2 // 50 < Score <= 80 - 집중 중
+	ScoreState_DISTRACTED          ScoreState = 3 // 30 < Score <= 50 - 분산됨
+	ScoreState_SLEEPING            ScoreState = 4 // Score <= 30 - 잠듦 (깨워야 함)
+	ScoreState_EMERGENCY           ScoreState = 5 // Audio > 90dB - 응급 상황
+)
+
+// Enum value maps for ScoreState.
+var (
+	ScoreState_name = map[int32]string{
+		0: "SCORE_STATE_UNKNOWN",
+		1: "THINKING",
+		2: "FOCUSED",
+		3: "DISTRACTED",
+		4: "SLEEPING",
+		5: "EMERGENCY",
+	}
+	ScoreState_value = map[string]int32{
+		"SCORE_STATE_UNKNOWN": 0,
+		"THINKING":            1,
+		"FOCUSED":             2,
+		"DISTRACTED":          3,
+		"SLEEPING":            4,
+		"EMERGENCY":           5,
+	}
+)
+
+func (x ScoreState) Enum() *ScoreState {
+	p := new(ScoreState)
+	*p = x
+	return p
+}
+
+func (x ScoreState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScoreState) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_scoring_proto_enumTypes[0].Descriptor()
+}
+
+func (ScoreState) Type() protoreflect.EnumType {
+	return &file_api_proto_scoring_proto_enumTypes[0]
+}
+
+func (x ScoreState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScoreState.Descriptor instead.
+func (ScoreState) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_scoring_proto_rawDescGZIP(), []int{0}
+}
+
+type LeaderboardPeriod int32
+
+const (
+	LeaderboardPeriod_LEADERBOARD_DAILY    LeaderboardPeriod = 0 // 오늘
+	LeaderboardPeriod_LEADERBOARD_WEEKLY   LeaderboardPeriod = 1 // 이번 주 (월요일 시작)
+	LeaderboardPeriod_LEADERBOARD_ALL_TIME LeaderboardPeriod = 2 // 누적
+)
+
+// Enum value maps for LeaderboardPeriod.
+var (
+	LeaderboardPeriod_name = map[int32]string{
+		0: "LEADERBOARD_DAILY",
+		1: "LEADERBOARD_WEEKLY",
+		2: "LEADERBOARD_ALL_TIME",
+	}
+	LeaderboardPeriod_value = map[string]int32{
+		"LEADERBOARD_DAILY":    0,
+		"LEADERBOARD_WEEKLY":   1,
+		"LEADERBOARD_ALL_TIME": 2,
+	}
+)
+
+func (x LeaderboardPeriod) Enum() *LeaderboardPeriod {
+	p := new(LeaderboardPeriod)
+	*p = x
+	return p
+}
+
+func (x LeaderboardPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_scoring_proto_enumTypes[1].Descriptor()
+}
+
+func (LeaderboardPeriod) Type() protoreflect.EnumType {
+	return &file_api_proto_scoring_proto_enumTypes[1]
+}
+
+func (x LeaderboardPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardPeriod.Descriptor instead.
+func (LeaderboardPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_scoring_proto_rawDescGZIP(), []int{1}
+}
+
+type LeaderboardMetric int32
+
+const (
+	LeaderboardMetric_LEADERBOARD_FOCUSED_TIME  LeaderboardMetric = 0 // 집중 시간 (FOCUSING + THINKING)
+	LeaderboardMetric_LEADERBOARD_AVERAGE_SCORE LeaderboardMetric = 1 // 평균 점수
+)
+
+// Enum value maps for LeaderboardMetric.
+var (
+	LeaderboardMetric_name = map[int32]string{
+		0: "LEADERBOARD_FOCUSED_TIME",
+		1: "LEADERBOARD_AVERAGE_SCORE",
+	}
+	LeaderboardMetric_value = map[string]int32{
+		"LEADERBOARD_FOCUSED_TIME":  0,
+		"LEADERBOARD_AVERAGE_SCORE": 1,
+	}
+)
+
+func (x LeaderboardMetric) Enum() *LeaderboardMetric {
+	p := new(LeaderboardMetric)
+	*p = x
+	return p
+}
+
+func (x LeaderboardMetric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardMetric) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_scoring_proto_enumTypes[2].Descriptor()
+}
+
+func (LeaderboardMetric) Type() protoreflect.EnumType {
+	return &file_api_proto_scoring_proto_enumTypes[2]
+}
+
+func (x LeaderboardMetric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardMetric.Descriptor instead.
+func (LeaderboardMetric) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_scoring_proto_rawDescGZIP(), []int{2}
+}
+
+type ScoreStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreStreamRequest) Reset() {
+	*x = ScoreStreamRequest{}
+	mi := &file_api_proto_scoring_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreStreamRequest) ProtoMessage() {}
+
+func (x *ScoreStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scoring_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreStreamRequest.ProtoReflect.Descriptor instead.
+func (*ScoreStreamRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_scoring_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScoreStreamRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type ScorePacket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	CurrentScore  int32                  `protobuf:"varint,2,opt,name=current_score,json=currentScore,proto3" json:"current_score,omitempty"` // 0-100
+	State         ScoreState             `protobuf:"varint,3,opt,name=state,proto3,enum=jiaa.ScoreState" json:"state,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Unix milliseconds
+	Breakdown     *ScoreBreakdown        `protobuf:"bytes,5,opt,name=breakdown,proto3" json:"breakdown,omitempty"`  // 점수 상세 내역
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScorePacket) Reset() {
+	*x = ScorePacket{}
+	mi := &file_api_proto_scoring_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScorePacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScorePacket) ProtoMessage() {}
+
+func (x *ScorePacket) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scoring_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScorePacket.ProtoReflect.Descriptor instead.
+func (*ScorePacket) Descriptor() ([]byte, []int) {
+	return file_api_proto_scoring_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScorePacket) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ScorePacket) GetCurrentScore() int32 {
+	if x != nil {
+		return x.CurrentScore
+	}
+	return 0
+}
+
+func (x *ScorePacket) GetState() ScoreState {
+	if x != nil {
+		return x.State
+	}
+	return ScoreState_SCORE_STATE_UNKNOWN
+}
+
+func (x *ScorePacket) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ScorePacket) GetBreakdown() *ScoreBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+// 5가지 센서 데이터 가중치 합산 내역
+type ScoreBreakdown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HeadPoseScore int32                  `protobuf:"varint,1,opt,name=head_pose_score,json=headPoseScore,proto3" json:"head_pose_score,omitempty"` // 머리 자세 점수
+	EyeFocusScore int32                  `protobuf:"varint,2,opt,name=eye_focus_score,json=eyeFocusScore,proto3" json:"eye_focus_score,omitempty"` // 눈 집중도 점수
+	AudioScore    int32                  `protobuf:"varint,3,opt,name=audio_score,json=audioScore,proto3" json:"audio_score,omitempty"`            // 오디오 점수
+	ActivityScore int32                  `protobuf:"varint,4,opt,name=activity_score,json=activityScore,proto3" json:"activity_score,omitempty"`   // 활동 점수 (URL/App)
+	IdleScore     int32                  `protobuf:"varint,5,opt,name=idle_score,json=idleScore,proto3" json:"idle_score,omitempty"`               // 유휴 시간 점수
+	TypingScore   int32                  `protobuf:"varint,6,opt,name=typing_score,json=typingScore,proto3" json:"typing_score,omitempty"`         // 타이핑 품질 점수 (엔트로피/Dwell)
+	CarriedScore  int32                  `protobuf:"varint,7,opt,name=carried_score,json=carriedScore,proto3" json:"carried_score,omitempty"`      // 직전 점수에서 이어진 몫
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreBreakdown) Reset() {
+	*x = ScoreBreakdown{}
+	mi := &file_api_proto_scoring_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreBreakdown) ProtoMessage() {}
+
+func (x *ScoreBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scoring_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreBreakdown.ProtoReflect.Descriptor instead.
+func (*ScoreBreakdown) Descriptor() ([]byte, []int) {
+	return file_api_proto_scoring_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScoreBreakdown) GetHeadPoseScore() int32 {
+	if x != nil {
+		return x.HeadPoseScore
+	}
+	return 0
+}
+
+func (x *ScoreBreakdown) GetEyeFocusScore() int32 {
+	if x != nil {
+		return x.EyeFocusScore
+	}
+	return 0
+}
+
+func (x *ScoreBreakdown) GetAudioScore() int32 {
+	if x != nil {
+		return x.AudioScore
+	}
+	return 0
+}
+
+func (x *ScoreBreakdown) GetActivityScore() int32 {
+	if x != nil {
+		return x.ActivityScore
+	}
+	return 0
+}
+
+func (x *ScoreBreakdown) GetIdleScore() int32 {
+	if x != nil {
+		return x.IdleScore
+	}
+	return 0
+}
+
+func (x *ScoreBreakdown) GetTypingScore() int32 {
+	if x != nil {
+		return x.TypingScore
+	}
+	return 0
+}
+
+func (x *ScoreBreakdown) GetCarriedScore() int32 {
+	if x != nil {
+		return x.CarriedScore
+	}
+	return 0
+}
+
+type FactBombRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	TriggerEvent  string                 `protobuf:"bytes,2,opt,name=trigger_event,json=triggerEvent,proto3" json:"trigger_event,omitempty"` // 트리거 이벤트 (예: "youtube_detected")
+	TargetUrl     string                 `protobuf:"bytes,3,opt,name=target_url,json=targetUrl,proto3" json:"target_url,omitempty"`          // 대상 URL (선택)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FactBombRequest) Reset() {
+	*x = FactBombRequest{}
+	mi := &file_api_proto_scoring_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FactBombRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FactBombRequest) ProtoMessage() {}
+
+func (x *FactBombRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scoring_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FactBombRequest.ProtoReflect.Descriptor instead.
+func (*FactBombRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_scoring_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FactBombRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *FactBombRequest) GetTriggerEvent() string {
+	if x != nil {
+		return x.TriggerEvent
+	}
+	return ""
+}
+
+func (x *FactBombRequest) GetTargetUrl() string {
+	if x != nil {
+		return x.TargetUrl
+	}
+	return ""
+}
+
+type FactBombResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Success            bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	FactMessage        string                 `protobuf:"bytes,2,opt,name=fact_message,json=factMessage,proto3" json:"fact_message,omitempty"`                       // 팩트 폭격 멘트
+	AccumulatedSeconds int64                  `protobuf:"varint,3,opt,name=accumulated_seconds,json=accumulatedSeconds,proto3" json:"accumulated_seconds,omitempty"` // 누적 시간 (초)
+	TimePeriod         string                 `protobuf:"bytes,4,opt,name=time_period,json=timePeriod,proto3" json:"time_period,omitempty"`                          // 기간 (예: "지난주")
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *FactBombResponse) Reset() {
+	*x = FactBombResponse{}
+	mi := &file_api_proto_scoring_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FactBombResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FactBombResponse) ProtoMessage() {}
+
+func (x *FactBombResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scoring_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FactBombResponse.ProtoReflect.Descriptor instead.
+func (*FactBombResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_scoring_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FactBombResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *FactBombResponse) GetFactMessage() string {
+	if x != nil {
+		return x.FactMessage
+	}
+	return ""
+}
+
+func (x *FactBombResponse) GetAccumulatedSeconds() int64 {
+	if x != nil {
+		return x.AccumulatedSeconds
+	}
+	return 0
+}
+
+func (x *FactBombResponse) GetTimePeriod() string {
+	if x != nil {
+		return x.TimePeriod
+	}
+	return ""
+}
+
+type GamificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GamificationRequest) Reset() {
+	*x = GamificationRequest{}
+	mi := &file_api_proto_scoring_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GamificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GamificationRequest) ProtoMessage() {}
+
+func (x *GamificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scoring_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GamificationRequest.ProtoReflect.Descriptor instead.
+func (*GamificationRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_scoring_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GamificationRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type GamificationResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Success           bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Level             int32                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`                                                    // 현재 레벨
+	Experience        int64                  `protobuf:"varint,3,opt,name=experience,proto3" json:"experience,omitempty"`                                          // 현재 경험치
+	NextLevelExp      int64                  `protobuf:"varint,4,opt,name=next_level_exp,json=nextLevelExp,proto3" json:"next_level_exp,omitempty"`                // 다음 레벨 경험치
+	TotalStudySeconds int64                  `protobuf:"varint,5,opt,name=total_study_seconds,json=totalStudySeconds,proto3" json:"total_study_seconds,omitempty"` // 총 공부 시간 (초)
+	AverageScore      float32                `protobuf:"fixed32,6,opt,name=average_score,json=averageScore,proto3" json:"average_score,omitempty"`                 // 평균 점수
+	Achievements      []*Achievement         `protobuf:"bytes,7,rep,name=achievements,proto3" json:"achievements,omitempty"`                                       // 업적 목록
+	Streak            *StreakStatus          `protobuf:"bytes,8,opt,name=streak,proto3" json:"streak,omitempty"`                                                   // 하루 목표 연속 달성 현황
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GamificationResponse) Reset() {
+	*x = GamificationResponse{}
+	mi := &file_api_proto_scoring_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GamificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GamificationResponse) ProtoMessage() {}
+
+func (x *GamificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scoring_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GamificationResponse.ProtoReflect.Descriptor instead.
+func (*GamificationResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_scoring_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GamificationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GamificationResponse) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *GamificationResponse) GetExperience() int64 {
+	if x != nil {
+		return x.Experience
+	}
+	return 0
+}
+
+func (x *GamificationResponse) GetNextLevelExp() int64 {
+	if x != nil {
+		return x.NextLevelExp
+	}
+	return 0
+}
+
+func (x *GamificationResponse) GetTotalStudySeconds() int64 {
+	if x != nil {
+		return x.TotalStudySeconds
+	}
+	return 0
+}
+
+func (x *GamificationResponse) GetAverageScore() float32 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+func (x *GamificationResponse) GetAchievements() []*Achievement {
+	if x != nil {
+		return x.Achievements
+	}
+	return nil
+}
+
+func (x *GamificationResponse) GetStreak() *StreakStatus {
+	if x != nil {
+		return x.Streak
+	}
+	return nil
+}
+
+type Achievement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Unlocked      bool                   `protobuf:"varint,4,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	UnlockedAt    int64                  `protobuf:"varint,5,opt,name=unlocked_at,json=unlockedAt,proto3" json:"unlocked_at,omitempty"` // 해금 시간 (Unix timestamp)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Achievement) Reset() {
+	*x = Achievement{}
+	mi := &file_api_proto_scoring_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Achievement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Achievement) ProtoMessage() {}
+
+func (x *Achievement) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scoring_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Achievement.ProtoReflect.Descriptor instead.
+func (*Achievement) Descriptor() ([]byte, []int) {
+	return file_api_proto_scoring_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Achievement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Achievement) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Achievement) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Achievement) GetUnlocked() bool {
+	if x != nil {
+		return x.Unlocked
+	}
+	return false
+}
+
+func (x *Achievement) GetUnlockedAt() int64 {
+	if x != nil {
+		return x.UnlockedAt
+	}
+	return 0
+}
+
+type StreakStatus struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DailyGoalSeconds  int64                  `protobuf:"varint,1,opt,name=daily_goal_seconds,json=dailyGoalSeconds,proto3" json:"daily_goal_seconds,omitempty"`    // 하루 집중 목표 (초)
+	TodayFocusSeconds int64                  `protobuf:"varint,2,opt,name=today_focus_seconds,json=todayFocusSeconds,proto3" json:"today_focus_seconds,omitempty"` // 오늘 누적 집중 시간 (초)
+	RemainingSeconds  int64                  `protobuf:"varint,3,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"`      // 오늘 목표까지 남은 시간 (초)
+	GoalMetToday      bool                   `protobuf:"varint,4,opt,name=goal_met_today,json=goalMetToday,proto3" json:"goal_met_today,omitempty"`                // 오늘 목표 달성 여부
+	CurrentStreak     int32                  `protobuf:"varint,5,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"`               // 현재 연속 달성 일수
+	BestStreak        int32                  `protobuf:"varint,6,opt,name=best_streak,json=bestStreak,proto3" json:"best_streak,omitempty"`                        // 최장 연속 달성 일수
+	AtRisk            bool                   `protobuf:"varint,7,opt,name=at_risk,json=atRisk,proto3" json:"at_risk,omitempty"`                                    // 자정이 가까운데 목표 미달
+	Timezone          string                 `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`                                               // 날짜 기준 시간대
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StreakStatus) Reset() {
+	*x = StreakStatus{}
+	mi := &file_api_proto_scoring_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreakStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreakStatus) ProtoMessage() {}
+
+func (x *StreakStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scoring_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreakStatus.ProtoReflect.Descriptor instead.
+func (*StreakStatus) Descriptor() ([]byte, []int) {
+	return file_api_proto_scoring_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StreakStatus) GetDailyGoalSeconds() int64 {
+	if x != nil {
+		return x.DailyGoalSeconds
+	}
+	return 0
+}
+
+func (x *StreakStatus) GetTodayFocusSeconds() int64 {
+	if x != nil {
+		return x.TodayFocusSeconds
+	}
+	return 0
+}
+
+func (x *StreakStatus) GetRemainingSeconds() int64 {
+	if x != nil {
+		return x.RemainingSeconds
+	}
+	return 0
+}
+
+func (x *StreakStatus) GetGoalMetToday() bool {
+	if x != nil {
+		return x.GoalMetToday
+	}
+	return false
+}
+
+func (x *StreakStatus) GetCurrentStreak() int32 {
+	if x != nil {
+		return x.CurrentStreak
+	}
+	return 0
+}
+
+func (x *StreakStatus) GetBestStreak() int32 {
+	if x != nil {
+		return x.BestStreak
+	}
+	return 0
+}
+
+func (x *StreakStatus) GetAtRisk() bool {
+	if x != nil {
+		return x.AtRisk
+	}
+	return false
+}
+
+func (x *StreakStatus) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type LeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Period        LeaderboardPeriod      `protobuf:"varint,2,opt,name=period,proto3,enum=jiaa.LeaderboardPeriod" json:"period,omitempty"`
+	Metric        LeaderboardMetric      `protobuf:"varint,3,opt,name=metric,proto3,enum=jiaa.LeaderboardMetric" json:"metric,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"` // 순위 개수 (0이면 기본 10)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardRequest) Reset() {
+	*x = LeaderboardRequest{}
+	mi := &file_api_proto_scoring_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardRequest) ProtoMessage() {}
+
+func (x *LeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scoring_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*LeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_scoring_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LeaderboardRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *LeaderboardRequest) GetPeriod() LeaderboardPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return LeaderboardPeriod_LEADERBOARD_DAILY
+}
+
+func (x *LeaderboardRequest) GetMetric() LeaderboardMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return LeaderboardMetric_LEADERBOARD_FOCUSED_TIME
+}
+
+func (x *LeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type LeaderboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Period        LeaderboardPeriod      `protobuf:"varint,3,opt,name=period,proto3,enum=jiaa.LeaderboardPeriod" json:"period,omitempty"`
+	Metric        LeaderboardMetric      `protobuf:"varint,4,opt,name=metric,proto3,enum=jiaa.LeaderboardMetric" json:"metric,omitempty"`
+	From          int64                  `protobuf:"varint,5,opt,name=from,proto3" json:"from,omitempty"` // 집계 시작 (Unix milliseconds, 누적이면 0)
+	To            int64                  `protobuf:"varint,6,opt,name=to,proto3" json:"to,omitempty"`     // 집계 끝 (Unix milliseconds, 누적이면 0)
+	Entries       []*LeaderboardEntry    `protobuf:"bytes,7,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardResponse) Reset() {
+	*x = LeaderboardResponse{}
+	mi := &file_api_proto_scoring_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardResponse) ProtoMessage() {}
+
+func (x *LeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scoring_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*LeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_scoring_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LeaderboardResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LeaderboardResponse) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *LeaderboardResponse) GetPeriod() LeaderboardPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return LeaderboardPeriod_LEADERBOARD_DAILY
+}
+
+func (x *LeaderboardResponse) GetMetric() LeaderboardMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return LeaderboardMetric_LEADERBOARD_FOCUSED_TIME
+}
+
+func (x *LeaderboardResponse) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *LeaderboardResponse) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *LeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type LeaderboardEntry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Rank           int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"` // 순위 (동점은 같은 순위)
+	ClientId       string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	DisplayName    string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	FocusedSeconds int64                  `protobuf:"varint,4,opt,name=focused_seconds,json=focusedSeconds,proto3" json:"focused_seconds,omitempty"` // 집중 시간 (초)
+	AverageScore   float32                `protobuf:"fixed32,5,opt,name=average_score,json=averageScore,proto3" json:"average_score,omitempty"`      // 평균 점수
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_api_proto_scoring_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scoring_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_scoring_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetFocusedSeconds() int64 {
+	if x != nil {
+		return x.FocusedSeconds
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetAverageScore() float32 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+var File_api_proto_scoring_proto protoreflect.FileDescriptor
+
+const file_api_proto_scoring_proto_rawDesc = "" +
+	"\n" +
+	"\x17api/proto/scoring.proto\x12\x04jiaa\"1\n" +
+	"\x12ScoreStreamRequest\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\"\xc9\x01\n" +
+	"\vScorePacket\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12#\n" +
+	"\rcurrent_score\x18\x02 \x01(\x05R\fcurrentScore\x12&\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x10.jiaa.ScoreStateR\x05state\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp\x122\n" +
+	"\tbreakdown\x18\x05 \x01(\v2\x14.jiaa.ScoreBreakdownR\tbreakdown\"\x8f\x02\n" +
+	"\x0eScoreBreakdown\x12&\n" +
+	"\x0fhead_pose_score\x18\x01 \x01(\x05R\rheadPoseScore\x12&\n" +
+	"\x0feye_focus_score\x18\x02 \x01(\x05R\reyeFocusScore\x12\x1f\n" +
+	"\vaudio_score\x18\x03 \x01(\x05R\n" +
+	"audioScore\x12%\n" +
+	"\x0eactivity_score\x18\x04 \x01(\x05R\ractivityScore\x12\x1d\n" +
+	"\n" +
+	"idle_score\x18\x05 \x01(\x05R\tidleScore\x12!\n" +
+	"\ftyping_score\x18\x06 \x01(\x05R\vtypingScore\x12#\n" +
+	"\rcarried_score\x18\a \x01(\x05R\fcarriedScore\"r\n" +
+	"\x0fFactBombRequest\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12#\n" +
+	"\rtrigger_event\x18\x02 \x01(\tR\ftriggerEvent\x12\x1d\n" +
+	"\n" +
+	"target_url\x18\x03 \x01(\tR\ttargetUrl\"\xa1\x01\n" +
+	"\x10FactBombResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12!\n" +
+	"\ffact_message\x18\x02 \x01(\tR\vfactMessage\x12/\n" +
+	"\x13accumulated_seconds\x18\x03 \x01(\x03R\x12accumulatedSeconds\x12\x1f\n" +
+	"\vtime_period\x18\x04 \x01(\tR\n" +
+	"timePeriod\"2\n" +
+	"\x13GamificationRequest\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\"\xc4\x02\n" +
+	"\x14GamificationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x05R\x05level\x12\x1e\n" +
+	"\n" +
+	"experience\x18\x03 \x01(\x03R\n" +
+	"experience\x12$\n" +
+	"\x0enext_level_exp\x18\x04 \x01(\x03R\fnextLevelExp\x12.\n" +
+	"\x13total_study_seconds\x18\x05 \x01(\x03R\x11totalStudySeconds\x12#\n" +
+	"\raverage_score\x18\x06 \x01(\x02R\faverageScore\x125\n" +
+	"\fachievements\x18\a \x03(\v2\x11.jiaa.AchievementR\fachievements\x12*\n" +
+	"\x06streak\x18\b \x01(\v2\x12.jiaa.StreakStatusR\x06streak\"\x90\x01\n" +
+	"\vAchievement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bunlocked\x18\x04 \x01(\bR\bunlocked\x12\x1f\n" +
+	"\vunlocked_at\x18\x05 \x01(\x03R\n" +
+	"unlockedAt\"\xbc\x02\n" +
+	"\fStreakStatus\x12,\n" +
+	"\x12daily_goal_seconds\x18\x01 \x01(\x03R\x10dailyGoalSeconds\x12.\n" +
+	"\x13today_focus_seconds\x18\x02 \x01(\x03R\x11todayFocusSeconds\x12+\n" +
+	"\x11remaining_seconds\x18\x03 \x01(\x03R\x10remainingSeconds\x12$\n" +
+	"\x0egoal_met_today\x18\x04 \x01(\bR\fgoalMetToday\x12%\n" +
+	"\x0ecurrent_streak\x18\x05 \x01(\x05R\rcurrentStreak\x12\x1f\n" +
+	"\vbest_streak\x18\x06 \x01(\x05R\n" +
+	"bestStreak\x12\x17\n" +
+	"\aat_risk\x18\a \x01(\bR\x06atRisk\x12\x1a\n" +
+	"\btimezone\x18\b \x01(\tR\btimezone\"\xa7\x01\n" +
+	"\x12LeaderboardRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12/\n" +
+	"\x06period\x18\x02 \x01(\x0e2\x17.jiaa.LeaderboardPeriodR\x06period\x12/\n" +
+	"\x06metric\x18\x03 \x01(\x0e2\x17.jiaa.LeaderboardMetricR\x06metric\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x82\x02\n" +
+	"\x13LeaderboardResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12/\n" +
+	"\x06period\x18\x03 \x01(\x0e2\x17.jiaa.LeaderboardPeriodR\x06period\x12/\n" +
+	"\x06metric\x18\x04 \x01(\x0e2\x17.jiaa.LeaderboardMetricR\x06metric\x12\x12\n" +
+	"\x04from\x18\x05 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x06 \x01(\x03R\x02to\x120\n" +
+	"\aentries\x18\a \x03(\v2\x16.jiaa.LeaderboardEntryR\aentries\"\xb4\x01\n" +
+	"\x10LeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12'\n" +
+	"\x0ffocused_seconds\x18\x04 \x01(\x03R\x0efocusedSeconds\x12#\n" +
+	"\raverage_score\x18\x05 \x01(\x02R\faverageScore*m\n" +
+	"\n" +
+	"ScoreState\x12\x17\n" +
+	"\x13SCORE_STATE_UNKNOWN\x10\x00\x12\f\n" +
+	"\bTHINKING\x10\x01\x12\v\n" +
+	"\aFOCUSED\x10\x02\x12\x0e\n" +
+	"\n" +
+	"DISTRACTED\x10\x03\x12\f\n" +
+	"\bSLEEPING\x10\x04\x12\r\n" +
+	"\tEMERGENCY\x10\x05*\\\n" +
+	"\x11LeaderboardPeriod\x12\x15\n" +
+	"\x11LEADERBOARD_DAILY\x10\x00\x12\x16\n" +
+	"\x12LEADERBOARD_WEEKLY\x10\x01\x12\x18\n" +
+	"\x14LEADERBOARD_ALL_TIME\x10\x02*P\n" +
+	"\x11LeaderboardMetric\x12\x1c\n" +
+	"\x18LEADERBOARD_FOCUSED_TIME\x10\x00\x12\x1d\n" +
+	"\x19LEADERBOARD_AVERAGE_SCORE\x10\x012\xa1\x02\n" +
+	"\x0eScoringService\x12<\n" +
+	"\vStreamScore\x12\x18.jiaa.ScoreStreamRequest\x1a\x11.jiaa.ScorePacket0\x01\x12<\n" +
+	"\vGetFactBomb\x12\x15.jiaa.FactBombRequest\x1a\x16.jiaa.FactBombResponse\x12L\n" +
+	"\x13GetGamificationInfo\x12\x19.jiaa.GamificationRequest\x1a\x1a.jiaa.GamificationResponse\x12E\n" +
+	"\x0eGetLeaderboard\x12\x18.jiaa.LeaderboardRequest\x1a\x19.jiaa.LeaderboardResponseB\x1cZ\x1ajiaa-server-core/pkg/protob\x06proto3"
+
+var (
+	file_api_proto_scoring_proto_rawDescOnce sync.Once
+	file_api_proto_scoring_proto_rawDescData []byte
+)
+
+func file_api_proto_scoring_proto_rawDescGZIP() []byte {
+	file_api_proto_scoring_proto_rawDescOnce.Do(func() {
+		file_api_proto_scoring_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_scoring_proto_rawDesc), len(file_api_proto_scoring_proto_rawDesc)))
+	})
+	return file_api_proto_scoring_proto_rawDescData
+}
+
+var file_api_proto_scoring_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_proto_scoring_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_api_proto_scoring_proto_goTypes = []any{
+	(ScoreState)(0),              // 0: jiaa.ScoreState
+	(LeaderboardPeriod)(0),       // 1: jiaa.LeaderboardPeriod
+	(LeaderboardMetric)(0),       // 2: jiaa.LeaderboardMetric
+	(*ScoreStreamRequest)(nil),   // 3: jiaa.ScoreStreamRequest
+	(*ScorePacket)(nil),          // 4: jiaa.ScorePacket
+	(*ScoreBreakdown)(nil),       // 5: jiaa.ScoreBreakdown
+	(*FactBombRequest)(nil),      // 6: jiaa.FactBombRequest
+	(*FactBombResponse)(nil),     // 7: jiaa.FactBombResponse
+	(*GamificationRequest)(nil),  // 8: jiaa.GamificationRequest
+	(*GamificationResponse)(nil), // 9: jiaa.GamificationResponse
+	(*Achievement)(nil),          // 10: jiaa.Achievement
+	(*StreakStatus)(nil),         // 11: jiaa.StreakStatus
+	(*LeaderboardRequest)(nil),   // 12: jiaa.LeaderboardRequest
+	(*LeaderboardResponse)(nil),  // 13: jiaa.LeaderboardResponse
+	(*LeaderboardEntry)(nil),     // 14: jiaa.LeaderboardEntry
+}
+var file_api_proto_scoring_proto_depIdxs = []int32{
+	0,  // 0: jiaa.ScorePacket.state:type_name -> jiaa.ScoreState
+	5,  // 1: jiaa.ScorePacket.breakdown:type_name -> jiaa.ScoreBreakdown
+	10, // 2: jiaa.GamificationResponse.achievements:type_name -> jiaa.Achievement
+	11, // 3: jiaa.GamificationResponse.streak:type_name -> jiaa.StreakStatus
+	1,  // 4: jiaa.LeaderboardRequest.period:type_name -> jiaa.LeaderboardPeriod
+	2,  // 5: jiaa.LeaderboardRequest.metric:type_name -> jiaa.LeaderboardMetric
+	1,  // 6: jiaa.LeaderboardResponse.period:type_name -> jiaa.LeaderboardPeriod
+	2,  // 7: jiaa.LeaderboardResponse.metric:type_name -> jiaa.LeaderboardMetric
+	14, // 8: jiaa.LeaderboardResponse.entries:type_name -> jiaa.LeaderboardEntry
+	3,  // 9: jiaa.ScoringService.StreamScore:input_type -> jiaa.ScoreStreamRequest
+	6,  // 10: jiaa.ScoringService.GetFactBomb:input_type -> jiaa.FactBombRequest
+	8,  // 11: jiaa.ScoringService.GetGamificationInfo:input_type -> jiaa.GamificationRequest
+	12, // 12: jiaa.ScoringService.GetLeaderboard:input_type -> jiaa.LeaderboardRequest
+	4,  // 13: jiaa.ScoringService.StreamScore:output_type -> jiaa.ScorePacket
+	7,  // 14: jiaa.ScoringService.GetFactBomb:output_type -> jiaa.FactBombResponse
+	9,  // 15: jiaa.ScoringService.GetGamificationInfo:output_type -> jiaa.GamificationResponse
+	13, // 16: jiaa.ScoringService.GetLeaderboard:output_type -> jiaa.LeaderboardResponse
+	13, // [13:17] is the sub-list for method output_type
+	9,  // [9:13] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_scoring_proto_init() }
+func file_api_proto_scoring_proto_init() {
+	if File_api_proto_scoring_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_scoring_proto_rawDesc), len(file_api_proto_scoring_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_scoring_proto_goTypes,
+		DependencyIndexes: file_api_proto_scoring_proto_depIdxs,
+		EnumInfos:         file_api_proto_scoring_proto_enumTypes,
+		MessageInfos:      file_api_proto_scoring_proto_msgTypes,
+	}.Build()
+	File_api_proto_scoring_proto = out.File
+	file_api_proto_scoring_proto_goTypes = nil
+	file_api_proto_scoring_proto_depIdxs = nil
+}
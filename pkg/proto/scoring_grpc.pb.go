@@ -0,0 +1,253 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.31.1
+// source: api/proto/scoring.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ScoringService_StreamScore_FullMethodName         = "/jiaa.ScoringService/StreamScore"
+	ScoringService_GetFactBomb_FullMethodName         = "/jiaa.ScoringService/GetFactBomb"
+	ScoringService_GetGamificationInfo_FullMethodName = "/jiaa.ScoringService/GetGamificationInfo"
+	ScoringService_GetLeaderboard_FullMethodName      = "/jiaa.ScoringService/GetLeaderboard"
+)
+
+// ScoringServiceClient is the client API for ScoringService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ScoringService Dev 6(Data & Fact Service)와 통신하기 위한 서비스
+// 실시간 점수 산출, 상태 결정, 팩트 폭격
+type ScoringServiceClient interface {
+	// 실시간 점수 스트림 (Dev 3에게 0.1초마다 전송)
+	StreamScore(ctx context.Context, in *ScoreStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScorePacket], error)
+	// 팩트 폭격 요청
+	GetFactBomb(ctx context.Context, in *FactBombRequest, opts ...grpc.CallOption) (*FactBombResponse, error)
+	// 게이미피케이션 정보 조회
+	GetGamificationInfo(ctx context.Context, in *GamificationRequest, opts ...grpc.CallOption) (*GamificationResponse, error)
+	// 스터디 그룹 리더보드 조회
+	GetLeaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error)
+}
+
+type scoringServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScoringServiceClient(cc grpc.ClientConnInterface) ScoringServiceClient {
+	return &scoringServiceClient{cc}
+}
+
+func (c *scoringServiceClient) StreamScore(ctx context.Context, in *ScoreStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScorePacket], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ScoringService_ServiceDesc.Streams[0], ScoringService_StreamScore_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ScoreStreamRequest, ScorePacket]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScoringService_StreamScoreClient = grpc.ServerStreamingClient[ScorePacket]
+
+func (c *scoringServiceClient) GetFactBomb(ctx context.Context, in *FactBombRequest, opts ...grpc.CallOption) (*FactBombResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FactBombResponse)
+	err := c.cc.Invoke(ctx, ScoringService_GetFactBomb_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) GetGamificationInfo(ctx context.Context, in *GamificationRequest, opts ...grpc.CallOption) (*GamificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GamificationResponse)
+	err := c.cc.Invoke(ctx, ScoringService_GetGamificationInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) GetLeaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaderboardResponse)
+	err := c.cc.Invoke(ctx, ScoringService_GetLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScoringServiceServer is the server API for ScoringService service.
+// All implementations must embed UnimplementedScoringServiceServer
+// for forward compatibility.
+//
+// ScoringService Dev 6(Data & Fact Service)와 통신하기 위한 서비스
+// 실시간 점수 산출, 상태 결정, 팩트 폭격
+type ScoringServiceServer interface {
+	// 실시간 점수 스트림 (Dev 3에게 0.1초마다 전송)
+	StreamScore(*ScoreStreamRequest, grpc.ServerStreamingServer[ScorePacket]) error
+	// 팩트 폭격 요청
+	GetFactBomb(context.Context, *FactBombRequest) (*FactBombResponse, error)
+	// 게이미피케이션 정보 조회
+	GetGamificationInfo(context.Context, *GamificationRequest) (*GamificationResponse, error)
+	// 스터디 그룹 리더보드 조회
+	GetLeaderboard(context.Context, *LeaderboardRequest) (*LeaderboardResponse, error)
+	mustEmbedUnimplementedScoringServiceServer()
+}
+
+// UnimplementedScoringServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedScoringServiceServer struct{}
+
+func (UnimplementedScoringServiceServer) StreamScore(*ScoreStreamRequest, grpc.ServerStreamingServer[ScorePacket]) error {
+	return status.Error(codes.Unimplemented, "method StreamScore not implemented")
+}
+func (UnimplementedScoringServiceServer) GetFactBomb(context.Context, *FactBombRequest) (*FactBombResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFactBomb not implemented")
+}
+func (UnimplementedScoringServiceServer) GetGamificationInfo(context.Context, *GamificationRequest) (*GamificationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGamificationInfo not implemented")
+}
+func (UnimplementedScoringServiceServer) GetLeaderboard(context.Context, *LeaderboardRequest) (*LeaderboardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedScoringServiceServer) mustEmbedUnimplementedScoringServiceServer() {}
+func (UnimplementedScoringServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeScoringServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScoringServiceServer will
+// result in compilation errors.
+type UnsafeScoringServiceServer interface {
+	mustEmbedUnimplementedScoringServiceServer()
+}
+
+func RegisterScoringServiceServer(s grpc.ServiceRegistrar, srv ScoringServiceServer) {
+	// If the following call panics, it indicates UnimplementedScoringServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ScoringService_ServiceDesc, srv)
+}
+
+func _ScoringService_StreamScore_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScoreStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ScoringServiceServer).StreamScore(m, &grpc.GenericServerStream[ScoreStreamRequest, ScorePacket]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScoringService_StreamScoreServer = grpc.ServerStreamingServer[ScorePacket]
+
+func _ScoringService_GetFactBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FactBombRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).GetFactBomb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_GetFactBomb_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).GetFactBomb(ctx, req.(*FactBombRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_GetGamificationInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GamificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).GetGamificationInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_GetGamificationInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).GetGamificationInfo(ctx, req.(*GamificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_GetLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).GetLeaderboard(ctx, req.(*LeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ScoringService_ServiceDesc is the grpc.ServiceDesc for ScoringService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScoringService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "jiaa.ScoringService",
+	HandlerType: (*ScoringServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFactBomb",
+			Handler:    _ScoringService_GetFactBomb_Handler,
+		},
+		{
+			MethodName: "GetGamificationInfo",
+			Handler:    _ScoringService_GetGamificationInfo_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _ScoringService_GetLeaderboard_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamScore",
+			Handler:       _ScoringService_StreamScore_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/proto/scoring.proto",
+}
@@ -89,9 +89,10 @@ type TTSResponse struct {
 
 // ScoreUpdateRequest 점수 업데이트 요청
 type ScoreUpdateRequest struct {
-	ClientId     string `json:"client_id"`
-	CurrentScore int32  `json:"current_score"`
-	State        string `json:"state"`
+	ClientId     string          `json:"client_id"`
+	CurrentScore int32           `json:"current_score"`
+	State        string          `json:"state"`
+	Breakdown    *ScoreBreakdown `json:"breakdown"`
 }
 
 // ScoreUpdateResponse 점수 업데이트 응답